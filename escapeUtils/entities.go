@@ -0,0 +1,317 @@
+package escapeUtils
+
+// basicEscape holds the entities escaping the characters which have a meaning in XML and HTML.
+var basicEscape = map[string]string{
+	"\"": "&quot;", // " - double-quote
+	"&":  "&amp;",  // & - ampersand
+	"<":  "&lt;",   // < - less-than
+	">":  "&gt;",   // > - greater-than
+}
+
+// aposEscape holds the entity escaping the apostrophe, which is legal in XML but not in HTML 4.
+var aposEscape = map[string]string{
+	"'": "&apos;", // XML apostrophe
+}
+
+// isoLatin1Escape holds the HTML 4 entities of the ISO-8859-1 characters.
+var isoLatin1Escape = map[string]string{
+	"\u00A0": "&nbsp;",   // no-break space
+	"\u00A1": "&iexcl;",  // inverted exclamation mark
+	"\u00A2": "&cent;",   // cent sign
+	"\u00A3": "&pound;",  // pound sign
+	"\u00A4": "&curren;", // currency sign
+	"\u00A5": "&yen;",    // yen sign
+	"\u00A6": "&brvbar;", // broken bar
+	"\u00A7": "&sect;",   // section sign
+	"\u00A8": "&uml;",    // diaeresis
+	"\u00A9": "&copy;",   // copyright sign
+	"\u00AA": "&ordf;",   // feminine ordinal indicator
+	"\u00AB": "&laquo;",  // left-pointing double angle quotation mark
+	"\u00AC": "&not;",    // not sign
+	"\u00AD": "&shy;",    // soft hyphen
+	"\u00AE": "&reg;",    // registered sign
+	"\u00AF": "&macr;",   // macron
+	"\u00B0": "&deg;",    // degree sign
+	"\u00B1": "&plusmn;", // plus-minus sign
+	"\u00B2": "&sup2;",   // superscript two
+	"\u00B3": "&sup3;",   // superscript three
+	"\u00B4": "&acute;",  // acute accent
+	"\u00B5": "&micro;",  // micro sign
+	"\u00B6": "&para;",   // pilcrow sign
+	"\u00B7": "&middot;", // middle dot
+	"\u00B8": "&cedil;",  // cedilla
+	"\u00B9": "&sup1;",   // superscript one
+	"\u00BA": "&ordm;",   // masculine ordinal indicator
+	"\u00BB": "&raquo;",  // right-pointing double angle quotation mark
+	"\u00BC": "&frac14;", // vulgar fraction one quarter
+	"\u00BD": "&frac12;", // vulgar fraction one half
+	"\u00BE": "&frac34;", // vulgar fraction three quarters
+	"\u00BF": "&iquest;", // inverted question mark
+	"\u00C0": "&Agrave;", // latin capital letter a with grave
+	"\u00C1": "&Aacute;", // latin capital letter a with acute
+	"\u00C2": "&Acirc;",  // latin capital letter a with circumflex
+	"\u00C3": "&Atilde;", // latin capital letter a with tilde
+	"\u00C4": "&Auml;",   // latin capital letter a with diaeresis
+	"\u00C5": "&Aring;",  // latin capital letter a with ring above
+	"\u00C6": "&AElig;",  // latin capital letter ae
+	"\u00C7": "&Ccedil;", // latin capital letter c with cedilla
+	"\u00C8": "&Egrave;", // latin capital letter e with grave
+	"\u00C9": "&Eacute;", // latin capital letter e with acute
+	"\u00CA": "&Ecirc;",  // latin capital letter e with circumflex
+	"\u00CB": "&Euml;",   // latin capital letter e with diaeresis
+	"\u00CC": "&Igrave;", // latin capital letter i with grave
+	"\u00CD": "&Iacute;", // latin capital letter i with acute
+	"\u00CE": "&Icirc;",  // latin capital letter i with circumflex
+	"\u00CF": "&Iuml;",   // latin capital letter i with diaeresis
+	"\u00D0": "&ETH;",    // latin capital letter eth
+	"\u00D1": "&Ntilde;", // latin capital letter n with tilde
+	"\u00D2": "&Ograve;", // latin capital letter o with grave
+	"\u00D3": "&Oacute;", // latin capital letter o with acute
+	"\u00D4": "&Ocirc;",  // latin capital letter o with circumflex
+	"\u00D5": "&Otilde;", // latin capital letter o with tilde
+	"\u00D6": "&Ouml;",   // latin capital letter o with diaeresis
+	"\u00D7": "&times;",  // multiplication sign
+	"\u00D8": "&Oslash;", // latin capital letter o with stroke
+	"\u00D9": "&Ugrave;", // latin capital letter u with grave
+	"\u00DA": "&Uacute;", // latin capital letter u with acute
+	"\u00DB": "&Ucirc;",  // latin capital letter u with circumflex
+	"\u00DC": "&Uuml;",   // latin capital letter u with diaeresis
+	"\u00DD": "&Yacute;", // latin capital letter y with acute
+	"\u00DE": "&THORN;",  // latin capital letter thorn
+	"\u00DF": "&szlig;",  // latin small letter sharp s
+	"\u00E0": "&agrave;", // latin small letter a with grave
+	"\u00E1": "&aacute;", // latin small letter a with acute
+	"\u00E2": "&acirc;",  // latin small letter a with circumflex
+	"\u00E3": "&atilde;", // latin small letter a with tilde
+	"\u00E4": "&auml;",   // latin small letter a with diaeresis
+	"\u00E5": "&aring;",  // latin small letter a with ring above
+	"\u00E6": "&aelig;",  // latin small letter ae
+	"\u00E7": "&ccedil;", // latin small letter c with cedilla
+	"\u00E8": "&egrave;", // latin small letter e with grave
+	"\u00E9": "&eacute;", // latin small letter e with acute
+	"\u00EA": "&ecirc;",  // latin small letter e with circumflex
+	"\u00EB": "&euml;",   // latin small letter e with diaeresis
+	"\u00EC": "&igrave;", // latin small letter i with grave
+	"\u00ED": "&iacute;", // latin small letter i with acute
+	"\u00EE": "&icirc;",  // latin small letter i with circumflex
+	"\u00EF": "&iuml;",   // latin small letter i with diaeresis
+	"\u00F0": "&eth;",    // latin small letter eth
+	"\u00F1": "&ntilde;", // latin small letter n with tilde
+	"\u00F2": "&ograve;", // latin small letter o with grave
+	"\u00F3": "&oacute;", // latin small letter o with acute
+	"\u00F4": "&ocirc;",  // latin small letter o with circumflex
+	"\u00F5": "&otilde;", // latin small letter o with tilde
+	"\u00F6": "&ouml;",   // latin small letter o with diaeresis
+	"\u00F7": "&divide;", // division sign
+	"\u00F8": "&oslash;", // latin small letter o with stroke
+	"\u00F9": "&ugrave;", // latin small letter u with grave
+	"\u00FA": "&uacute;", // latin small letter u with acute
+	"\u00FB": "&ucirc;",  // latin small letter u with circumflex
+	"\u00FC": "&uuml;",   // latin small letter u with diaeresis
+	"\u00FD": "&yacute;", // latin small letter y with acute
+	"\u00FE": "&thorn;",  // latin small letter thorn
+	"\u00FF": "&yuml;",   // latin small letter y with diaeresis
+}
+
+// html40ExtendedEscape holds the HTML 4 entities of the symbols, mathematical symbols
+// and Greek letters (HTML 4.01, section 24.3).
+var html40ExtendedEscape = map[string]string{
+	"\u0152": "&OElig;",    // latin capital ligature oe
+	"\u0153": "&oelig;",    // latin small ligature oe
+	"\u0160": "&Scaron;",   // latin capital letter s with caron
+	"\u0161": "&scaron;",   // latin small letter s with caron
+	"\u0178": "&Yuml;",     // latin capital letter y with diaeresis
+	"\u0192": "&fnof;",     // latin small letter f with hook
+	"\u02C6": "&circ;",     // modifier letter circumflex accent
+	"\u02DC": "&tilde;",    // small tilde
+	"\u0391": "&Alpha;",    // greek capital letter alpha
+	"\u0392": "&Beta;",     // greek capital letter beta
+	"\u0393": "&Gamma;",    // greek capital letter gamma
+	"\u0394": "&Delta;",    // greek capital letter delta
+	"\u0395": "&Epsilon;",  // greek capital letter epsilon
+	"\u0396": "&Zeta;",     // greek capital letter zeta
+	"\u0397": "&Eta;",      // greek capital letter eta
+	"\u0398": "&Theta;",    // greek capital letter theta
+	"\u0399": "&Iota;",     // greek capital letter iota
+	"\u039A": "&Kappa;",    // greek capital letter kappa
+	"\u039B": "&Lambda;",   // greek capital letter lamda
+	"\u039C": "&Mu;",       // greek capital letter mu
+	"\u039D": "&Nu;",       // greek capital letter nu
+	"\u039E": "&Xi;",       // greek capital letter xi
+	"\u039F": "&Omicron;",  // greek capital letter omicron
+	"\u03A0": "&Pi;",       // greek capital letter pi
+	"\u03A1": "&Rho;",      // greek capital letter rho
+	"\u03A3": "&Sigma;",    // greek capital letter sigma
+	"\u03A4": "&Tau;",      // greek capital letter tau
+	"\u03A5": "&Upsilon;",  // greek capital letter upsilon
+	"\u03A6": "&Phi;",      // greek capital letter phi
+	"\u03A7": "&Chi;",      // greek capital letter chi
+	"\u03A8": "&Psi;",      // greek capital letter psi
+	"\u03A9": "&Omega;",    // greek capital letter omega
+	"\u03B1": "&alpha;",    // greek small letter alpha
+	"\u03B2": "&beta;",     // greek small letter beta
+	"\u03B3": "&gamma;",    // greek small letter gamma
+	"\u03B4": "&delta;",    // greek small letter delta
+	"\u03B5": "&epsilon;",  // greek small letter epsilon
+	"\u03B6": "&zeta;",     // greek small letter zeta
+	"\u03B7": "&eta;",      // greek small letter eta
+	"\u03B8": "&theta;",    // greek small letter theta
+	"\u03B9": "&iota;",     // greek small letter iota
+	"\u03BA": "&kappa;",    // greek small letter kappa
+	"\u03BB": "&lambda;",   // greek small letter lamda
+	"\u03BC": "&mu;",       // greek small letter mu
+	"\u03BD": "&nu;",       // greek small letter nu
+	"\u03BE": "&xi;",       // greek small letter xi
+	"\u03BF": "&omicron;",  // greek small letter omicron
+	"\u03C0": "&pi;",       // greek small letter pi
+	"\u03C1": "&rho;",      // greek small letter rho
+	"\u03C2": "&sigmaf;",   // greek small letter final sigma
+	"\u03C3": "&sigma;",    // greek small letter sigma
+	"\u03C4": "&tau;",      // greek small letter tau
+	"\u03C5": "&upsilon;",  // greek small letter upsilon
+	"\u03C6": "&phi;",      // greek small letter phi
+	"\u03C7": "&chi;",      // greek small letter chi
+	"\u03C8": "&psi;",      // greek small letter psi
+	"\u03C9": "&omega;",    // greek small letter omega
+	"\u03D1": "&thetasym;", // greek theta symbol
+	"\u03D2": "&upsih;",    // greek upsilon with hook symbol
+	"\u03D6": "&piv;",      // greek pi symbol
+	"\u2002": "&ensp;",     // en space
+	"\u2003": "&emsp;",     // em space
+	"\u2009": "&thinsp;",   // thin space
+	"\u200C": "&zwnj;",     // zero width non-joiner
+	"\u200D": "&zwj;",      // zero width joiner
+	"\u200E": "&lrm;",      // left-to-right mark
+	"\u200F": "&rlm;",      // right-to-left mark
+	"\u2013": "&ndash;",    // en dash
+	"\u2014": "&mdash;",    // em dash
+	"\u2018": "&lsquo;",    // left single quotation mark
+	"\u2019": "&rsquo;",    // right single quotation mark
+	"\u201A": "&sbquo;",    // single low-9 quotation mark
+	"\u201C": "&ldquo;",    // left double quotation mark
+	"\u201D": "&rdquo;",    // right double quotation mark
+	"\u201E": "&bdquo;",    // double low-9 quotation mark
+	"\u2020": "&dagger;",   // dagger
+	"\u2021": "&Dagger;",   // double dagger
+	"\u2022": "&bull;",     // bullet
+	"\u2026": "&hellip;",   // horizontal ellipsis
+	"\u2030": "&permil;",   // per mille sign
+	"\u2032": "&prime;",    // prime
+	"\u2033": "&Prime;",    // double prime
+	"\u2039": "&lsaquo;",   // single left-pointing angle quotation mark
+	"\u203A": "&rsaquo;",   // single right-pointing angle quotation mark
+	"\u203E": "&oline;",    // overline
+	"\u2044": "&frasl;",    // fraction slash
+	"\u20AC": "&euro;",     // euro sign
+	"\u2111": "&image;",    // black-letter capital i
+	"\u2118": "&weierp;",   // script capital p
+	"\u211C": "&real;",     // black-letter capital r
+	"\u2122": "&trade;",    // trade mark sign
+	"\u2135": "&alefsym;",  // alef symbol
+	"\u2190": "&larr;",     // leftwards arrow
+	"\u2191": "&uarr;",     // upwards arrow
+	"\u2192": "&rarr;",     // rightwards arrow
+	"\u2193": "&darr;",     // downwards arrow
+	"\u2194": "&harr;",     // left right arrow
+	"\u21B5": "&crarr;",    // downwards arrow with corner leftwards
+	"\u21D0": "&lArr;",     // leftwards double arrow
+	"\u21D1": "&uArr;",     // upwards double arrow
+	"\u21D2": "&rArr;",     // rightwards double arrow
+	"\u21D3": "&dArr;",     // downwards double arrow
+	"\u21D4": "&hArr;",     // left right double arrow
+	"\u2200": "&forall;",   // for all
+	"\u2202": "&part;",     // partial differential
+	"\u2203": "&exist;",    // there exists
+	"\u2205": "&empty;",    // empty set
+	"\u2207": "&nabla;",    // nabla
+	"\u2208": "&isin;",     // element of
+	"\u2209": "&notin;",    // not an element of
+	"\u220B": "&ni;",       // contains as member
+	"\u220F": "&prod;",     // n-ary product
+	"\u2211": "&sum;",      // n-ary summation
+	"\u2212": "&minus;",    // minus sign
+	"\u2217": "&lowast;",   // asterisk operator
+	"\u221A": "&radic;",    // square root
+	"\u221D": "&prop;",     // proportional to
+	"\u221E": "&infin;",    // infinity
+	"\u2220": "&ang;",      // angle
+	"\u2227": "&and;",      // logical and
+	"\u2228": "&or;",       // logical or
+	"\u2229": "&cap;",      // intersection
+	"\u222A": "&cup;",      // union
+	"\u222B": "&int;",      // integral
+	"\u2234": "&there4;",   // therefore
+	"\u223C": "&sim;",      // tilde operator
+	"\u2245": "&cong;",     // approximately equal to
+	"\u2248": "&asymp;",    // almost equal to
+	"\u2260": "&ne;",       // not equal to
+	"\u2261": "&equiv;",    // identical to
+	"\u2264": "&le;",       // less-than or equal to
+	"\u2265": "&ge;",       // greater-than or equal to
+	"\u2282": "&sub;",      // subset of
+	"\u2283": "&sup;",      // superset of
+	"\u2284": "&nsub;",     // not a subset of
+	"\u2286": "&sube;",     // subset of or equal to
+	"\u2287": "&supe;",     // superset of or equal to
+	"\u2295": "&oplus;",    // circled plus
+	"\u2297": "&otimes;",   // circled times
+	"\u22A5": "&perp;",     // up tack
+	"\u22C5": "&sdot;",     // dot operator
+	"\u2308": "&lceil;",    // left ceiling
+	"\u2309": "&rceil;",    // right ceiling
+	"\u230A": "&lfloor;",   // left floor
+	"\u230B": "&rfloor;",   // right floor
+	"\u2329": "&lang;",     // left-pointing angle bracket
+	"\u232A": "&rang;",     // right-pointing angle bracket
+	"\u25CA": "&loz;",      // lozenge
+	"\u2660": "&spades;",   // black spade suit
+	"\u2663": "&clubs;",    // black club suit
+	"\u2665": "&hearts;",   // black heart suit
+	"\u2666": "&diams;",    // black diamond suit
+}
+
+// copyTable returns a copy of a lookup table.
+func copyTable(table map[string]string) map[string]string {
+	copied := make(map[string]string, len(table))
+	for k, v := range table {
+		copied[k] = v
+	}
+	return copied
+}
+
+// BasicEscapeTable returns the lookup table escaping the characters which have a meaning in XML and HTML
+// (", &, < and >).
+func BasicEscapeTable() map[string]string {
+	return copyTable(basicEscape)
+}
+
+// AposEscapeTable returns the lookup table escaping the apostrophe as an XML entity.
+func AposEscapeTable() map[string]string {
+	return copyTable(aposEscape)
+}
+
+// ISOLatin1EscapeTable returns the lookup table escaping the ISO-8859-1 characters as HTML 4 entities.
+func ISOLatin1EscapeTable() map[string]string {
+	return copyTable(isoLatin1Escape)
+}
+
+// HTML40ExtendedEscapeTable returns the lookup table escaping the symbols, mathematical symbols
+// and Greek letters as HTML 4 entities.
+func HTML40ExtendedEscapeTable() map[string]string {
+	return copyTable(html40ExtendedEscape)
+}
+
+// JavaCtrlCharsEscapeTable returns the lookup table escaping the control characters in Java and JSON.
+func JavaCtrlCharsEscapeTable() map[string]string {
+	return copyTable(javaCtrlCharsEscape)
+}
+
+// InvertTable returns a lookup table mapping the values of a table to its keys,
+// turning an escaping table into an unescaping one.
+func InvertTable(table map[string]string) map[string]string {
+	inverted := make(map[string]string, len(table))
+	for k, v := range table {
+		inverted[v] = k
+	}
+	return inverted
+}
@@ -0,0 +1,243 @@
+// Package escapeUtils provides utilities to escape and unescape strings for HTML, XML, JSON, CSV,
+// Java and Go, reflecting what's available in Apache Commons StringEscapeUtils.
+package escapeUtils
+
+import (
+	"html"
+	"io"
+	"strings"
+)
+
+// javaCtrlCharsEscape holds the escapes of the control characters in Java and JSON.
+var javaCtrlCharsEscape = map[string]string{
+	"\b": `\b`,
+	"\n": `\n`,
+	"\t": `\t`,
+	"\f": `\f`,
+	"\r": `\r`,
+}
+
+// EscapeJavaTranslator escapes Java string literals.
+var EscapeJavaTranslator Translator = AggregateTranslator{
+	NewLookupTranslator(map[string]string{`"`: `\"`, `\`: `\\`}),
+	NewLookupTranslator(javaCtrlCharsEscape),
+	UnicodeEscaperOutsideOf(32, 0x7F),
+}
+
+// UnescapeJavaTranslator unescapes Java string literals.
+var UnescapeJavaTranslator Translator = AggregateTranslator{
+	OctalUnescaper{},
+	UnicodeUnescaper{},
+	NewLookupTranslator(InvertTable(javaCtrlCharsEscape)),
+	NewLookupTranslator(map[string]string{`\\`: `\`, `\"`: `"`, `\'`: `'`, `\`: ``}),
+}
+
+// EscapeJSONTranslator escapes JSON string literals.
+var EscapeJSONTranslator Translator = AggregateTranslator{
+	NewLookupTranslator(map[string]string{`"`: `\"`, `\`: `\\`, `/`: `\/`}),
+	NewLookupTranslator(javaCtrlCharsEscape),
+	UnicodeEscaperOutsideOf(32, 0x7E),
+}
+
+// UnescapeJSONTranslator unescapes JSON string literals.
+var UnescapeJSONTranslator = UnescapeJavaTranslator
+
+// EscapeGoTranslator escapes double-quoted Go string literals.
+var EscapeGoTranslator Translator = TranslatorFunc(goEscaper)
+
+// UnescapeGoTranslator unescapes double-quoted Go string literals.
+var UnescapeGoTranslator Translator = TranslatorFunc(goUnescaper)
+
+// EscapeXML10Translator escapes XML 1.0 text.
+var EscapeXML10Translator Translator = AggregateTranslator{
+	NewLookupTranslator(basicEscape, aposEscape),
+	CodePointRemover(0x00, 0x08),
+	CodePointRemover(0x0B, 0x0C),
+	CodePointRemover(0x0E, 0x1F),
+	NumericEntityEscaperBetween(0x7F, 0x84),
+	NumericEntityEscaperBetween(0x86, 0x9F),
+	CodePointRemover(0xFFFE, 0xFFFF),
+}
+
+// EscapeXML11Translator escapes XML 1.1 text.
+var EscapeXML11Translator Translator = AggregateTranslator{
+	NewLookupTranslator(basicEscape, aposEscape),
+	CodePointRemover(0x00, 0x00),
+	NumericEntityEscaperBetween(0x01, 0x08),
+	NumericEntityEscaperBetween(0x0B, 0x0C),
+	NumericEntityEscaperBetween(0x0E, 0x1F),
+	NumericEntityEscaperBetween(0x7F, 0x84),
+	NumericEntityEscaperBetween(0x86, 0x9F),
+	CodePointRemover(0xFFFE, 0xFFFF),
+}
+
+// UnescapeXMLTranslator unescapes XML text.
+var UnescapeXMLTranslator Translator = AggregateTranslator{
+	NewLookupTranslator(InvertTable(basicEscape), InvertTable(aposEscape)),
+	NumericEntityUnescaper{SemicolonRequired: true},
+}
+
+// EscapeHTML4Translator escapes HTML 4 text.
+var EscapeHTML4Translator Translator = NewLookupTranslator(basicEscape, isoLatin1Escape, html40ExtendedEscape)
+
+// UnescapeHTML4Translator unescapes HTML 4 text.
+var UnescapeHTML4Translator Translator = AggregateTranslator{
+	NewLookupTranslator(InvertTable(basicEscape), InvertTable(isoLatin1Escape), InvertTable(html40ExtendedEscape)),
+	NumericEntityUnescaper{SemicolonRequired: true},
+}
+
+// EscapeHTML5Translator escapes HTML5 text.
+var EscapeHTML5Translator Translator = NewLookupTranslator(basicEscape, aposEscape)
+
+// UnescapeHTML5Translator unescapes HTML5 text.
+var UnescapeHTML5Translator Translator = TranslatorFunc(html5Unescaper)
+
+// EscapeCSVTranslator escapes a whole CSV field.
+var EscapeCSVTranslator Translator = TranslatorFunc(csvEscaper)
+
+// UnescapeCSVTranslator unescapes a whole CSV field.
+var UnescapeCSVTranslator Translator = TranslatorFunc(csvUnescaper)
+
+// html5Unescaper unescapes a named or numeric HTML5 character reference using the entity table
+// of the html package.
+func html5Unescaper(input string, w io.Writer) (int, error) {
+	if len(input) < 3 || input[0] != '&' {
+		return 0, nil
+	}
+	end := 1
+	for end < len(input) && end < 40 && input[end] != ';' && input[end] != '&' && input[end] != ' ' {
+		end++
+	}
+	if end < len(input) && input[end] == ';' {
+		end++
+	}
+	unescaped := html.UnescapeString(input[:end])
+	if unescaped == input[:end] {
+		return 0, nil
+	}
+	// references without a trailing semicolon may be shorter than the scanned text
+	tail := 0
+	for tail < end-1 && strings.HasSuffix(unescaped, input[end-tail-1:end]) {
+		tail++
+	}
+	_, err := io.WriteString(w, unescaped[:len(unescaped)-tail])
+	return end - tail, err
+}
+
+const csvQuote = `"`
+
+// csvSearchChars are the characters which require a CSV field to be quoted.
+const csvSearchChars = ",\"\r\n"
+
+// EscapeCSV returns a string value for a CSV column (RFC 4180).
+// If the value contains a comma, a newline or a double quote, it is enclosed in double quotes,
+// and any double quote it contains is escaped with another double quote.
+//
+//	EscapeCSV(`a,b`)      = `"a,b"`
+//	EscapeCSV(`say "hi"`) = `"say ""hi"""`
+func EscapeCSV(str string) string {
+	if !strings.ContainsAny(str, csvSearchChars) {
+		return str
+	}
+	return csvQuote + strings.Replace(str, csvQuote, csvQuote+csvQuote, -1) + csvQuote
+}
+
+// UnescapeCSV returns a string value for an unescaped CSV column (RFC 4180).
+// If the value is enclosed in double quotes and contains a comma, a newline or a double quote,
+// the quotes are removed and any pair of double quotes is unescaped to a single one.
+func UnescapeCSV(str string) string {
+	if len(str) < 2 || !strings.HasPrefix(str, csvQuote) || !strings.HasSuffix(str, csvQuote) {
+		return str
+	}
+	quoteless := str[1 : len(str)-1]
+	if !strings.ContainsAny(quoteless, csvSearchChars) {
+		return str
+	}
+	return strings.Replace(quoteless, csvQuote+csvQuote, csvQuote, -1)
+}
+
+// EscapeGo escapes a string so that it can be put between double quotes in Go source code.
+// Printable characters are kept, others use the same escapes as strconv.Quote.
+//
+//	EscapeGo("He said \"héllo\"\n") = `He said \"héllo\"\n`
+func EscapeGo(str string) string {
+	return TranslateString(EscapeGoTranslator, str)
+}
+
+// UnescapeGo unescapes the escape sequences of a double-quoted Go string literal.
+// Invalid escape sequences are kept as is.
+func UnescapeGo(str string) string {
+	return TranslateString(UnescapeGoTranslator, str)
+}
+
+// EscapeHTML4 escapes the characters in a string using HTML 4 entities.
+//
+//	EscapeHTML4(`"bread" & "butter"`) = `&quot;bread&quot; &amp; &quot;butter&quot;`
+//	EscapeHTML4("crème brûlée")      = "cr&egrave;me br&ucirc;l&eacute;e"
+func EscapeHTML4(str string) string {
+	return TranslateString(EscapeHTML4Translator, str)
+}
+
+// UnescapeHTML4 unescapes the HTML 4 entities and the numeric entities of a string.
+// Unknown entities are kept as is.
+func UnescapeHTML4(str string) string {
+	return TranslateString(UnescapeHTML4Translator, str)
+}
+
+// EscapeHTML5 escapes the characters which have a meaning in HTML5 (&, <, >, " and '),
+// other characters being left to the document encoding.
+func EscapeHTML5(str string) string {
+	return TranslateString(EscapeHTML5Translator, str)
+}
+
+// UnescapeHTML5 unescapes the HTML5 named character references and the numeric entities of a string.
+func UnescapeHTML5(str string) string {
+	return TranslateString(UnescapeHTML5Translator, str)
+}
+
+// EscapeJava escapes the characters in a string using Java string rules.
+// Characters outside of the printable ASCII range are escaped as \uXXXX.
+//
+//	EscapeJava("He didn't say, \"Stop!\"") = `He didn't say, \"Stop!\"`
+func EscapeJava(str string) string {
+	return TranslateString(EscapeJavaTranslator, str)
+}
+
+// UnescapeJava unescapes any Java literal found in a string, including octal and unicode escapes.
+func UnescapeJava(str string) string {
+	return TranslateString(UnescapeJavaTranslator, str)
+}
+
+// EscapeJSON escapes the characters in a string using JSON string rules.
+// Characters outside of the printable ASCII range are escaped as \uXXXX.
+//
+//	EscapeJSON(`He didn't say, "Stop!"`) = `He didn't say, \"Stop!\"`
+func EscapeJSON(str string) string {
+	return TranslateString(EscapeJSONTranslator, str)
+}
+
+// UnescapeJSON unescapes any JSON literal found in a string.
+func UnescapeJSON(str string) string {
+	return TranslateString(UnescapeJSONTranslator, str)
+}
+
+// EscapeXML10 escapes the characters in a string using XML 1.0 entities.
+// Characters which are not allowed in XML 1.0 are removed, and the discouraged ones
+// are escaped as numeric entities.
+//
+//	EscapeXML10(`"bread" & 'butter'`) = `&quot;bread&quot; &amp; &apos;butter&apos;`
+func EscapeXML10(str string) string {
+	return TranslateString(EscapeXML10Translator, str)
+}
+
+// EscapeXML11 escapes the characters in a string using XML 1.1 entities.
+// The null character and the non-characters U+FFFE and U+FFFF are removed, the restricted
+// characters are escaped as numeric entities.
+func EscapeXML11(str string) string {
+	return TranslateString(EscapeXML11Translator, str)
+}
+
+// UnescapeXML unescapes the five XML entities and the numeric entities of a string.
+func UnescapeXML(str string) string {
+	return TranslateString(UnescapeXMLTranslator, str)
+}
@@ -0,0 +1,184 @@
+package escapeUtils
+
+import "testing"
+
+func TestEscapeCSV(t *testing.T) {
+	if EscapeCSV("") != "" || EscapeCSV("foo") != "foo" {
+		t.Errorf("fail test EscapeCSV 1")
+	}
+	if EscapeCSV("foo,bar") != `"foo,bar"` {
+		t.Errorf("fail test EscapeCSV 2")
+	}
+	if EscapeCSV(`say "hi"`) != `"say ""hi"""` {
+		t.Errorf("fail test EscapeCSV 3")
+	}
+	if EscapeCSV("foo\r\nbar") != "\"foo\r\nbar\"" {
+		t.Errorf("fail test EscapeCSV 4")
+	}
+}
+
+func TestUnescapeCSV(t *testing.T) {
+	if UnescapeCSV("") != "" || UnescapeCSV("foo") != "foo" || UnescapeCSV(`"`) != `"` {
+		t.Errorf("fail test UnescapeCSV 1")
+	}
+	if UnescapeCSV(`"foo,bar"`) != "foo,bar" {
+		t.Errorf("fail test UnescapeCSV 2")
+	}
+	if UnescapeCSV(`"say ""hi"""`) != `say "hi"` {
+		t.Errorf("fail test UnescapeCSV 3")
+	}
+	if UnescapeCSV(`"foo"`) != `"foo"` {
+		t.Errorf("fail test UnescapeCSV 4")
+	}
+}
+
+func TestEscapeGo(t *testing.T) {
+	if EscapeGo("") != "" || EscapeGo("foo") != "foo" {
+		t.Errorf("fail test EscapeGo 1")
+	}
+	if EscapeGo("He said \"héllo\"\n") != `He said \"héllo\"\n` {
+		t.Errorf("fail test EscapeGo 2")
+	}
+	if EscapeGo("it's a\\b\t\x00\xff\u200b") != `it's a\\b\t\x00\xff\u200b` {
+		t.Errorf("fail test EscapeGo 3")
+	}
+}
+
+func TestUnescapeGo(t *testing.T) {
+	if UnescapeGo("") != "" || UnescapeGo("foo") != "foo" {
+		t.Errorf("fail test UnescapeGo 1")
+	}
+	if UnescapeGo(`He said \"héllo\"\n`) != "He said \"héllo\"\n" {
+		t.Errorf("fail test UnescapeGo 2")
+	}
+	if UnescapeGo(`\x41\101\U0001F600\xff`) != "AA😀\xff" {
+		t.Errorf("fail test UnescapeGo 3")
+	}
+	if UnescapeGo(`bad \q escape\`) != `bad \q escape\` {
+		t.Errorf("fail test UnescapeGo 4")
+	}
+	str := "any\t\"string\" \\ with é and \x01  "
+	if UnescapeGo(EscapeGo(str)) != str {
+		t.Errorf("fail test UnescapeGo 5")
+	}
+}
+
+func TestEscapeHTML4(t *testing.T) {
+	if EscapeHTML4("") != "" || EscapeHTML4("foo") != "foo" {
+		t.Errorf("fail test EscapeHTML4 1")
+	}
+	if EscapeHTML4(`"bread" & "butter"`) != `&quot;bread&quot; &amp; &quot;butter&quot;` {
+		t.Errorf("fail test EscapeHTML4 2")
+	}
+	if EscapeHTML4("crème brûlée") != "cr&egrave;me br&ucirc;l&eacute;e" {
+		t.Errorf("fail test EscapeHTML4 3")
+	}
+	if EscapeHTML4("<α ≤ β>'") != "&lt;&alpha; &le; &beta;&gt;'" {
+		t.Errorf("fail test EscapeHTML4 4")
+	}
+}
+
+func TestUnescapeHTML4(t *testing.T) {
+	if UnescapeHTML4("") != "" || UnescapeHTML4("foo") != "foo" {
+		t.Errorf("fail test UnescapeHTML4 1")
+	}
+	if UnescapeHTML4("&quot;bread&quot; &amp; &quot;butter&quot;") != `"bread" & "butter"` {
+		t.Errorf("fail test UnescapeHTML4 2")
+	}
+	if UnescapeHTML4("cr&egrave;me &#98;r&#xFB;l&#233;e") != "crème brûlée" {
+		t.Errorf("fail test UnescapeHTML4 3")
+	}
+	if UnescapeHTML4("&foo; &amp &#12") != "&foo; &amp &#12" {
+		t.Errorf("fail test UnescapeHTML4 4")
+	}
+}
+
+func TestEscapeHTML5(t *testing.T) {
+	if EscapeHTML5(`<a href="x">Tom & Jerry's</a>`) != `&lt;a href=&quot;x&quot;&gt;Tom &amp; Jerry&apos;s&lt;/a&gt;` {
+		t.Errorf("fail test EscapeHTML5 1")
+	}
+	if EscapeHTML5("crème") != "crème" {
+		t.Errorf("fail test EscapeHTML5 2")
+	}
+}
+
+func TestUnescapeHTML5(t *testing.T) {
+	if UnescapeHTML5("") != "" || UnescapeHTML5("Tom & Jerry") != "Tom & Jerry" {
+		t.Errorf("fail test UnescapeHTML5 1")
+	}
+	if UnescapeHTML5("&lt;p&gt;&NotEqualTilde; &apos;&hellip;&apos; &#x1F600;") != "<p>≂̸ '…' 😀" {
+		t.Errorf("fail test UnescapeHTML5 2")
+	}
+	if UnescapeHTML5("&notit; &amp &unknown;") != "¬it; & &unknown;" {
+		t.Errorf("fail test UnescapeHTML5 3")
+	}
+}
+
+func TestEscapeJava(t *testing.T) {
+	if EscapeJava("") != "" || EscapeJava("foo") != "foo" {
+		t.Errorf("fail test EscapeJava 1")
+	}
+	if EscapeJava("He didn't say, \"Stop!\"\n") != `He didn't say, \"Stop!\"\n` {
+		t.Errorf("fail test EscapeJava 2")
+	}
+	if EscapeJava("é\\/😀\x01") != `\u00E9\\/\uD83D\uDE00\u0001` {
+		t.Errorf("fail test EscapeJava 3")
+	}
+}
+
+func TestUnescapeJava(t *testing.T) {
+	if UnescapeJava("") != "" || UnescapeJava("foo") != "foo" {
+		t.Errorf("fail test UnescapeJava 1")
+	}
+	if UnescapeJava(`He didn\'t say, \"Stop!\"\n`) != "He didn't say, \"Stop!\"\n" {
+		t.Errorf("fail test UnescapeJava 2")
+	}
+	if UnescapeJava(`é\\😀\uuu0041\101\0\477`) != "é\\😀AA\x00'7" {
+		t.Errorf("fail test UnescapeJava 3")
+	}
+}
+
+func TestEscapeJSON(t *testing.T) {
+	if EscapeJSON(`He didn't say, "Stop!"`) != `He didn't say, \"Stop!\"` {
+		t.Errorf("fail test EscapeJSON 1")
+	}
+	if EscapeJSON("a/b\t\u007fé") != `a\/b\t\u007F\u00E9` {
+		t.Errorf("fail test EscapeJSON 2")
+	}
+}
+
+func TestUnescapeJSON(t *testing.T) {
+	if UnescapeJSON(`He didn't say, \"Stop!\"`) != `He didn't say, "Stop!"` {
+		t.Errorf("fail test UnescapeJSON 1")
+	}
+	if UnescapeJSON(`a\/b\té😀`) != "a/b\té😀" {
+		t.Errorf("fail test UnescapeJSON 2")
+	}
+}
+
+func TestEscapeXML10(t *testing.T) {
+	if EscapeXML10(`"bread" & 'butter'`) != `&quot;bread&quot; &amp; &apos;butter&apos;` {
+		t.Errorf("fail test EscapeXML10 1")
+	}
+	if EscapeXML10("a\x00b\x0bc\td\u0080e\u0085f￿é") != "abc\td&#128;e\u0085fé" {
+		t.Errorf("fail test EscapeXML10 2")
+	}
+}
+
+func TestEscapeXML11(t *testing.T) {
+	if EscapeXML11("<a>") != "&lt;a&gt;" {
+		t.Errorf("fail test EscapeXML11 1")
+	}
+	if EscapeXML11("a\x00b\x0bc\td\u0080e￿") != "ab&#11;c\td&#128;e" {
+		t.Errorf("fail test EscapeXML11 2")
+	}
+}
+
+func TestUnescapeXML(t *testing.T) {
+	if UnescapeXML("&lt;a&gt; &amp; &apos;b&apos; &quot;c&quot;") != `<a> & 'b' "c"` {
+		t.Errorf("fail test UnescapeXML 1")
+	}
+	if UnescapeXML("&#233;&#xe9;&eacute;") != "éé&eacute;" {
+		t.Errorf("fail test UnescapeXML 2")
+	}
+}
@@ -0,0 +1,350 @@
+package escapeUtils
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Translator translates a piece of text, escaping or unescaping it.
+//
+// Translators are composable: an AggregateTranslator tries several translators in turn,
+// and Translate drives a translator over a whole string, copying the characters
+// the translator does not apply to.
+type Translator interface {
+	// Translate translates the beginning of input to w and returns the number of bytes of input
+	// which were consumed, zero meaning that the translator does not apply there.
+	Translate(input string, w io.Writer) (int, error)
+}
+
+// Translate translates a whole string with a translator, writing the result to w.
+// The characters the translator does not apply to are copied as is.
+func Translate(t Translator, input string, w io.Writer) error {
+	for pos := 0; pos < len(input); {
+		consumed, err := t.Translate(input[pos:], w)
+		if err != nil {
+			return err
+		}
+		if consumed == 0 {
+			_, size := utf8.DecodeRuneInString(input[pos:])
+			if _, err := io.WriteString(w, input[pos:pos+size]); err != nil {
+				return err
+			}
+			consumed = size
+		}
+		pos += consumed
+	}
+	return nil
+}
+
+// TranslateString translates a whole string with a translator.
+// The characters the translator does not apply to are copied as is.
+func TranslateString(t Translator, input string) string {
+	if input == "" {
+		return input
+	}
+	var out strings.Builder
+	out.Grow(len(input))
+	// writing to a strings.Builder never fails
+	_ = Translate(t, input, &out)
+	return out.String()
+}
+
+// TranslatorFunc adapts a function to the Translator interface.
+type TranslatorFunc func(input string, w io.Writer) (int, error)
+
+// Translate calls f(input, w).
+func (f TranslatorFunc) Translate(input string, w io.Writer) (int, error) {
+	return f(input, w)
+}
+
+// AggregateTranslator applies the first of its translators which consumes the input.
+type AggregateTranslator []Translator
+
+// Translate applies the first translator which consumes the beginning of input.
+func (a AggregateTranslator) Translate(input string, w io.Writer) (int, error) {
+	for _, t := range a {
+		consumed, err := t.Translate(input, w)
+		if err != nil || consumed > 0 {
+			return consumed, err
+		}
+	}
+	return 0, nil
+}
+
+// LookupTranslator translates the keys of lookup tables to their values, the longest key winning.
+type LookupTranslator struct {
+	lookup   map[string]string
+	shortest int
+	longest  int
+}
+
+// NewLookupTranslator creates a LookupTranslator from lookup tables, later tables overriding
+// the keys of earlier ones.
+func NewLookupTranslator(tables ...map[string]string) *LookupTranslator {
+	l := &LookupTranslator{lookup: make(map[string]string)}
+	for _, table := range tables {
+		for k, v := range table {
+			l.lookup[k] = v
+			if l.shortest == 0 || len(k) < l.shortest {
+				l.shortest = len(k)
+			}
+			if len(k) > l.longest {
+				l.longest = len(k)
+			}
+		}
+	}
+	return l
+}
+
+// Translate translates the longest key found at the beginning of input.
+func (l *LookupTranslator) Translate(input string, w io.Writer) (int, error) {
+	longest := l.longest
+	if longest > len(input) {
+		longest = len(input)
+	}
+	for i := longest; i >= l.shortest && i > 0; i-- {
+		if v, ok := l.lookup[input[:i]]; ok {
+			_, err := io.WriteString(w, v)
+			return i, err
+		}
+	}
+	return 0, nil
+}
+
+// CodePointTranslator translates a single character (rune), reporting whether it did.
+type CodePointTranslator func(r rune, w io.Writer) (bool, error)
+
+// Translate translates the first character of input.
+func (c CodePointTranslator) Translate(input string, w io.Writer) (int, error) {
+	r, size := utf8.DecodeRuneInString(input)
+	translated, err := c(r, w)
+	if translated {
+		return size, err
+	}
+	return 0, err
+}
+
+// numericEntityEscaper escapes the characters inside (or outside) a range as numeric entities (&#123;).
+func numericEntityEscaper(below rune, above rune, between bool) CodePointTranslator {
+	return func(r rune, w io.Writer) (bool, error) {
+		if (r >= below && r <= above) != between {
+			return false, nil
+		}
+		_, err := io.WriteString(w, "&#"+strconv.Itoa(int(r))+";")
+		return true, err
+	}
+}
+
+// NumericEntityEscaperBetween escapes the characters between below and above (inclusive)
+// as decimal numeric entities (&#123;).
+func NumericEntityEscaperBetween(below rune, above rune) CodePointTranslator {
+	return numericEntityEscaper(below, above, true)
+}
+
+// NumericEntityEscaperOutsideOf escapes the characters outside of below and above (inclusive)
+// as decimal numeric entities (&#123;).
+func NumericEntityEscaperOutsideOf(below rune, above rune) CodePointTranslator {
+	return numericEntityEscaper(below, above, false)
+}
+
+// unicodeEscaper escapes the characters inside (or outside) a range as \uXXXX,
+// using surrogate pairs above U+FFFF.
+func unicodeEscaper(below rune, above rune, between bool) CodePointTranslator {
+	return func(r rune, w io.Writer) (bool, error) {
+		if (r >= below && r <= above) != between {
+			return false, nil
+		}
+		if r > 0xFFFF {
+			r -= 0x10000
+			_, err := io.WriteString(w, unicodeEscape(0xD800+(r>>10)&0x3FF)+unicodeEscape(0xDC00+r&0x3FF))
+			return true, err
+		}
+		_, err := io.WriteString(w, unicodeEscape(r))
+		return true, err
+	}
+}
+
+// unicodeEscape returns the \uXXXX escape of a character of the Basic Multilingual Plane.
+func unicodeEscape(r rune) string {
+	hex := strings.ToUpper(strconv.FormatInt(int64(r), 16))
+	return `\u` + strings.Repeat("0", 4-len(hex)) + hex
+}
+
+// UnicodeEscaperBetween escapes the characters between below and above (inclusive) as \uXXXX,
+// using UTF-16 surrogate pairs for supplementary characters.
+func UnicodeEscaperBetween(below rune, above rune) CodePointTranslator {
+	return unicodeEscaper(below, above, true)
+}
+
+// UnicodeEscaperOutsideOf escapes the characters outside of below and above (inclusive) as \uXXXX,
+// using UTF-16 surrogate pairs for supplementary characters.
+func UnicodeEscaperOutsideOf(below rune, above rune) CodePointTranslator {
+	return unicodeEscaper(below, above, false)
+}
+
+// CodePointRemover removes the characters between below and above (inclusive).
+func CodePointRemover(below rune, above rune) CodePointTranslator {
+	return func(r rune, w io.Writer) (bool, error) {
+		return r >= below && r <= above, nil
+	}
+}
+
+// NumericEntityUnescaper unescapes decimal (&#123;) and hexadecimal (&#x7B;) numeric entities.
+type NumericEntityUnescaper struct {
+	// SemicolonRequired only unescapes the entities terminated by a semicolon.
+	SemicolonRequired bool
+}
+
+// Translate unescapes the numeric entity found at the beginning of input.
+func (n NumericEntityUnescaper) Translate(input string, w io.Writer) (int, error) {
+	if len(input) < 3 || input[0] != '&' || input[1] != '#' {
+		return 0, nil
+	}
+	start := 2
+	base := 10
+	if input[start] == 'x' || input[start] == 'X' {
+		start++
+		base = 16
+	}
+	end := start
+	for end < len(input) && isDigit(input[end], base) {
+		end++
+	}
+	if end == start {
+		return 0, nil
+	}
+	semicolon := end < len(input) && input[end] == ';'
+	if !semicolon && n.SemicolonRequired {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(input[start:end], base, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) {
+		return 0, nil
+	}
+	if semicolon {
+		end++
+	}
+	_, err = io.WriteString(w, string(rune(value)))
+	return end, err
+}
+
+// isDigit checks if a byte is a digit in base 10 or 16.
+func isDigit(c byte, base int) bool {
+	if c >= '0' && c <= '9' {
+		return true
+	}
+	return base == 16 && ((c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F'))
+}
+
+// UnicodeUnescaper unescapes \uXXXX sequences, combining UTF-16 surrogate pairs.
+// Any number of 'u' may follow the backslash, as allowed in Java.
+type UnicodeUnescaper struct{}
+
+// Translate unescapes the \uXXXX sequence found at the beginning of input.
+func (UnicodeUnescaper) Translate(input string, w io.Writer) (int, error) {
+	r, consumed := parseUnicodeEscape(input)
+	if consumed == 0 {
+		return 0, nil
+	}
+	if r >= 0xD800 && r < 0xDC00 {
+		if low, next := parseUnicodeEscape(input[consumed:]); next > 0 && low >= 0xDC00 && low < 0xE000 {
+			r = 0x10000 + (r-0xD800)<<10 + (low - 0xDC00)
+			consumed += next
+		}
+	}
+	_, err := io.WriteString(w, string(r))
+	return consumed, err
+}
+
+// parseUnicodeEscape parses a \uXXXX sequence at the beginning of input.
+func parseUnicodeEscape(input string) (rune, int) {
+	if len(input) < 2 || input[0] != '\\' || input[1] != 'u' {
+		return 0, 0
+	}
+	i := 2
+	for i < len(input) && input[i] == 'u' {
+		i++
+	}
+	if i < len(input) && input[i] == '+' {
+		i++
+	}
+	if i+4 > len(input) {
+		return 0, 0
+	}
+	value, err := strconv.ParseUint(input[i:i+4], 16, 32)
+	if err != nil {
+		return 0, 0
+	}
+	return rune(value), i + 4
+}
+
+// OctalUnescaper unescapes Java octal escapes, from \0 to \377.
+type OctalUnescaper struct{}
+
+// Translate unescapes the octal escape found at the beginning of input.
+func (OctalUnescaper) Translate(input string, w io.Writer) (int, error) {
+	if len(input) < 2 || input[0] != '\\' || input[1] < '0' || input[1] > '7' {
+		return 0, nil
+	}
+	end := 2
+	for end < len(input) && end < 4 && input[end] >= '0' && input[end] <= '7' {
+		end++
+	}
+	// three digits escapes are only allowed up to \377
+	if end == 4 && input[1] > '3' {
+		end = 3
+	}
+	value, _ := strconv.ParseUint(input[1:end], 8, 32)
+	_, err := io.WriteString(w, string(rune(value)))
+	return end, err
+}
+
+// goEscaper escapes a character the way it would appear in a double-quoted Go string literal.
+func goEscaper(input string, w io.Writer) (int, error) {
+	r, size := utf8.DecodeRuneInString(input)
+	var escaped string
+	switch {
+	case r == utf8.RuneError && size == 1:
+		escaped = `\x` + strconv.FormatUint(uint64(input[0])|0x100, 16)[1:]
+	case r == '"':
+		escaped = `\"`
+	case r == '\'':
+		escaped = `'`
+	default:
+		quoted := strconv.QuoteRune(r)
+		escaped = quoted[1 : len(quoted)-1]
+	}
+	_, err := io.WriteString(w, escaped)
+	return size, err
+}
+
+// goUnescaper unescapes the escape sequences of a double-quoted Go string literal.
+func goUnescaper(input string, w io.Writer) (int, error) {
+	if len(input) < 2 || input[0] != '\\' {
+		return 0, nil
+	}
+	value, multibyte, tail, err := strconv.UnquoteChar(input, '"')
+	if err != nil {
+		return 0, nil
+	}
+	if multibyte || value >= utf8.RuneSelf && input[1] != 'x' && (input[1] < '0' || input[1] > '7') {
+		_, err = io.WriteString(w, string(value))
+	} else {
+		_, err = w.Write([]byte{byte(value)})
+	}
+	return len(input) - len(tail), err
+}
+
+// csvEscaper escapes a whole CSV field.
+func csvEscaper(input string, w io.Writer) (int, error) {
+	_, err := io.WriteString(w, EscapeCSV(input))
+	return len(input), err
+}
+
+// csvUnescaper unescapes a whole CSV field.
+func csvUnescaper(input string, w io.Writer) (int, error) {
+	_, err := io.WriteString(w, UnescapeCSV(input))
+	return len(input), err
+}
@@ -0,0 +1,140 @@
+package escapeUtils
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	var out strings.Builder
+	if err := Translate(EscapeXML10Translator, "a < b", &out); err != nil || out.String() != "a &lt; b" {
+		t.Errorf("fail test Translate 1")
+	}
+	if err := Translate(EscapeXML10Translator, "a < b", failingWriter{}); err == nil {
+		t.Errorf("fail test Translate 2")
+	}
+	if TranslateString(EscapeHTML4Translator, "") != "" {
+		t.Errorf("fail test Translate 3")
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestAggregateTranslator(t *testing.T) {
+	// a custom escaper for a templating DSL where {{ and }} are delimiters
+	dslEscaper := AggregateTranslator{
+		NewLookupTranslator(map[string]string{"{{": `\{\{`, "}}": `\}\}`}),
+		EscapeHTML4Translator,
+		CodePointRemover(0x00, 0x1F),
+	}
+	if TranslateString(dslEscaper, "{{ a & b }}\x01") != `\{\{ a &amp; b \}\}` {
+		t.Errorf("fail test AggregateTranslator 1")
+	}
+	if TranslateString(dslEscaper, "{ é }") != "{ &eacute; }" {
+		t.Errorf("fail test AggregateTranslator 2")
+	}
+	if TranslateString(AggregateTranslator{}, "foo") != "foo" {
+		t.Errorf("fail test AggregateTranslator 3")
+	}
+}
+
+func TestLookupTranslator(t *testing.T) {
+	lookup := NewLookupTranslator(map[string]string{"a": "1", "ab": "2"}, map[string]string{"a": "3"})
+	if TranslateString(lookup, "abac") != "23c" {
+		t.Errorf("fail test LookupTranslator 1")
+	}
+	if TranslateString(NewLookupTranslator(), "abc") != "abc" {
+		t.Errorf("fail test LookupTranslator 2")
+	}
+}
+
+func TestTranslatorFunc(t *testing.T) {
+	upper := TranslatorFunc(func(input string, w io.Writer) (int, error) {
+		if input[0] < 'a' || input[0] > 'z' {
+			return 0, nil
+		}
+		_, err := io.WriteString(w, strings.ToUpper(input[:1]))
+		return 1, err
+	})
+	if TranslateString(upper, "héllo") != "HéLLO" {
+		t.Errorf("fail test TranslatorFunc 1")
+	}
+}
+
+func TestCodePointTranslators(t *testing.T) {
+	if TranslateString(NumericEntityEscaperBetween('a', 'b'), "abc") != "&#97;&#98;c" {
+		t.Errorf("fail test CodePointTranslators 1")
+	}
+	if TranslateString(NumericEntityEscaperOutsideOf(0x20, 0x7E), "café") != "caf&#233;" {
+		t.Errorf("fail test CodePointTranslators 2")
+	}
+	if TranslateString(UnicodeEscaperBetween('a', 'a'), "abc") != `\u0061bc` {
+		t.Errorf("fail test CodePointTranslators 3")
+	}
+	if TranslateString(UnicodeEscaperOutsideOf(0x20, 0x7E), "a😀") != `a\uD83D\uDE00` {
+		t.Errorf("fail test CodePointTranslators 4")
+	}
+	if TranslateString(CodePointRemover('0', '9'), "a1b22c") != "abc" {
+		t.Errorf("fail test CodePointTranslators 5")
+	}
+}
+
+func TestNumericEntityUnescaper(t *testing.T) {
+	if TranslateString(NumericEntityUnescaper{}, "&#97;&#x62;&#X63") != "abc" {
+		t.Errorf("fail test NumericEntityUnescaper 1")
+	}
+	if TranslateString(NumericEntityUnescaper{SemicolonRequired: true}, "&#97;&#98") != "a&#98" {
+		t.Errorf("fail test NumericEntityUnescaper 2")
+	}
+	if TranslateString(NumericEntityUnescaper{}, "&#;&#x;&#99999999;") != "&#;&#x;&#99999999;" {
+		t.Errorf("fail test NumericEntityUnescaper 3")
+	}
+}
+
+func TestUnicodeUnescaper(t *testing.T) {
+	if TranslateString(UnicodeUnescaper{}, `A\uuu0042\u+0043`) != "ABC" {
+		t.Errorf("fail test UnicodeUnescaper 1")
+	}
+	if TranslateString(UnicodeUnescaper{}, `\uD83D\uDE00`) != "😀" {
+		t.Errorf("fail test UnicodeUnescaper 2")
+	}
+	if TranslateString(UnicodeUnescaper{}, `\u00G1`) != `\u00G1` {
+		t.Errorf("fail test UnicodeUnescaper 3")
+	}
+}
+
+func TestOctalUnescaper(t *testing.T) {
+	if TranslateString(OctalUnescaper{}, `\0\101\377`) != "\x00Aÿ" {
+		t.Errorf("fail test OctalUnescaper 1")
+	}
+	if TranslateString(OctalUnescaper{}, `\400`) != " 0" {
+		t.Errorf("fail test OctalUnescaper 2")
+	}
+	if TranslateString(OctalUnescaper{}, `\8`) != `\8` {
+		t.Errorf("fail test OctalUnescaper 3")
+	}
+}
+
+func TestTables(t *testing.T) {
+	table := BasicEscapeTable()
+	table["&"] = "&and;"
+	if EscapeHTML4("&") != "&amp;" {
+		t.Errorf("fail test Tables 1")
+	}
+	unescaper := NewLookupTranslator(InvertTable(BasicEscapeTable()), InvertTable(AposEscapeTable()))
+	if TranslateString(unescaper, "&lt;&apos;&gt;") != "<'>" {
+		t.Errorf("fail test Tables 2")
+	}
+	if ISOLatin1EscapeTable()["é"] != "&eacute;" || HTML40ExtendedEscapeTable()["α"] != "&alpha;" {
+		t.Errorf("fail test Tables 3")
+	}
+	if JavaCtrlCharsEscapeTable()["\n"] != `\n` {
+		t.Errorf("fail test Tables 4")
+	}
+}
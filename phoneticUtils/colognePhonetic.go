@@ -0,0 +1,92 @@
+package phoneticUtils
+
+import (
+	"strings"
+)
+
+// ColognePhonetic is the Cologne phonetic encoder (Kölner Phonetik) of Hans Joachim Postel, which
+// codes the German words with digits, the words which sound alike in German having the same code.
+type ColognePhonetic struct{}
+
+// Encode returns the Cologne phonetic code of a string, only its letters being encoded.
+//
+//	ColognePhonetic{}.Encode("Müller-Lüdenscheidt") = "65752682"
+//	ColognePhonetic{}.Encode("Wikipedia")           = "3412"
+//	ColognePhonetic{}.Encode("Meyer")               = "67"
+func (ColognePhonetic) Encode(str string) string {
+	str = strings.ToUpper(strings.NewReplacer("ä", "a", "ö", "o", "ü", "u", "ß", "s", "Ä", "A", "Ö", "O", "Ü", "U").Replace(str))
+	letters := []byte(lettersOnly(str))
+	code := []byte{}
+	// last is the code of the previous letter, '/' at the start and '-' after an H
+	last := byte('/')
+	for i, letter := range letters {
+		previous, next := byte(0), byte(0)
+		if i > 0 {
+			previous = letters[i-1]
+		}
+		if i+1 < len(letters) {
+			next = letters[i+1]
+		}
+		var digit byte
+		switch letter {
+		case 'A', 'E', 'I', 'J', 'O', 'U', 'Y':
+			digit = '0'
+		case 'H':
+			digit = '-'
+		case 'B':
+			digit = '1'
+		case 'P':
+			if next == 'H' {
+				digit = '3'
+			} else {
+				digit = '1'
+			}
+		case 'D', 'T':
+			if next != 0 && strings.IndexByte("CSZ", next) >= 0 {
+				digit = '8'
+			} else {
+				digit = '2'
+			}
+		case 'F', 'V', 'W':
+			digit = '3'
+		case 'G', 'K', 'Q':
+			digit = '4'
+		case 'C':
+			switch {
+			case i == 0 && next != 0 && strings.IndexByte("AHKLOQRUX", next) >= 0:
+				digit = '4'
+			case i == 0:
+				digit = '8'
+			case previous == 'S' || previous == 'Z' || next == 0 || strings.IndexByte("AHKOQUX", next) < 0:
+				digit = '8'
+			default:
+				digit = '4'
+			}
+		case 'X':
+			if previous == 'C' || previous == 'K' || previous == 'Q' {
+				digit = '8'
+			} else {
+				// the X is coded as KS
+				if last != '4' {
+					code = append(code, '4')
+				}
+				digit = '8'
+				last = '4'
+			}
+		case 'L':
+			digit = '5'
+		case 'M', 'N':
+			digit = '6'
+		case 'R':
+			digit = '7'
+		case 'S', 'Z':
+			digit = '8'
+		}
+		// the repeated codes are coded once, and the vowels only at the start
+		if digit != '-' && digit != last && (digit != '0' || last == '/') {
+			code = append(code, digit)
+		}
+		last = digit
+	}
+	return string(code)
+}
@@ -0,0 +1,16 @@
+package phoneticUtils
+
+import "testing"
+
+func TestColognePhonetic(t *testing.T) {
+	c := ColognePhonetic{}
+	if c.Encode("") != "" || c.Encode("Müller-Lüdenscheidt") != "65752682" || c.Encode("Wikipedia") != "3412" {
+		t.Errorf("fail test ColognePhonetic 1")
+	}
+	if c.Encode("Meyer") != "67" || c.Encode("Maier") != "67" || c.Encode("Müller") != "657" || c.Encode("Mueller") != "657" {
+		t.Errorf("fail test ColognePhonetic 2")
+	}
+	if c.Encode("Breschnew") != "17863" || c.Encode("Xaver") != "4837" || c.Encode("Pharma") != "376" || c.Encode("Heinz") != "68" {
+		t.Errorf("fail test ColognePhonetic 3")
+	}
+}
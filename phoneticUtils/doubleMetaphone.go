@@ -0,0 +1,577 @@
+package phoneticUtils
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// DoubleMetaphone is the Double Metaphone encoder of Lawrence Philips, which improves Metaphone and
+// handles the names of many origins. It gives a primary code and an alternate one for the names which
+// are pronounced in two ways.
+type DoubleMetaphone struct {
+	// MaxLength is the maximum length of the codes, 4 by default.
+	MaxLength int
+}
+
+// Encode returns the primary Double Metaphone code of a string.
+//
+//	DoubleMetaphone{}.Encode("Smith")    = "SM0"
+//	DoubleMetaphone{}.Encode("Schmidt")  = "XMT"
+//	DoubleMetaphone{}.Encode("Thompson") = "TMPS"
+func (d DoubleMetaphone) Encode(str string) string {
+	primary, _ := d.encode(str)
+	return primary
+}
+
+// EncodeAlternate returns the alternate Double Metaphone code of a string, which is its primary code
+// when it is pronounced in one way only.
+//
+//	DoubleMetaphone{}.EncodeAlternate("Smith")   = "XMT"
+//	DoubleMetaphone{}.EncodeAlternate("Schmidt") = "SMT"
+func (d DoubleMetaphone) EncodeAlternate(str string) string {
+	_, alternate := d.encode(str)
+	return alternate
+}
+
+// encode returns the primary and alternate codes of a string.
+func (d DoubleMetaphone) encode(str string) (string, string) {
+	maxLength := d.MaxLength
+	if maxLength <= 0 {
+		maxLength = 4
+	}
+	w := doubleMetaphoneWord{value: doubleMetaphoneLetters(str)}
+	if len(w.value) == 0 {
+		return "", ""
+	}
+	w.slavoGermanic = w.containsAny("W", "K", "CZ", "WITZ")
+	r := &doubleMetaphoneResult{maxLength: maxLength}
+	index := 0
+	if w.contains(0, "GN", "KN", "PN", "WR", "PS") {
+		// the first letter is silent
+		index = 1
+	}
+	for !r.isComplete() && index < len(w.value) {
+		switch w.at(index) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			if index == 0 {
+				r.append("A")
+			}
+			index++
+		case 'B':
+			r.append("P")
+			index = w.skip(index, "B")
+		case 'Ç':
+			r.append("S")
+			index++
+		case 'C':
+			index = w.handleC(r, index)
+		case 'D':
+			index = w.handleD(r, index)
+		case 'F':
+			r.append("F")
+			index = w.skip(index, "F")
+		case 'G':
+			index = w.handleG(r, index)
+		case 'H':
+			if (index == 0 || w.isVowel(index-1)) && w.isVowel(index+1) {
+				r.append("H")
+				index++
+			}
+			index++
+		case 'J':
+			index = w.handleJ(r, index)
+		case 'K':
+			r.append("K")
+			index = w.skip(index, "K")
+		case 'L':
+			index = w.handleL(r, index)
+		case 'M':
+			r.append("M")
+			if w.at(index+1) == 'M' || w.contains(index-1, "UMB") && (index+1 == len(w.value)-1 || w.contains(index+2, "ER")) {
+				index++
+			}
+			index++
+		case 'N':
+			r.append("N")
+			index = w.skip(index, "N")
+		case 'Ñ':
+			r.append("N")
+			index++
+		case 'P':
+			if w.at(index+1) == 'H' {
+				r.append("F")
+				index += 2
+			} else {
+				r.append("P")
+				index = w.skip(index, "P", "B")
+			}
+		case 'Q':
+			r.append("K")
+			index = w.skip(index, "Q")
+		case 'R':
+			if index == len(w.value)-1 && !w.slavoGermanic && w.contains(index-2, "IE") && !w.contains(index-4, "ME", "MA") {
+				// the final R of French names such as "Rogier" is silent
+				r.appendBoth("", "R")
+			} else {
+				r.append("R")
+			}
+			index = w.skip(index, "R")
+		case 'S':
+			index = w.handleS(r, index)
+		case 'T':
+			index = w.handleT(r, index)
+		case 'V':
+			r.append("F")
+			index = w.skip(index, "V")
+		case 'W':
+			index = w.handleW(r, index)
+		case 'X':
+			index = w.handleX(r, index)
+		case 'Z':
+			index = w.handleZ(r, index)
+		default:
+			index++
+		}
+	}
+	return r.primary.String(), r.alternate.String()
+}
+
+// doubleMetaphoneLetters returns the upper case letters of a string to encode, without their accents
+// but for Ç and Ñ which have their own rules.
+func doubleMetaphoneLetters(str string) []rune {
+	str = stringUtils.Normalize(strings.ToUpper(strings.TrimSpace(str)), stringUtils.NFC)
+	var buff strings.Builder
+	for _, r := range str {
+		if r == 'Ç' || r == 'Ñ' || r < utf8.RuneSelf {
+			buff.WriteRune(r)
+		} else {
+			buff.WriteString(stringUtils.StripAccents(string(r)))
+		}
+	}
+	return []rune(buff.String())
+}
+
+// doubleMetaphoneResult builds the primary and alternate codes up to their maximum length.
+type doubleMetaphoneResult struct {
+	primary   strings.Builder
+	alternate strings.Builder
+	maxLength int
+}
+
+// append appends the same code to the primary and alternate codes.
+func (r *doubleMetaphoneResult) append(code string) {
+	r.appendBoth(code, code)
+}
+
+// appendBoth appends a code to the primary code and another one to the alternate code.
+func (r *doubleMetaphoneResult) appendBoth(primary string, alternate string) {
+	appendCode(&r.primary, primary, r.maxLength)
+	appendCode(&r.alternate, alternate, r.maxLength)
+}
+
+// isComplete tells whether both codes have reached their maximum length.
+func (r *doubleMetaphoneResult) isComplete() bool {
+	return r.primary.Len() >= r.maxLength && r.alternate.Len() >= r.maxLength
+}
+
+// appendCode appends a code to a builder, truncated to keep the builder within the maximum length.
+func appendCode(buff *strings.Builder, code string, maxLength int) {
+	if available := maxLength - buff.Len(); len(code) > available {
+		if available <= 0 {
+			return
+		}
+		code = code[:available]
+	}
+	buff.WriteString(code)
+}
+
+// doubleMetaphoneWord is an upper case word being encoded by Double Metaphone.
+type doubleMetaphoneWord struct {
+	value []rune
+	// slavoGermanic tells whether the word looks Slavic or Germanic
+	slavoGermanic bool
+}
+
+// at returns the letter at an index, or 0 if the index is out of the word.
+func (w doubleMetaphoneWord) at(i int) rune {
+	if i < 0 || i >= len(w.value) {
+		return 0
+	}
+	return w.value[i]
+}
+
+// isVowel tells whether the letter at an index is a vowel, Y included.
+func (w doubleMetaphoneWord) isVowel(i int) bool {
+	return w.at(i) != 0 && strings.ContainsRune("AEIOUY", w.at(i))
+}
+
+// contains tells whether one of the substrings, all of the same length, is found at an index.
+func (w doubleMetaphoneWord) contains(i int, subs ...string) bool {
+	length := len(subs[0])
+	if i < 0 || i+length > len(w.value) {
+		return false
+	}
+	value := string(w.value[i : i+length])
+	for _, sub := range subs {
+		if value == sub {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAny tells whether one of the substrings is found anywhere in the word.
+func (w doubleMetaphoneWord) containsAny(subs ...string) bool {
+	value := string(w.value)
+	for _, sub := range subs {
+		if strings.Contains(value, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// skip returns the index following a letter, skipping the next letter too if it is one of the letters given.
+func (w doubleMetaphoneWord) skip(index int, letters ...string) int {
+	if w.contains(index+1, letters...) {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (w doubleMetaphoneWord) handleC(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.isGermanicCH(index):
+		r.append("K")
+		return index + 2
+	case index == 0 && w.contains(index, "CAESAR"):
+		r.append("S")
+		return index + 2
+	case w.contains(index, "CH"):
+		return w.handleCH(r, index)
+	case w.contains(index, "CZ") && !w.contains(index-2, "WICZ"):
+		r.appendBoth("S", "X")
+		return index + 2
+	case w.contains(index+1, "CIA"):
+		r.append("X")
+		return index + 3
+	case w.contains(index, "CC") && !(index == 1 && w.at(0) == 'M'):
+		if w.contains(index+2, "I", "E", "H") && !w.contains(index+2, "HU") {
+			if index == 1 && w.at(index-1) == 'A' || w.contains(index-1, "UCCEE", "UCCES") {
+				// "accident", "succeed"
+				r.append("KS")
+			} else {
+				r.append("X")
+			}
+			return index + 3
+		}
+		r.append("K")
+		return index + 2
+	case w.contains(index, "CK", "CG", "CQ"):
+		r.append("K")
+		return index + 2
+	case w.contains(index, "CI", "CE", "CY"):
+		if w.contains(index, "CIO", "CIE", "CIA") {
+			r.appendBoth("S", "X")
+		} else {
+			r.append("S")
+		}
+		return index + 2
+	}
+	r.append("K")
+	switch {
+	case w.contains(index+1, " C", " Q", " G"):
+		return index + 3
+	case w.contains(index+1, "C", "K", "Q") && !w.contains(index+1, "CE", "CI"):
+		return index + 2
+	}
+	return index + 1
+}
+
+// isGermanicCH tells whether the C at an index is a Germanic CH, as in "Bacher" or "Chianti".
+func (w doubleMetaphoneWord) isGermanicCH(index int) bool {
+	switch {
+	case w.contains(index, "CHIA"):
+		return true
+	case index <= 1 || w.isVowel(index-2) || !w.contains(index-1, "ACH"):
+		return false
+	}
+	c := w.at(index + 2)
+	return c != 'I' && c != 'E' || w.contains(index-2, "BACHER", "MACHER")
+}
+
+func (w doubleMetaphoneWord) handleCH(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case index > 0 && w.contains(index, "CHAE"):
+		// "Michael"
+		r.appendBoth("K", "X")
+	case index == 0 && (w.contains(index+1, "HARAC", "HARIS") || w.contains(index+1, "HOR", "HYM", "HIA", "HEM")) &&
+		!w.contains(0, "CHORE"):
+		// Greek roots such as "chemistry", "chorus"
+		r.append("K")
+	case w.contains(0, "VAN ", "VON ") || w.contains(0, "SCH") || w.contains(index-2, "ORCHES", "ARCHIT", "ORCHID") ||
+		w.contains(index+2, "T", "S") ||
+		(index == 0 || w.contains(index-1, "A", "O", "U", "E")) &&
+			(w.contains(index+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || index+1 == len(w.value)-1):
+		// Germanic, Greek or before a consonant: "Bach", "orchestra", "Christ"
+		r.append("K")
+	case index > 0 && w.contains(0, "MC"):
+		r.append("K")
+	case index > 0:
+		r.appendBoth("X", "K")
+	default:
+		r.append("X")
+	}
+	return index + 2
+}
+
+func (w doubleMetaphoneWord) handleD(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.contains(index, "DG"):
+		if w.contains(index+2, "I", "E", "Y") {
+			// "edge"
+			r.append("J")
+			return index + 3
+		}
+		// "Edgar"
+		r.append("TK")
+		return index + 2
+	case w.contains(index, "DT", "DD"):
+		r.append("T")
+		return index + 2
+	}
+	r.append("T")
+	return index + 1
+}
+
+func (w doubleMetaphoneWord) handleG(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.at(index+1) == 'H':
+		return w.handleGH(r, index)
+	case w.at(index+1) == 'N':
+		switch {
+		case index == 1 && w.isVowel(0) && !w.slavoGermanic:
+			r.appendBoth("KN", "N")
+		case !w.contains(index+2, "EY") && w.at(index+1) != 'Y' && !w.slavoGermanic:
+			r.appendBoth("N", "KN")
+		default:
+			r.append("KN")
+		}
+		return index + 2
+	case w.contains(index+1, "LI") && !w.slavoGermanic:
+		// "tagliaro"
+		r.appendBoth("KL", "L")
+		return index + 2
+	case index == 0 && (w.at(index+1) == 'Y' || w.contains(index+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		r.appendBoth("K", "J")
+		return index + 2
+	case (w.contains(index+1, "ER") || w.at(index+1) == 'Y') && !w.contains(0, "DANGER", "RANGER", "MANGER") &&
+		!w.contains(index-1, "E", "I") && !w.contains(index-1, "RGY", "OGY"):
+		r.appendBoth("K", "J")
+		return index + 2
+	case w.contains(index+1, "E", "I", "Y") || w.contains(index-1, "AGGI", "OGGI"):
+		switch {
+		case w.contains(0, "VAN ", "VON ") || w.contains(0, "SCH") || w.contains(index+1, "ET"):
+			r.append("K")
+		case w.contains(index+1, "IER"):
+			r.append("J")
+		default:
+			r.appendBoth("J", "K")
+		}
+		return index + 2
+	}
+	r.append("K")
+	return w.skip(index, "G")
+}
+
+func (w doubleMetaphoneWord) handleGH(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case index > 0 && !w.isVowel(index-1):
+		r.append("K")
+	case index == 0:
+		// "ghislane", "ghetto"
+		if w.at(index+2) == 'I' {
+			r.append("J")
+		} else {
+			r.append("K")
+		}
+	case index > 1 && w.contains(index-2, "B", "H", "D") || index > 2 && w.contains(index-3, "B", "H", "D") ||
+		index > 3 && w.contains(index-4, "B", "H"):
+		// "Hugh", "bough", "broughton"
+	case index > 2 && w.at(index-1) == 'U' && w.contains(index-3, "C", "G", "L", "R", "T"):
+		// "laugh", "cough", "tough"
+		r.append("F")
+	case index > 0 && w.at(index-1) != 'I':
+		r.append("K")
+	}
+	return index + 2
+}
+
+func (w doubleMetaphoneWord) handleJ(r *doubleMetaphoneResult, index int) int {
+	if w.contains(index, "JOSE") || w.contains(0, "SAN ") {
+		// Spanish names such as "Jose" and "San Jacinto"
+		if index == 0 && w.at(index+4) == ' ' || len(w.value) == 4 || w.contains(0, "SAN ") {
+			r.append("H")
+		} else {
+			r.appendBoth("J", "H")
+		}
+		return index + 1
+	}
+	switch {
+	case index == 0:
+		r.appendBoth("J", "A")
+	case w.isVowel(index-1) && !w.slavoGermanic && (w.at(index+1) == 'A' || w.at(index+1) == 'O'):
+		r.appendBoth("J", "H")
+	case index == len(w.value)-1:
+		r.appendBoth("J", "")
+	case !w.contains(index+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !w.contains(index-1, "S", "K", "L"):
+		r.append("J")
+	}
+	return w.skip(index, "J")
+}
+
+func (w doubleMetaphoneWord) handleL(r *doubleMetaphoneResult, index int) int {
+	if w.at(index+1) != 'L' {
+		r.append("L")
+		return index + 1
+	}
+	last := len(w.value) - 1
+	if index == last-2 && w.contains(index-1, "ILLO", "ILLA", "ALLE") ||
+		(w.contains(last-1, "AS", "OS") || w.contains(last, "A", "O")) && w.contains(index-1, "ALLE") {
+		// Spanish names such as "Cabrillo" and "Gallegos"
+		r.appendBoth("L", "")
+	} else {
+		r.append("L")
+	}
+	return index + 2
+}
+
+func (w doubleMetaphoneWord) handleS(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.contains(index-1, "ISL", "YSL"):
+		// "island", "carlysle"
+		return index + 1
+	case index == 0 && w.contains(index, "SUGAR"):
+		r.appendBoth("X", "S")
+		return index + 1
+	case w.contains(index, "SH"):
+		if w.contains(index+1, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			r.append("S")
+		} else {
+			r.append("X")
+		}
+		return index + 2
+	case w.contains(index, "SIO", "SIA") || w.contains(index, "SIAN"):
+		if w.slavoGermanic {
+			r.append("S")
+		} else {
+			r.appendBoth("S", "X")
+		}
+		return index + 3
+	case index == 0 && w.contains(index+1, "M", "N", "L", "W") || w.contains(index+1, "Z"):
+		// "Smith" and "Schmidt", "Snider" and "Schneider"
+		r.appendBoth("S", "X")
+		return w.skip(index, "Z")
+	case w.contains(index, "SC"):
+		return w.handleSC(r, index)
+	case index == len(w.value)-1 && w.contains(index-2, "AI", "OI"):
+		// the final S of French names such as "Artois" is silent
+		r.appendBoth("", "S")
+	default:
+		r.append("S")
+	}
+	return w.skip(index, "S", "Z")
+}
+
+func (w doubleMetaphoneWord) handleSC(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.at(index+2) == 'H':
+		switch {
+		case w.contains(index+3, "ER", "EN"):
+			// "Schermerhorn", "Schenker"
+			r.appendBoth("X", "SK")
+		case w.contains(index+3, "OO", "UY", "ED", "EM"):
+			// Dutch names such as "school" and "schooner"
+			r.append("SK")
+		case index == 0 && !w.isVowel(3) && w.at(3) != 'W':
+			r.appendBoth("X", "S")
+		default:
+			r.append("X")
+		}
+	case w.contains(index+2, "I", "E", "Y"):
+		r.append("S")
+	default:
+		r.append("SK")
+	}
+	return index + 3
+}
+
+func (w doubleMetaphoneWord) handleT(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.contains(index, "TION"), w.contains(index, "TIA", "TCH"):
+		r.append("X")
+		return index + 3
+	case w.contains(index, "TH") || w.contains(index, "TTH"):
+		if w.contains(index+2, "OM", "AM") || w.contains(0, "VAN ", "VON ") || w.contains(0, "SCH") {
+			// "Thomas", "Thames"
+			r.append("T")
+		} else {
+			r.appendBoth("0", "T")
+		}
+		return index + 2
+	}
+	r.append("T")
+	return w.skip(index, "T", "D")
+}
+
+func (w doubleMetaphoneWord) handleW(r *doubleMetaphoneResult, index int) int {
+	switch {
+	case w.contains(index, "WR"):
+		r.append("R")
+		return index + 2
+	case index == 0 && (w.isVowel(index+1) || w.contains(index, "WH")):
+		// "Wasserman" may be pronounced with a V
+		if w.isVowel(index + 1) {
+			r.appendBoth("A", "F")
+		} else {
+			r.append("A")
+		}
+	case index == len(w.value)-1 && w.isVowel(index-1) || w.contains(index-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		w.contains(0, "SCH"):
+		// "Tsjaikowski", "Arnow"
+		r.appendBoth("", "F")
+	case w.contains(index, "WICZ", "WITZ"):
+		// Polish names such as "Filipowicz"
+		r.appendBoth("TS", "FX")
+		return index + 4
+	}
+	return index + 1
+}
+
+func (w doubleMetaphoneWord) handleX(r *doubleMetaphoneResult, index int) int {
+	if index == 0 {
+		r.append("S")
+		return index + 1
+	}
+	if !(index == len(w.value)-1 && (w.contains(index-3, "IAU", "EAU") || w.contains(index-2, "AU", "OU"))) {
+		// the final X of French names such as "Breaux" is silent
+		r.append("KS")
+	}
+	return w.skip(index, "C", "X")
+}
+
+func (w doubleMetaphoneWord) handleZ(r *doubleMetaphoneResult, index int) int {
+	if w.at(index+1) == 'H' {
+		// Chinese names such as "Zhao"
+		r.append("J")
+		return index + 2
+	}
+	if w.contains(index+1, "ZO", "ZI", "ZA") || w.slavoGermanic && index > 0 && w.at(index-1) != 'T' {
+		r.appendBoth("S", "TS")
+	} else {
+		r.append("S")
+	}
+	return w.skip(index, "Z")
+}
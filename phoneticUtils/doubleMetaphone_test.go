@@ -0,0 +1,38 @@
+package phoneticUtils
+
+import "testing"
+
+func TestDoubleMetaphone(t *testing.T) {
+	d := DoubleMetaphone{}
+	if d.Encode("") != "" || d.Encode("  ") != "" || d.Encode("Thomas") != "TMS" || d.Encode("Dumb") != "TM" {
+		t.Errorf("fail test DoubleMetaphone 1")
+	}
+	if d.Encode("Smith") != "SM0" || d.EncodeAlternate("Smith") != "XMT" || d.Encode("Schmidt") != "XMT" || d.EncodeAlternate("Schmidt") != "SMT" {
+		t.Errorf("fail test DoubleMetaphone 2")
+	}
+	if d.Encode("Jose") != "HS" || d.Encode("Caesar") != "SSR" || d.Encode("Gough") != "KF" || d.Encode("ghislane") != "JLN" {
+		t.Errorf("fail test DoubleMetaphone 3")
+	}
+	if d.Encode("Cabrillo") != "KPRL" || d.EncodeAlternate("Cabrillo") != "KPR" || d.EncodeAlternate("Gallegos") != "KKS" {
+		t.Errorf("fail test DoubleMetaphone 4")
+	}
+	if d.Encode("Michael") != "MKL" || d.EncodeAlternate("Michael") != "MXL" || d.Encode("Campbell") != "KMPL" {
+		t.Errorf("fail test DoubleMetaphone 5")
+	}
+	if d.Encode("Arnow") != "ARN" || d.EncodeAlternate("Arnow") != "ARNF" || d.EncodeAlternate("Filipowicz") != "FLPF" {
+		t.Errorf("fail test DoubleMetaphone 6")
+	}
+	if d.Encode("Artois") != "ART" || d.EncodeAlternate("Artois") != "ARTS" || d.Encode("Breaux") != "PR" || d.Encode("Zhao") != "J" {
+		t.Errorf("fail test DoubleMetaphone 7")
+	}
+	if d.Encode("François") != "FRNS" || d.Encode("Peña") != "PN" || d.Encode("Jones") != "JNS" || d.EncodeAlternate("Jones") != "ANS" {
+		t.Errorf("fail test DoubleMetaphone 8")
+	}
+	if (DoubleMetaphone{MaxLength: 6}).Encode("Thompson") != "TMPSN" || (DoubleMetaphone{MaxLength: 8}).Encode("Schwarzenegger") != "XRSNKR" {
+		t.Errorf("fail test DoubleMetaphone 9")
+	}
+	// the accents are removed, but for Ç and Ñ
+	if d.Encode("Émile") != "AML" || d.Encode("é") != "A" || d.Encode("Müller") != "MLR" || d.Encode("Garçon") != "KRSN" {
+		t.Errorf("fail test DoubleMetaphone 10")
+	}
+}
@@ -0,0 +1,169 @@
+package phoneticUtils
+
+import (
+	"strings"
+)
+
+// Metaphone is the Metaphone encoder of Lawrence Philips, which codes the consonant sounds of
+// English words, "0" standing for "th" and "X" for "sh".
+type Metaphone struct {
+	// MaxLength is the maximum length of the codes, 4 by default.
+	MaxLength int
+}
+
+// Encode returns the Metaphone code of a string, only its letters being encoded.
+//
+//	Metaphone{}.Encode("Smith")  = "SM0"
+//	Metaphone{}.Encode("knight") = "NT"
+//	Metaphone{}.Encode("jumped") = "JMPT"
+func (m Metaphone) Encode(str string) string {
+	maxLength := m.MaxLength
+	if maxLength <= 0 {
+		maxLength = 4
+	}
+	word := []byte(lettersOnly(str))
+	if len(word) <= 1 {
+		return string(word)
+	}
+	// the silent initial letters are skipped
+	switch {
+	case strings.IndexByte("KGP", word[0]) >= 0 && word[1] == 'N',
+		word[0] == 'A' && word[1] == 'E',
+		word[0] == 'W' && word[1] == 'R':
+		word = word[1:]
+	case word[0] == 'W' && word[1] == 'H':
+		word = word[1:]
+		word[0] = 'W'
+	case word[0] == 'X':
+		word[0] = 'S'
+	}
+	w := metaphoneWord(word)
+	code := []byte{}
+	for n := 0; len(code) < maxLength && n < len(word); n++ {
+		symbol := word[n]
+		// the doubled letters are coded once, but for C
+		if symbol != 'C' && w.at(n-1) == symbol {
+			continue
+		}
+		switch symbol {
+		case 'A', 'E', 'I', 'O', 'U':
+			if n == 0 {
+				code = append(code, symbol)
+			}
+		case 'B':
+			// the B of a final MB is silent
+			if w.at(n-1) != 'M' || n != len(word)-1 {
+				code = append(code, 'B')
+			}
+		case 'C':
+			switch {
+			case w.at(n-1) == 'S' && w.isFrontVowel(n+1):
+				// SCI, SCE and SCY
+			case w.matches(n, "CIA"):
+				code = append(code, 'X')
+			case w.isFrontVowel(n + 1):
+				code = append(code, 'S')
+			case w.at(n-1) == 'S' && w.at(n+1) == 'H':
+				code = append(code, 'K')
+			case w.at(n+1) == 'H' && n == 0 && len(word) >= 3 && w.isVowel(2):
+				code = append(code, 'K')
+			case w.at(n+1) == 'H':
+				code = append(code, 'X')
+			default:
+				code = append(code, 'K')
+			}
+		case 'D':
+			if w.at(n+1) == 'G' && w.isFrontVowel(n+2) {
+				code = append(code, 'J')
+				n += 2
+			} else {
+				code = append(code, 'T')
+			}
+		case 'G':
+			switch {
+			case w.at(n+1) == 'H' && (n+2 == len(word) || !w.isVowel(n+2)):
+				// the GH not followed by a vowel is silent
+			case n > 0 && w.matches(n, "GN"):
+				// the G of GN is silent
+			case w.isFrontVowel(n+1) && w.at(n-1) != 'G':
+				code = append(code, 'J')
+			default:
+				code = append(code, 'K')
+			}
+		case 'H':
+			if n < len(word)-1 && strings.IndexByte("CSPTG", w.at(n-1)) < 0 && w.isVowel(n+1) {
+				code = append(code, 'H')
+			}
+		case 'F', 'J', 'L', 'M', 'N', 'R':
+			code = append(code, symbol)
+		case 'K':
+			if w.at(n-1) != 'C' {
+				code = append(code, 'K')
+			}
+		case 'P':
+			if w.at(n+1) == 'H' {
+				code = append(code, 'F')
+			} else {
+				code = append(code, 'P')
+			}
+		case 'Q':
+			code = append(code, 'K')
+		case 'S':
+			if w.matches(n, "SH") || w.matches(n, "SIO") || w.matches(n, "SIA") {
+				code = append(code, 'X')
+			} else {
+				code = append(code, 'S')
+			}
+		case 'T':
+			switch {
+			case w.matches(n, "TIA") || w.matches(n, "TIO"):
+				code = append(code, 'X')
+			case w.matches(n, "TCH"):
+			case w.matches(n, "TH"):
+				code = append(code, '0')
+			default:
+				code = append(code, 'T')
+			}
+		case 'V':
+			code = append(code, 'F')
+		case 'W', 'Y':
+			if w.isVowel(n + 1) {
+				code = append(code, symbol)
+			}
+		case 'X':
+			code = append(code, 'K', 'S')
+		case 'Z':
+			code = append(code, 'S')
+		}
+	}
+	if len(code) > maxLength {
+		code = code[:maxLength]
+	}
+	return string(code)
+}
+
+// metaphoneWord is an upper case word being encoded by Metaphone.
+type metaphoneWord []byte
+
+// at returns the letter at an index, or 0 if the index is out of the word.
+func (w metaphoneWord) at(i int) byte {
+	if i < 0 || i >= len(w) {
+		return 0
+	}
+	return w[i]
+}
+
+// isVowel tells whether the letter at an index is a vowel.
+func (w metaphoneWord) isVowel(i int) bool {
+	return w.at(i) != 0 && strings.IndexByte("AEIOU", w.at(i)) >= 0
+}
+
+// isFrontVowel tells whether the letter at an index is E, I or Y, which soften C and G.
+func (w metaphoneWord) isFrontVowel(i int) bool {
+	return w.at(i) != 0 && strings.IndexByte("EIY", w.at(i)) >= 0
+}
+
+// matches tells whether the word has a substring at an index.
+func (w metaphoneWord) matches(i int, sub string) bool {
+	return i >= 0 && i+len(sub) <= len(w) && string(w[i:i+len(sub)]) == sub
+}
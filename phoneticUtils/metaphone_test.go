@@ -0,0 +1,33 @@
+package phoneticUtils
+
+import "testing"
+
+func TestMetaphone(t *testing.T) {
+	m := Metaphone{}
+	if m.Encode("") != "" || m.Encode("a") != "A" || m.Encode("howl") != "HL" || m.Encode("testing") != "TSTN" {
+		t.Errorf("fail test Metaphone 1")
+	}
+	if m.Encode("The") != "0" || m.Encode("quick") != "KK" || m.Encode("brown") != "BRN" || m.Encode("fox") != "FKS" {
+		t.Errorf("fail test Metaphone 2")
+	}
+	if m.Encode("jumped") != "JMPT" || m.Encode("over") != "OFR" || m.Encode("lazy") != "LS" || m.Encode("dogs") != "TKS" {
+		t.Errorf("fail test Metaphone 3")
+	}
+	// silent initial letters
+	if m.Encode("knight") != "NT" || m.Encode("Wright") != "RT" || m.Encode("Xavier") != "SFR" || m.Encode("whale") != "WL" {
+		t.Errorf("fail test Metaphone 4")
+	}
+	if m.Encode("schedule") != "SKTL" || m.Encode("character") != "KRKT" || m.Encode("teach") != "TX" || m.Encode("science") != "SNS" {
+		t.Errorf("fail test Metaphone 5")
+	}
+	if (Metaphone{MaxLength: 6}).Encode("Thompson") != "0MPSN" || (Metaphone{MaxLength: 6}).Encode("Alexander") != "ALKSNT" {
+		t.Errorf("fail test Metaphone 6")
+	}
+	// only the letters are encoded
+	if m.Encode("1") != "" || m.Encode(" ") != "" || m.Encode("-") != "" || m.Encode("  x") != m.Encode("x") || m.Encode("  xb") != m.Encode("xb") || m.Encode("Émile") != "EML" {
+		t.Errorf("fail test Metaphone 7")
+	}
+	if SoundsLikeWith(Metaphone{}, "1", "1") {
+		t.Errorf("fail test Metaphone 8")
+	}
+}
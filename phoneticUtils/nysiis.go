@@ -0,0 +1,111 @@
+package phoneticUtils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// nysiisRules are the translations of the first and last letters of a string by NYSIIS.
+var nysiisRules = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`^MAC`), "MCC"},
+	{regexp.MustCompile(`^KN`), "NN"},
+	{regexp.MustCompile(`^K`), "C"},
+	{regexp.MustCompile(`^(PH|PF)`), "FF"},
+	{regexp.MustCompile(`^SCH`), "SSS"},
+	{regexp.MustCompile(`(EE|IE)$`), "Y"},
+	{regexp.MustCompile(`(DT|RT|RD|NT|ND)$`), "D"},
+}
+
+// Nysiis is the encoder of the New York State Identification and Intelligence System, which improves
+// Soundex for the surnames.
+type Nysiis struct {
+	// MaxLength is the maximum length of the codes, 6 by default as in the original system.
+	MaxLength int
+}
+
+// Encode returns the NYSIIS code of a string, only its letters being encoded.
+//
+//	Nysiis{}.Encode("Macintosh")  = "MCANT"
+//	Nysiis{}.Encode("Knuth")      = "NAT"
+//	Nysiis{}.Encode("Phillipson") = "FALAPS"
+func (n Nysiis) Encode(str string) string {
+	maxLength := n.MaxLength
+	if maxLength <= 0 {
+		maxLength = 6
+	}
+	str = lettersOnly(str)
+	if str == "" {
+		return str
+	}
+	for _, rule := range nysiisRules {
+		str = rule.pattern.ReplaceAllLiteralString(str, rule.replacement)
+	}
+	letters := []byte(str)
+	key := []byte{letters[0]}
+	for i := 1; i < len(letters); i++ {
+		next, afterNext := byte(' '), byte(' ')
+		if i+1 < len(letters) {
+			next = letters[i+1]
+		}
+		if i+2 < len(letters) {
+			afterNext = letters[i+2]
+		}
+		copy(letters[i:], nysiisTranscode(letters[i-1], letters[i], next, afterNext))
+		if letters[i] != letters[i-1] {
+			key = append(key, letters[i])
+		}
+	}
+	if len(key) > 1 {
+		last := key[len(key)-1]
+		if last == 'S' {
+			key = key[:len(key)-1]
+			last = key[len(key)-1]
+		}
+		if len(key) > 2 && key[len(key)-2] == 'A' && last == 'Y' {
+			key = append(key[:len(key)-2], 'Y')
+		}
+		if last == 'A' {
+			key = key[:len(key)-1]
+		}
+	}
+	if len(key) > maxLength {
+		key = key[:maxLength]
+	}
+	return string(key)
+}
+
+// nysiisTranscode returns the translation of a letter from its previous and next letters.
+func nysiisTranscode(previous byte, current byte, next byte, afterNext byte) string {
+	switch {
+	case current == 'E' && next == 'V':
+		return "AF"
+	case isNysiisVowel(current):
+		return "A"
+	case current == 'Q':
+		return "G"
+	case current == 'Z':
+		return "S"
+	case current == 'M':
+		return "N"
+	case current == 'K' && next == 'N':
+		return "NN"
+	case current == 'K':
+		return "C"
+	case current == 'S' && next == 'C' && afterNext == 'H':
+		return "SSS"
+	case current == 'P' && next == 'H':
+		return "FF"
+	case current == 'H' && (!isNysiisVowel(previous) || !isNysiisVowel(next)),
+		current == 'W' && isNysiisVowel(previous):
+		return string(previous)
+	}
+	return string(current)
+}
+
+// isNysiisVowel tells whether a letter is one of the vowels A, E, I, O and U.
+func isNysiisVowel(letter byte) bool {
+	return strings.IndexByte("AEIOU", letter) >= 0
+}
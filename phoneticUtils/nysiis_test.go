@@ -0,0 +1,22 @@
+package phoneticUtils
+
+import "testing"
+
+func TestNysiis(t *testing.T) {
+	n := Nysiis{}
+	if n.Encode("") != "" || n.Encode("Macintosh") != "MCANT" || n.Encode("Knuth") != "NAT" || n.Encode("Koehn") != "CAN" {
+		t.Errorf("fail test Nysiis 1")
+	}
+	if n.Encode("Phillipson") != "FALAPS" || n.Encode("Pfeister") != "FASTAR" || n.Encode("Schoenhoeft") != "SANAFT" {
+		t.Errorf("fail test Nysiis 2")
+	}
+	if n.Encode("McKee") != "MCY" || n.Encode("Mackie") != "MCY" || n.Encode("Bart") != "BAD" || n.Encode("Hunt") != "HAD" {
+		t.Errorf("fail test Nysiis 3")
+	}
+	if n.Encode("Carraway") != "CARY" || n.Encode("Yamada") != "YANAD" || n.Encode("Vasquez") != "VASG" {
+		t.Errorf("fail test Nysiis 4")
+	}
+	if (Nysiis{MaxLength: 10}).Encode("Westerlund") != "WASTARLAD" || (Nysiis{MaxLength: 10}).Encode("Heitschmidt") != "HATSNAD" {
+		t.Errorf("fail test Nysiis 5")
+	}
+}
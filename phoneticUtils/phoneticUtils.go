@@ -0,0 +1,63 @@
+// Package phoneticUtils provides phonetic encoders, reflecting what's available in the Apache Commons Codec
+// language package, to match the names which sound alike.
+package phoneticUtils
+
+import (
+	"strings"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// Encoder encodes a string to a phonetic code, the strings which sound alike having the same code.
+type Encoder interface {
+	// Encode returns the phonetic code of a string, or "" if it has no letters to encode.
+	Encode(str string) string
+}
+
+// Similarity returns a similarity function comparing the phonetic codes of two strings with
+// the Jaro-Winkler similarity, to be used with stringUtils.ClosestMatchesWithSimilarity.
+//
+//	Similarity(Soundex{})("Robert", "Rupert") = 1
+func Similarity(encoder Encoder) stringUtils.SimilarityFunc {
+	return func(s string, t string) float64 {
+		return stringUtils.JaroWinklerSimilarity(encoder.Encode(s), encoder.Encode(t))
+	}
+}
+
+// SoundsLike tells whether two strings sound alike, their primary or alternate Double Metaphone codes
+// being equal.
+//
+//	SoundsLike("Smith", "Schmidt")     = true
+//	SoundsLike("Catherine", "Kathryn") = true
+//	SoundsLike("Smith", "Jones")       = false
+//	SoundsLike("", "")                 = false
+func SoundsLike(a string, b string) bool {
+	encoder := DoubleMetaphone{}
+	primaryA, alternateA := encoder.encode(a)
+	primaryB, alternateB := encoder.encode(b)
+	if primaryA == "" || primaryB == "" {
+		return false
+	}
+	return primaryA == primaryB || primaryA == alternateB || alternateA == primaryB || alternateA == alternateB
+}
+
+// SoundsLikeWith tells whether two strings sound alike, their codes by an encoder being equal.
+//
+//	SoundsLikeWith(Soundex{}, "Robert", "Rupert")       = true
+//	SoundsLikeWith(ColognePhonetic{}, "Meyer", "Maier") = true
+func SoundsLikeWith(encoder Encoder, a string, b string) bool {
+	code := encoder.Encode(a)
+	return code != "" && code == encoder.Encode(b)
+}
+
+// lettersOnly returns the upper case ASCII letters of a string, its accents being removed.
+func lettersOnly(str string) string {
+	str = strings.ToUpper(stringUtils.StripAccents(str))
+	var buff strings.Builder
+	for i := 0; i < len(str); i++ {
+		if str[i] >= 'A' && str[i] <= 'Z' {
+			buff.WriteByte(str[i])
+		}
+	}
+	return buff.String()
+}
@@ -0,0 +1,42 @@
+package phoneticUtils
+
+import (
+	"testing"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+func TestSoundsLike(t *testing.T) {
+	if SoundsLike("", "") || SoundsLike("Smith", "") || !SoundsLike("Smith", "Smith") {
+		t.Errorf("fail test SoundsLike 1")
+	}
+	if !SoundsLike("Smith", "Schmidt") || !SoundsLike("Catherine", "Kathryn") || !SoundsLike("Jeffrey", "Geoffrey") {
+		t.Errorf("fail test SoundsLike 2")
+	}
+	if SoundsLike("Smith", "Jones") || SoundsLike("Meyer", "Miller") {
+		t.Errorf("fail test SoundsLike 3")
+	}
+	if !SoundsLike("Émile", "Emile") || !SoundsLike("Müller", "Muller") || !SoundsLike("José", "Jose") || !SoundsLike("Zoë", "Zoe") {
+		t.Errorf("fail test SoundsLike 4")
+	}
+}
+
+func TestSoundsLikeWith(t *testing.T) {
+	if SoundsLikeWith(Soundex{}, "", "") || !SoundsLikeWith(Soundex{}, "Robert", "Rupert") || SoundsLikeWith(Soundex{}, "Robert", "Rubin") {
+		t.Errorf("fail test SoundsLikeWith 1")
+	}
+	if !SoundsLikeWith(ColognePhonetic{}, "Meyer", "Maier") || !SoundsLikeWith(Nysiis{}, "Knuth", "Nuth") {
+		t.Errorf("fail test SoundsLikeWith 2")
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	similarity := Similarity(Soundex{})
+	if similarity("Robert", "Rupert") != 1 || similarity("Smith", "Jones") >= 1 {
+		t.Errorf("fail test Similarity 1")
+	}
+	matches := stringUtils.ClosestMatchesWithSimilarity("Meier", []string{"Miller", "Mayer", "Maler"}, 1, Similarity(ColognePhonetic{}))
+	if len(matches) != 1 || matches[0].Value != "Mayer" {
+		t.Errorf("fail test Similarity 2")
+	}
+}
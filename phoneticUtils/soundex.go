@@ -0,0 +1,64 @@
+package phoneticUtils
+
+// soundexMapping holds the Soundex codes of the letters from A to Z.
+const soundexMapping = "01230120022455012623010202"
+
+// refinedSoundexMapping holds the Refined Soundex codes of the letters from A to Z.
+const refinedSoundexMapping = "01360240043788015936020505"
+
+// Soundex is the American Soundex encoder: the first letter of a string followed by the codes
+// of its next consonants, on 4 characters.
+type Soundex struct{}
+
+// Encode returns the Soundex code of a string, only its letters being encoded.
+//
+//	Soundex{}.Encode("Robert")   = "R163"
+//	Soundex{}.Encode("Ashcraft") = "A261"
+//	Soundex{}.Encode("Lee")      = "L000"
+func (Soundex) Encode(str string) string {
+	str = lettersOnly(str)
+	if str == "" {
+		return str
+	}
+	code := []byte{str[0], '0', '0', '0'}
+	count := 1
+	last := soundexMapping[str[0]-'A']
+	for i := 1; i < len(str) && count < len(code); i++ {
+		// the consonants separated by H or W are coded once
+		if str[i] == 'H' || str[i] == 'W' {
+			continue
+		}
+		digit := soundexMapping[str[i]-'A']
+		if digit != '0' && digit != last {
+			code[count] = digit
+			count++
+		}
+		last = digit
+	}
+	return string(code)
+}
+
+// RefinedSoundex is the Refined Soundex encoder, which has more codes than Soundex and does not
+// limit their length, for a finer matching.
+type RefinedSoundex struct{}
+
+// Encode returns the Refined Soundex code of a string, only its letters being encoded.
+//
+//	RefinedSoundex{}.Encode("testing") = "T6036084"
+//	RefinedSoundex{}.Encode("Braz")    = "B1905"
+func (RefinedSoundex) Encode(str string) string {
+	str = lettersOnly(str)
+	if str == "" {
+		return str
+	}
+	code := []byte{str[0]}
+	last := byte(0)
+	for i := 0; i < len(str); i++ {
+		digit := refinedSoundexMapping[str[i]-'A']
+		if digit != last {
+			code = append(code, digit)
+		}
+		last = digit
+	}
+	return string(code)
+}
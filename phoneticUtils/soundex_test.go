@@ -0,0 +1,33 @@
+package phoneticUtils
+
+import "testing"
+
+func TestSoundex(t *testing.T) {
+	s := Soundex{}
+	if s.Encode("") != "" || s.Encode("123") != "" || s.Encode("Lee") != "L000" {
+		t.Errorf("fail test Soundex 1")
+	}
+	if s.Encode("Robert") != "R163" || s.Encode("Rupert") != "R163" || s.Encode("Rubin") != "R150" {
+		t.Errorf("fail test Soundex 2")
+	}
+	// the consonants separated by H or W are coded once
+	if s.Encode("Ashcraft") != "A261" || s.Encode("Tymczak") != "T522" || s.Encode("Pfister") != "P236" {
+		t.Errorf("fail test Soundex 3")
+	}
+	if s.Encode("o'hara") != "O600" || s.Encode("Émile") != "E540" {
+		t.Errorf("fail test Soundex 4")
+	}
+}
+
+func TestRefinedSoundex(t *testing.T) {
+	s := RefinedSoundex{}
+	if s.Encode("") != "" || s.Encode("testing") != "T6036084" || s.Encode("The") != "T60" {
+		t.Errorf("fail test RefinedSoundex 1")
+	}
+	if s.Encode("quick") != "Q503" || s.Encode("brown") != "B1908" || s.Encode("fox") != "F205" {
+		t.Errorf("fail test RefinedSoundex 2")
+	}
+	if s.Encode("jumped") != "J408106" || s.Encode("lazy") != "L7050" || s.Encode("dogs") != "D6043" {
+		t.Errorf("fail test RefinedSoundex 3")
+	}
+}
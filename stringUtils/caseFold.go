@@ -0,0 +1,206 @@
+package stringUtils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fullCaseFolding holds the case foldings of CaseFolding.txt producing several characters.
+var fullCaseFolding = map[rune]string{
+	0x00DF: "ss", 0x0130: "i\u0307", 0x0149: "\u02BCn", 0x01F0: "j\u030C",
+	0x0390: "\u03B9\u0308\u0301", 0x03B0: "\u03C5\u0308\u0301", 0x0587: "\u0565\u0582", 0x1E96: "h\u0331",
+	0x1E97: "t\u0308", 0x1E98: "w\u030A", 0x1E99: "y\u030A", 0x1E9A: "a\u02BE",
+	0x1E9E: "ss", 0x1F50: "\u03C5\u0313", 0x1F52: "\u03C5\u0313\u0300", 0x1F54: "\u03C5\u0313\u0301",
+	0x1F56: "\u03C5\u0313\u0342", 0x1F80: "\u1F00\u03B9", 0x1F81: "\u1F01\u03B9", 0x1F82: "\u1F02\u03B9",
+	0x1F83: "\u1F03\u03B9", 0x1F84: "\u1F04\u03B9", 0x1F85: "\u1F05\u03B9", 0x1F86: "\u1F06\u03B9",
+	0x1F87: "\u1F07\u03B9", 0x1F88: "\u1F00\u03B9", 0x1F89: "\u1F01\u03B9", 0x1F8A: "\u1F02\u03B9",
+	0x1F8B: "\u1F03\u03B9", 0x1F8C: "\u1F04\u03B9", 0x1F8D: "\u1F05\u03B9", 0x1F8E: "\u1F06\u03B9",
+	0x1F8F: "\u1F07\u03B9", 0x1F90: "\u1F20\u03B9", 0x1F91: "\u1F21\u03B9", 0x1F92: "\u1F22\u03B9",
+	0x1F93: "\u1F23\u03B9", 0x1F94: "\u1F24\u03B9", 0x1F95: "\u1F25\u03B9", 0x1F96: "\u1F26\u03B9",
+	0x1F97: "\u1F27\u03B9", 0x1F98: "\u1F20\u03B9", 0x1F99: "\u1F21\u03B9", 0x1F9A: "\u1F22\u03B9",
+	0x1F9B: "\u1F23\u03B9", 0x1F9C: "\u1F24\u03B9", 0x1F9D: "\u1F25\u03B9", 0x1F9E: "\u1F26\u03B9",
+	0x1F9F: "\u1F27\u03B9", 0x1FA0: "\u1F60\u03B9", 0x1FA1: "\u1F61\u03B9", 0x1FA2: "\u1F62\u03B9",
+	0x1FA3: "\u1F63\u03B9", 0x1FA4: "\u1F64\u03B9", 0x1FA5: "\u1F65\u03B9", 0x1FA6: "\u1F66\u03B9",
+	0x1FA7: "\u1F67\u03B9", 0x1FA8: "\u1F60\u03B9", 0x1FA9: "\u1F61\u03B9", 0x1FAA: "\u1F62\u03B9",
+	0x1FAB: "\u1F63\u03B9", 0x1FAC: "\u1F64\u03B9", 0x1FAD: "\u1F65\u03B9", 0x1FAE: "\u1F66\u03B9",
+	0x1FAF: "\u1F67\u03B9", 0x1FB2: "\u1F70\u03B9", 0x1FB3: "\u03B1\u03B9", 0x1FB4: "\u03AC\u03B9",
+	0x1FB6: "\u03B1\u0342", 0x1FB7: "\u03B1\u0342\u03B9", 0x1FBC: "\u03B1\u03B9", 0x1FC2: "\u1F74\u03B9",
+	0x1FC3: "\u03B7\u03B9", 0x1FC4: "\u03AE\u03B9", 0x1FC6: "\u03B7\u0342", 0x1FC7: "\u03B7\u0342\u03B9",
+	0x1FCC: "\u03B7\u03B9", 0x1FD2: "\u03B9\u0308\u0300", 0x1FD3: "\u03B9\u0308\u0301", 0x1FD6: "\u03B9\u0342",
+	0x1FD7: "\u03B9\u0308\u0342", 0x1FE2: "\u03C5\u0308\u0300", 0x1FE3: "\u03C5\u0308\u0301", 0x1FE4: "\u03C1\u0313",
+	0x1FE6: "\u03C5\u0342", 0x1FE7: "\u03C5\u0308\u0342", 0x1FF2: "\u1F7C\u03B9", 0x1FF3: "\u03C9\u03B9",
+	0x1FF4: "\u03CE\u03B9", 0x1FF6: "\u03C9\u0342", 0x1FF7: "\u03C9\u0342\u03B9", 0x1FFC: "\u03C9\u03B9",
+	0xFB00: "ff", 0xFB01: "fi", 0xFB02: "fl", 0xFB03: "ffi",
+	0xFB04: "ffl", 0xFB05: "st", 0xFB06: "st", 0xFB13: "\u0574\u0576",
+	0xFB14: "\u0574\u0565", 0xFB15: "\u0574\u056B", 0xFB16: "\u057E\u0576", 0xFB17: "\u0574\u056D",
+}
+
+// foldRune returns the simple case folding of a character, its lower case form in most cases.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return r
+	}
+	switch {
+	case r == 0x131:
+		// the dotless i has no upper case form of its own
+		return r
+	case unicode.Is(unicode.Cherokee, r):
+		// Cherokee letters fold to upper case, its lower case letters being the latest
+		return unicode.ToUpper(r)
+	}
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+// foldString returns the full case folding of a string. It also returns the offsets of the bytes
+// of the folded string in the original string, plus the length of the original string,
+// or nil when each character folds to a single character of the same length.
+func foldString(str string) (string, []int) {
+	var buff strings.Builder
+	buff.Grow(len(str))
+	var offsets []int
+	for i, r := range str {
+		size := utf8.RuneLen(r)
+		expanded := false
+		if r == utf8.RuneError {
+			// keep invalid bytes as they are
+			_, size = utf8.DecodeRuneInString(str[i:])
+			buff.WriteString(str[i : i+size])
+		} else if r < utf8.RuneSelf {
+			buff.WriteByte(byte(foldRune(r)))
+		} else if folded, ok := fullCaseFolding[r]; ok {
+			buff.WriteString(folded)
+			expanded = true
+		} else {
+			buff.WriteRune(foldRune(r))
+		}
+		if offsets == nil && (expanded || buff.Len() != i+size) {
+			offsets = make([]int, i, len(str)+1)
+			for j := range offsets {
+				offsets[j] = j
+			}
+		}
+		for offsets != nil && len(offsets) < buff.Len() {
+			offsets = append(offsets, i)
+		}
+	}
+	if offsets == nil {
+		return buff.String(), nil
+	}
+	return buff.String(), append(offsets, len(str))
+}
+
+// foldedString is a string along with its full case folding.
+type foldedString struct {
+	folded string
+	// offsets maps the bytes of the folded string to the original one, nil when they have the same offsets
+	offsets []int
+}
+
+// newFoldedString folds the case of a string.
+func newFoldedString(str string) foldedString {
+	folded, offsets := foldString(str)
+	return foldedString{folded, offsets}
+}
+
+// offset returns the offset in the original string of the byte at index i of the folded string,
+// or the length of the original string when i is the length of the folded string.
+func (f foldedString) offset(i int) int {
+	if f.offsets == nil {
+		return i
+	}
+	return f.offsets[i]
+}
+
+// isBoundary checks if index i of the folded string is a boundary between the foldings of two original
+// characters, as a match must not cover only part of the folding of a character, such as "s" in "ß".
+func (f foldedString) isBoundary(i int) bool {
+	if i == 0 || i == len(f.folded) {
+		return true
+	}
+	if f.offsets == nil {
+		return utf8.RuneStart(f.folded[i])
+	}
+	return f.offsets[i] != f.offsets[i-1]
+}
+
+// index returns the bounds in the original string of the first or last occurrence of a folded string,
+// or -1 and -1.
+func (f foldedString) index(search string, last bool) (int, int) {
+	if last {
+		for end := len(f.folded); end >= len(search); {
+			start := strings.LastIndex(f.folded[:end], search)
+			if start < 0 {
+				break
+			}
+			if f.isBoundary(start) && f.isBoundary(start+len(search)) {
+				return f.offset(start), f.offset(start + len(search))
+			}
+			end = start + len(search) - 1
+		}
+		return -1, -1
+	}
+	for from := 0; from <= len(f.folded); {
+		idx := strings.Index(f.folded[from:], search)
+		if idx < 0 {
+			break
+		}
+		start := from + idx
+		if f.isBoundary(start) && f.isBoundary(start+len(search)) {
+			return f.offset(start), f.offset(start + len(search))
+		}
+		from = start + 1
+	}
+	return -1, -1
+}
+
+// hasPrefix returns the length of the original string matching a folded prefix, or -1.
+func (f foldedString) hasPrefix(prefix string) int {
+	if !strings.HasPrefix(f.folded, prefix) || !f.isBoundary(len(prefix)) {
+		return -1
+	}
+	return f.offset(len(prefix))
+}
+
+// hasSuffix returns the offset in the original string where a folded suffix matches, or -1.
+func (f foldedString) hasSuffix(suffix string) int {
+	start := len(f.folded) - len(suffix)
+	if !strings.HasSuffix(f.folded, suffix) || !f.isBoundary(start) {
+		return -1
+	}
+	return f.offset(start)
+}
+
+// EqualsIgnoreCase checks if two strings are equal ignoring case, using the full case folding of Unicode:
+// "ß" is equal to "SS", and the Kelvin sign to "k".
+//
+//	EqualsIgnoreCase("Straße", "STRASSE") = true
+func EqualsIgnoreCase(str1 string, str2 string) bool {
+	return FoldCase(str1) == FoldCase(str2)
+}
+
+// FoldCase returns the full case folding of a string, as defined by the Unicode Standard. Strings having
+// the same case folding are equal ignoring case.
+//
+//	FoldCase("Straße") = "strasse"
+func FoldCase(str string) string {
+	folded, _ := foldString(str)
+	return folded
+}
+
+// IndexIgnoreCase returns the bounds of the first occurrence of search in a string ignoring case,
+// or -1 and -1. The bounds are byte offsets in the string, the occurrence may not have the length of search.
+//
+//	IndexIgnoreCase("Die Straße", "STRASSE") = 4, 11
+func IndexIgnoreCase(str string, search string) (int, int) {
+	return newFoldedString(str).index(FoldCase(search), false)
+}
+
+// LastIndexIgnoreCase returns the bounds of the last occurrence of search in a string ignoring case,
+// or -1 and -1. The bounds are byte offsets in the string, the occurrence may not have the length of search.
+func LastIndexIgnoreCase(str string, search string) (int, int) {
+	return newFoldedString(str).index(FoldCase(search), true)
+}
@@ -0,0 +1,73 @@
+package stringUtils
+
+import "testing"
+
+func TestFoldCase(t *testing.T) {
+	if FoldCase("") != "" || FoldCase("Hello WORLD") != "hello world" {
+		t.Errorf("fail test FoldCase 1")
+	}
+	if FoldCase("Straße ẞ ﬁ") != "strasse ss fi" {
+		t.Errorf("fail test FoldCase 2")
+	}
+	if FoldCase("KΣσςſ") != "kσσσs" {
+		t.Errorf("fail test FoldCase 3")
+	}
+	if FoldCase("İı") != "i̇ı" || FoldCase("ᏸᎠ") != "ᏰᎠ" {
+		t.Errorf("fail test FoldCase 4")
+	}
+	if FoldCase("a\xffB") != "a\xffb" {
+		t.Errorf("fail test FoldCase 5")
+	}
+}
+
+func TestEqualsIgnoreCase(t *testing.T) {
+	if !EqualsIgnoreCase("", "") || EqualsIgnoreCase("a", "") {
+		t.Errorf("fail test EqualsIgnoreCase 1")
+	}
+	if !EqualsIgnoreCase("Straße", "STRASSE") || !EqualsIgnoreCase("Kelvin", "kELVIN") {
+		t.Errorf("fail test EqualsIgnoreCase 2")
+	}
+	if EqualsIgnoreCase("Straße", "STRASE") {
+		t.Errorf("fail test EqualsIgnoreCase 3")
+	}
+}
+
+func TestIndexIgnoreCase(t *testing.T) {
+	if start, end := IndexIgnoreCase("abc", ""); start != 0 || end != 0 {
+		t.Errorf("fail test IndexIgnoreCase 1")
+	}
+	if start, end := IndexIgnoreCase("Die Straße", "STRASSE"); start != 4 || end != 11 {
+		t.Errorf("fail test IndexIgnoreCase 2")
+	}
+	if start, end := IndexIgnoreCase("maße Masse", "masse"); start != 0 || end != 5 {
+		t.Errorf("fail test IndexIgnoreCase 3")
+	}
+	// a match must not cover part of the folding of a character
+	if start, end := IndexIgnoreCase("maße", "mas"); start != -1 || end != -1 {
+		t.Errorf("fail test IndexIgnoreCase 4")
+	}
+	if start, end := IndexIgnoreCase("ßs", "ss"); start != 0 || end != 2 {
+		t.Errorf("fail test IndexIgnoreCase 5")
+	}
+	if start, end := IndexIgnoreCase("xsß", "sS"); start != 2 || end != 4 {
+		t.Errorf("fail test IndexIgnoreCase 6")
+	}
+	if start, end := IndexIgnoreCase("a K b", "K"); start != 2 || end != 5 {
+		t.Errorf("fail test IndexIgnoreCase 7")
+	}
+}
+
+func TestLastIndexIgnoreCase(t *testing.T) {
+	if start, end := LastIndexIgnoreCase("abc", ""); start != 3 || end != 3 {
+		t.Errorf("fail test LastIndexIgnoreCase 1")
+	}
+	if start, end := LastIndexIgnoreCase("Masse maße", "MASSE"); start != 6 || end != 11 {
+		t.Errorf("fail test LastIndexIgnoreCase 2")
+	}
+	if start, end := LastIndexIgnoreCase("ss ßx", "s"); start != 1 || end != 2 {
+		t.Errorf("fail test LastIndexIgnoreCase 3")
+	}
+	if start, end := LastIndexIgnoreCase("abc", "d"); start != -1 || end != -1 {
+		t.Errorf("fail test LastIndexIgnoreCase 4")
+	}
+}
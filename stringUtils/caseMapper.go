@@ -0,0 +1,497 @@
+package stringUtils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fullUpperCase holds the upper case mappings of SpecialCasing.txt producing several characters.
+var fullUpperCase = map[rune]string{
+	0x00DF: "SS", 0x0149: "\u02BCN", 0x01F0: "J\u030C", 0x0390: "\u0399\u0308\u0301",
+	0x03B0: "\u03A5\u0308\u0301", 0x0587: "\u0535\u0552", 0x1E96: "H\u0331", 0x1E97: "T\u0308",
+	0x1E98: "W\u030A", 0x1E99: "Y\u030A", 0x1E9A: "A\u02BE", 0x1F50: "\u03A5\u0313",
+	0x1F52: "\u03A5\u0313\u0300", 0x1F54: "\u03A5\u0313\u0301", 0x1F56: "\u03A5\u0313\u0342", 0x1F80: "\u1F08\u0399",
+	0x1F81: "\u1F09\u0399", 0x1F82: "\u1F0A\u0399", 0x1F83: "\u1F0B\u0399", 0x1F84: "\u1F0C\u0399",
+	0x1F85: "\u1F0D\u0399", 0x1F86: "\u1F0E\u0399", 0x1F87: "\u1F0F\u0399", 0x1F88: "\u1F08\u0399",
+	0x1F89: "\u1F09\u0399", 0x1F8A: "\u1F0A\u0399", 0x1F8B: "\u1F0B\u0399", 0x1F8C: "\u1F0C\u0399",
+	0x1F8D: "\u1F0D\u0399", 0x1F8E: "\u1F0E\u0399", 0x1F8F: "\u1F0F\u0399", 0x1F90: "\u1F28\u0399",
+	0x1F91: "\u1F29\u0399", 0x1F92: "\u1F2A\u0399", 0x1F93: "\u1F2B\u0399", 0x1F94: "\u1F2C\u0399",
+	0x1F95: "\u1F2D\u0399", 0x1F96: "\u1F2E\u0399", 0x1F97: "\u1F2F\u0399", 0x1F98: "\u1F28\u0399",
+	0x1F99: "\u1F29\u0399", 0x1F9A: "\u1F2A\u0399", 0x1F9B: "\u1F2B\u0399", 0x1F9C: "\u1F2C\u0399",
+	0x1F9D: "\u1F2D\u0399", 0x1F9E: "\u1F2E\u0399", 0x1F9F: "\u1F2F\u0399", 0x1FA0: "\u1F68\u0399",
+	0x1FA1: "\u1F69\u0399", 0x1FA2: "\u1F6A\u0399", 0x1FA3: "\u1F6B\u0399", 0x1FA4: "\u1F6C\u0399",
+	0x1FA5: "\u1F6D\u0399", 0x1FA6: "\u1F6E\u0399", 0x1FA7: "\u1F6F\u0399", 0x1FA8: "\u1F68\u0399",
+	0x1FA9: "\u1F69\u0399", 0x1FAA: "\u1F6A\u0399", 0x1FAB: "\u1F6B\u0399", 0x1FAC: "\u1F6C\u0399",
+	0x1FAD: "\u1F6D\u0399", 0x1FAE: "\u1F6E\u0399", 0x1FAF: "\u1F6F\u0399", 0x1FB2: "\u1FBA\u0399",
+	0x1FB3: "\u0391\u0399", 0x1FB4: "\u0386\u0399", 0x1FB6: "\u0391\u0342", 0x1FB7: "\u0391\u0342\u0399",
+	0x1FBC: "\u0391\u0399", 0x1FC2: "\u1FCA\u0399", 0x1FC3: "\u0397\u0399", 0x1FC4: "\u0389\u0399",
+	0x1FC6: "\u0397\u0342", 0x1FC7: "\u0397\u0342\u0399", 0x1FCC: "\u0397\u0399", 0x1FD2: "\u0399\u0308\u0300",
+	0x1FD3: "\u0399\u0308\u0301", 0x1FD6: "\u0399\u0342", 0x1FD7: "\u0399\u0308\u0342", 0x1FE2: "\u03A5\u0308\u0300",
+	0x1FE3: "\u03A5\u0308\u0301", 0x1FE4: "\u03A1\u0313", 0x1FE6: "\u03A5\u0342", 0x1FE7: "\u03A5\u0308\u0342",
+	0x1FF2: "\u1FFA\u0399", 0x1FF3: "\u03A9\u0399", 0x1FF4: "\u038F\u0399", 0x1FF6: "\u03A9\u0342",
+	0x1FF7: "\u03A9\u0342\u0399", 0x1FFC: "\u03A9\u0399", 0xFB00: "FF", 0xFB01: "FI",
+	0xFB02: "FL", 0xFB03: "FFI", 0xFB04: "FFL", 0xFB05: "ST",
+	0xFB06: "ST", 0xFB13: "\u0544\u0546", 0xFB14: "\u0544\u0535", 0xFB15: "\u0544\u053B",
+	0xFB16: "\u054E\u0546", 0xFB17: "\u0544\u053D",
+}
+
+// fullTitleCase holds the title case mappings of SpecialCasing.txt producing several characters.
+var fullTitleCase = map[rune]string{
+	0x00DF: "Ss", 0x0149: "\u02BCN", 0x01F0: "J\u030C", 0x0390: "\u0399\u0308\u0301",
+	0x03B0: "\u03A5\u0308\u0301", 0x0587: "\u0535\u0582", 0x1E96: "H\u0331", 0x1E97: "T\u0308",
+	0x1E98: "W\u030A", 0x1E99: "Y\u030A", 0x1E9A: "A\u02BE", 0x1F50: "\u03A5\u0313",
+	0x1F52: "\u03A5\u0313\u0300", 0x1F54: "\u03A5\u0313\u0301", 0x1F56: "\u03A5\u0313\u0342", 0x1FB2: "\u1FBA\u0345",
+	0x1FB4: "\u0386\u0345", 0x1FB6: "\u0391\u0342", 0x1FB7: "\u0391\u0342\u0345", 0x1FC2: "\u1FCA\u0345",
+	0x1FC4: "\u0389\u0345", 0x1FC6: "\u0397\u0342", 0x1FC7: "\u0397\u0342\u0345", 0x1FD2: "\u0399\u0308\u0300",
+	0x1FD3: "\u0399\u0308\u0301", 0x1FD6: "\u0399\u0342", 0x1FD7: "\u0399\u0308\u0342", 0x1FE2: "\u03A5\u0308\u0300",
+	0x1FE3: "\u03A5\u0308\u0301", 0x1FE4: "\u03A1\u0313", 0x1FE6: "\u03A5\u0342", 0x1FE7: "\u03A5\u0308\u0342",
+	0x1FF2: "\u1FFA\u0345", 0x1FF4: "\u038F\u0345", 0x1FF6: "\u03A9\u0342", 0x1FF7: "\u03A9\u0342\u0345",
+	0xFB00: "Ff", 0xFB01: "Fi", 0xFB02: "Fl", 0xFB03: "Ffi",
+	0xFB04: "Ffl", 0xFB05: "St", 0xFB06: "St", 0xFB13: "\u0544\u0576",
+	0xFB14: "\u0544\u0565", 0xFB15: "\u0544\u056B", 0xFB16: "\u054E\u0576", 0xFB17: "\u0544\u056D",
+}
+
+// greekLetter is the upper case form of an accented Greek letter in Modern Greek, without its accents
+// and breathings, its iota subscript becoming a capital iota.
+type greekLetter struct {
+	upper string
+	// accent tells if the letter has a tonos, a varia or a perispomeni
+	accent bool
+}
+
+// greekUpperCase holds the Greek letters whose upper case form differs in Modern Greek.
+var greekUpperCase = map[rune]greekLetter{
+	0x0386: {"\u0391", true}, 0x0388: {"\u0395", true}, 0x0389: {"\u0397", true},
+	0x038A: {"\u0399", true}, 0x038C: {"\u039F", true}, 0x038E: {"\u03A5", true},
+	0x038F: {"\u03A9", true}, 0x0390: {"\u03AA", true}, 0x03AC: {"\u0391", true},
+	0x03AD: {"\u0395", true}, 0x03AE: {"\u0397", true}, 0x03AF: {"\u0399", true},
+	0x03B0: {"\u03AB", true}, 0x03CC: {"\u039F", true}, 0x03CD: {"\u03A5", true},
+	0x03CE: {"\u03A9", true}, 0x03D3: {"\u03D2", true}, 0x1F00: {"\u0391", false},
+	0x1F01: {"\u0391", false}, 0x1F02: {"\u0391", true}, 0x1F03: {"\u0391", true},
+	0x1F04: {"\u0391", true}, 0x1F05: {"\u0391", true}, 0x1F06: {"\u0391", true},
+	0x1F07: {"\u0391", true}, 0x1F08: {"\u0391", false}, 0x1F09: {"\u0391", false},
+	0x1F0A: {"\u0391", true}, 0x1F0B: {"\u0391", true}, 0x1F0C: {"\u0391", true},
+	0x1F0D: {"\u0391", true}, 0x1F0E: {"\u0391", true}, 0x1F0F: {"\u0391", true},
+	0x1F10: {"\u0395", false}, 0x1F11: {"\u0395", false}, 0x1F12: {"\u0395", true},
+	0x1F13: {"\u0395", true}, 0x1F14: {"\u0395", true}, 0x1F15: {"\u0395", true},
+	0x1F18: {"\u0395", false}, 0x1F19: {"\u0395", false}, 0x1F1A: {"\u0395", true},
+	0x1F1B: {"\u0395", true}, 0x1F1C: {"\u0395", true}, 0x1F1D: {"\u0395", true},
+	0x1F20: {"\u0397", false}, 0x1F21: {"\u0397", false}, 0x1F22: {"\u0397", true},
+	0x1F23: {"\u0397", true}, 0x1F24: {"\u0397", true}, 0x1F25: {"\u0397", true},
+	0x1F26: {"\u0397", true}, 0x1F27: {"\u0397", true}, 0x1F28: {"\u0397", false},
+	0x1F29: {"\u0397", false}, 0x1F2A: {"\u0397", true}, 0x1F2B: {"\u0397", true},
+	0x1F2C: {"\u0397", true}, 0x1F2D: {"\u0397", true}, 0x1F2E: {"\u0397", true},
+	0x1F2F: {"\u0397", true}, 0x1F30: {"\u0399", false}, 0x1F31: {"\u0399", false},
+	0x1F32: {"\u0399", true}, 0x1F33: {"\u0399", true}, 0x1F34: {"\u0399", true},
+	0x1F35: {"\u0399", true}, 0x1F36: {"\u0399", true}, 0x1F37: {"\u0399", true},
+	0x1F38: {"\u0399", false}, 0x1F39: {"\u0399", false}, 0x1F3A: {"\u0399", true},
+	0x1F3B: {"\u0399", true}, 0x1F3C: {"\u0399", true}, 0x1F3D: {"\u0399", true},
+	0x1F3E: {"\u0399", true}, 0x1F3F: {"\u0399", true}, 0x1F40: {"\u039F", false},
+	0x1F41: {"\u039F", false}, 0x1F42: {"\u039F", true}, 0x1F43: {"\u039F", true},
+	0x1F44: {"\u039F", true}, 0x1F45: {"\u039F", true}, 0x1F48: {"\u039F", false},
+	0x1F49: {"\u039F", false}, 0x1F4A: {"\u039F", true}, 0x1F4B: {"\u039F", true},
+	0x1F4C: {"\u039F", true}, 0x1F4D: {"\u039F", true}, 0x1F50: {"\u03A5", false},
+	0x1F51: {"\u03A5", false}, 0x1F52: {"\u03A5", true}, 0x1F53: {"\u03A5", true},
+	0x1F54: {"\u03A5", true}, 0x1F55: {"\u03A5", true}, 0x1F56: {"\u03A5", true},
+	0x1F57: {"\u03A5", true}, 0x1F59: {"\u03A5", false}, 0x1F5B: {"\u03A5", true},
+	0x1F5D: {"\u03A5", true}, 0x1F5F: {"\u03A5", true}, 0x1F60: {"\u03A9", false},
+	0x1F61: {"\u03A9", false}, 0x1F62: {"\u03A9", true}, 0x1F63: {"\u03A9", true},
+	0x1F64: {"\u03A9", true}, 0x1F65: {"\u03A9", true}, 0x1F66: {"\u03A9", true},
+	0x1F67: {"\u03A9", true}, 0x1F68: {"\u03A9", false}, 0x1F69: {"\u03A9", false},
+	0x1F6A: {"\u03A9", true}, 0x1F6B: {"\u03A9", true}, 0x1F6C: {"\u03A9", true},
+	0x1F6D: {"\u03A9", true}, 0x1F6E: {"\u03A9", true}, 0x1F6F: {"\u03A9", true},
+	0x1F70: {"\u0391", true}, 0x1F71: {"\u0391", true}, 0x1F72: {"\u0395", true},
+	0x1F73: {"\u0395", true}, 0x1F74: {"\u0397", true}, 0x1F75: {"\u0397", true},
+	0x1F76: {"\u0399", true}, 0x1F77: {"\u0399", true}, 0x1F78: {"\u039F", true},
+	0x1F79: {"\u039F", true}, 0x1F7A: {"\u03A5", true}, 0x1F7B: {"\u03A5", true},
+	0x1F7C: {"\u03A9", true}, 0x1F7D: {"\u03A9", true}, 0x1F80: {"\u0391\u0399", false},
+	0x1F81: {"\u0391\u0399", false}, 0x1F82: {"\u0391\u0399", true}, 0x1F83: {"\u0391\u0399", true},
+	0x1F84: {"\u0391\u0399", true}, 0x1F85: {"\u0391\u0399", true}, 0x1F86: {"\u0391\u0399", true},
+	0x1F87: {"\u0391\u0399", true}, 0x1F88: {"\u0391\u0399", false}, 0x1F89: {"\u0391\u0399", false},
+	0x1F8A: {"\u0391\u0399", true}, 0x1F8B: {"\u0391\u0399", true}, 0x1F8C: {"\u0391\u0399", true},
+	0x1F8D: {"\u0391\u0399", true}, 0x1F8E: {"\u0391\u0399", true}, 0x1F8F: {"\u0391\u0399", true},
+	0x1F90: {"\u0397\u0399", false}, 0x1F91: {"\u0397\u0399", false}, 0x1F92: {"\u0397\u0399", true},
+	0x1F93: {"\u0397\u0399", true}, 0x1F94: {"\u0397\u0399", true}, 0x1F95: {"\u0397\u0399", true},
+	0x1F96: {"\u0397\u0399", true}, 0x1F97: {"\u0397\u0399", true}, 0x1F98: {"\u0397\u0399", false},
+	0x1F99: {"\u0397\u0399", false}, 0x1F9A: {"\u0397\u0399", true}, 0x1F9B: {"\u0397\u0399", true},
+	0x1F9C: {"\u0397\u0399", true}, 0x1F9D: {"\u0397\u0399", true}, 0x1F9E: {"\u0397\u0399", true},
+	0x1F9F: {"\u0397\u0399", true}, 0x1FA0: {"\u03A9\u0399", false}, 0x1FA1: {"\u03A9\u0399", false},
+	0x1FA2: {"\u03A9\u0399", true}, 0x1FA3: {"\u03A9\u0399", true}, 0x1FA4: {"\u03A9\u0399", true},
+	0x1FA5: {"\u03A9\u0399", true}, 0x1FA6: {"\u03A9\u0399", true}, 0x1FA7: {"\u03A9\u0399", true},
+	0x1FA8: {"\u03A9\u0399", false}, 0x1FA9: {"\u03A9\u0399", false}, 0x1FAA: {"\u03A9\u0399", true},
+	0x1FAB: {"\u03A9\u0399", true}, 0x1FAC: {"\u03A9\u0399", true}, 0x1FAD: {"\u03A9\u0399", true},
+	0x1FAE: {"\u03A9\u0399", true}, 0x1FAF: {"\u03A9\u0399", true}, 0x1FB0: {"\u0391", false},
+	0x1FB1: {"\u0391", false}, 0x1FB2: {"\u0391\u0399", true}, 0x1FB4: {"\u0391\u0399", true},
+	0x1FB6: {"\u0391", true}, 0x1FB7: {"\u0391\u0399", true}, 0x1FB8: {"\u0391", false},
+	0x1FB9: {"\u0391", false}, 0x1FBA: {"\u0391", true}, 0x1FBB: {"\u0391", true},
+	0x1FC2: {"\u0397\u0399", true}, 0x1FC4: {"\u0397\u0399", true}, 0x1FC6: {"\u0397", true},
+	0x1FC7: {"\u0397\u0399", true}, 0x1FC8: {"\u0395", true}, 0x1FC9: {"\u0395", true},
+	0x1FCA: {"\u0397", true}, 0x1FCB: {"\u0397", true}, 0x1FD0: {"\u0399", false},
+	0x1FD1: {"\u0399", false}, 0x1FD2: {"\u03AA", true}, 0x1FD3: {"\u03AA", true},
+	0x1FD6: {"\u0399", true}, 0x1FD7: {"\u03AA", true}, 0x1FD8: {"\u0399", false},
+	0x1FD9: {"\u0399", false}, 0x1FDA: {"\u0399", true}, 0x1FDB: {"\u0399", true},
+	0x1FE0: {"\u03A5", false}, 0x1FE1: {"\u03A5", false}, 0x1FE2: {"\u03AB", true},
+	0x1FE3: {"\u03AB", true}, 0x1FE4: {"\u03A1", false}, 0x1FE5: {"\u03A1", false},
+	0x1FE6: {"\u03A5", true}, 0x1FE7: {"\u03AB", true}, 0x1FE8: {"\u03A5", false},
+	0x1FE9: {"\u03A5", false}, 0x1FEA: {"\u03A5", true}, 0x1FEB: {"\u03A5", true},
+	0x1FEC: {"\u03A1", false}, 0x1FF2: {"\u03A9\u0399", true}, 0x1FF4: {"\u03A9\u0399", true},
+	0x1FF6: {"\u03A9", true}, 0x1FF7: {"\u03A9\u0399", true}, 0x1FF8: {"\u039F", true},
+	0x1FF9: {"\u039F", true}, 0x1FFA: {"\u03A9", true}, 0x1FFB: {"\u03A9", true},
+}
+
+// combiningAbove holds the combining marks of the combining diacritical marks blocks placed above
+// their base character (canonical combining class 230).
+var combiningAbove = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x0314, 1},
+		{0x033D, 0x0344, 1},
+		{0x0346, 0x034A, 4},
+		{0x034B, 0x034C, 1},
+		{0x0350, 0x0352, 1},
+		{0x0357, 0x035B, 4},
+		{0x0363, 0x036F, 1},
+		{0x1AB0, 0x1AB4, 1},
+		{0x1ABB, 0x1ABC, 1},
+		{0x1AC1, 0x1AC2, 1},
+		{0x1AC5, 0x1AC9, 1},
+		{0x1ACB, 0x1ACE, 1},
+		{0x1DC0, 0x1DC1, 1},
+		{0x1DC3, 0x1DC9, 1},
+		{0x1DCB, 0x1DCC, 1},
+		{0x1DD1, 0x1DF5, 1},
+		{0x1DFB, 0x1DFE, 3},
+		{0x20D0, 0x20D1, 1},
+		{0x20D4, 0x20D7, 1},
+		{0x20DB, 0x20DC, 1},
+		{0x20E1, 0x20E7, 6},
+		{0x20E9, 0x20F0, 7},
+		{0xFE20, 0xFE26, 1},
+		{0xFE2E, 0xFE2F, 1},
+	},
+}
+
+// caseMapping is the case a character is converted to.
+type caseMapping int
+
+const (
+	toLower caseMapping = iota
+	toUpper
+	toTitle
+)
+
+// CaseMapper converts the case of strings following the rules of a language, as defined by SpecialCasing.txt
+// and the Unicode Standard. Whatever the language, the full case mappings are used ("ß" is upper cased to "SS")
+// and a Greek capital sigma ending a word is lower cased to a final sigma "ς". The languages having rules
+// of their own are:
+//   - Turkish ("tr") and Azerbaijani ("az"): the dotted "i" and dotless "ı" have distinct capitals, "İ" and "I";
+//   - Lithuanian ("lt"): the dot above "i" and "j" is kept when they also carry an accent;
+//   - Greek ("el"): accents and breathings are removed when upper casing;
+//   - Dutch ("nl"): the digraph "ij" is capitalized as a whole.
+//
+// The zero value follows the rules of no particular language.
+type CaseMapper struct {
+	language string
+}
+
+// NewCaseMapper creates a CaseMapper for a locale, a BCP 47 language tag such as "tr" or "tr-TR".
+// Only the language of the locale matters, and an empty locale follows the rules of no particular language.
+func NewCaseMapper(locale string) CaseMapper {
+	language := strings.ToLower(locale)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	return CaseMapper{language}
+}
+
+// Language returns the language whose rules the CaseMapper follows, or "" if it follows no particular language.
+func (m CaseMapper) Language() string {
+	return m.language
+}
+
+// Capitalize changes the first character of a string to title case. The other characters are not changed.
+//
+//	NewCaseMapper("tr").Capitalize("istanbul")   = "İstanbul"
+//	NewCaseMapper("nl").Capitalize("ijsselmeer") = "IJsselmeer"
+func (m CaseMapper) Capitalize(str string) string {
+	if str == "" {
+		return str
+	}
+	runes := []rune(str)
+	if m.language == "nl" && len(runes) > 1 && (runes[0] == 'i' || runes[0] == 'I') && (runes[1] == 'j' || runes[1] == 'J') {
+		return "IJ" + string(runes[2:])
+	}
+	return m.mapFirst(runes, toTitle)
+}
+
+// SwapCase changes the upper and title case characters of a string to lower case, and the lower case ones
+// to upper case.
+//
+//	NewCaseMapper("tr").SwapCase("Iİiı") = "ıiİI"
+func (m CaseMapper) SwapCase(str string) string {
+	runes := []rune(str)
+	var buff strings.Builder
+	for i, r := range runes {
+		// the combining marks are converted along with their base character
+		base := runes[baseIndex(runes, i)]
+		switch {
+		case unicode.IsUpper(base) || unicode.IsTitle(base):
+			buff.WriteString(m.mapRune(runes, i, toLower))
+		case unicode.IsLower(base):
+			buff.WriteString(m.mapRune(runes, i, toUpper))
+		default:
+			buff.WriteRune(r)
+		}
+	}
+	return buff.String()
+}
+
+// ToLower converts a string to lower case.
+//
+//	NewCaseMapper("tr").ToLower("DİYARBAKIR") = "diyarbakır"
+//	NewCaseMapper("").ToLower("ΟΔΟΣ")         = "οδος"
+func (m CaseMapper) ToLower(str string) string {
+	return m.mapString(str, toLower)
+}
+
+// ToUpper converts a string to upper case.
+//
+//	NewCaseMapper("tr").ToUpper("diyarbakır") = "DİYARBAKIR"
+//	NewCaseMapper("el").ToUpper("Μάιος")      = "ΜΑΪΟΣ"
+//	NewCaseMapper("").ToUpper("straße")       = "STRASSE"
+func (m CaseMapper) ToUpper(str string) string {
+	return m.mapString(str, toUpper)
+}
+
+// Uncapitalize changes the first character of a string to lower case. The other characters are not changed.
+func (m CaseMapper) Uncapitalize(str string) string {
+	if str == "" {
+		return str
+	}
+	return m.mapFirst([]rune(str), toLower)
+}
+
+// mapString converts all the characters of a string.
+func (m CaseMapper) mapString(str string, mapping caseMapping) string {
+	runes := []rune(str)
+	var buff strings.Builder
+	buff.Grow(len(str))
+	for i := range runes {
+		buff.WriteString(m.mapRune(runes, i, mapping))
+	}
+	return buff.String()
+}
+
+// mapFirst converts the first character of a string along with its combining marks.
+func (m CaseMapper) mapFirst(runes []rune, mapping caseMapping) string {
+	var buff strings.Builder
+	end := 1
+	for end < len(runes) && unicode.Is(unicode.Mn, runes[end]) {
+		end++
+	}
+	for i := 0; i < end; i++ {
+		buff.WriteString(m.mapRune(runes, i, mapping))
+	}
+	buff.WriteString(string(runes[end:]))
+	return buff.String()
+}
+
+// mapRune converts the character at index i, the characters around it giving its context.
+func (m CaseMapper) mapRune(runes []rune, i int, mapping caseMapping) string {
+	r := runes[i]
+	switch m.language {
+	case "tr", "az":
+		switch {
+		case r == 0x130 && mapping == toLower:
+			return "i"
+		case r == 'I' && mapping == toLower && isBeforeDot(runes, i):
+			// the dot above is removed along with the I
+			return "i"
+		case r == 'I' && mapping == toLower:
+			return "\u0131"
+		case r == 'i' && mapping != toLower:
+			return "\u0130"
+		case r == 0x307 && mapping == toLower && runes[aboveBaseIndex(runes, i)] == 'I':
+			return ""
+		}
+	case "lt":
+		if mapping == toLower {
+			switch r {
+			case 'I', 'J', 0x12E:
+				if isMoreAbove(runes, i) {
+					return string(unicode.ToLower(r)) + "\u0307"
+				}
+			case 0xCC:
+				return "i\u0307\u0300"
+			case 0xCD:
+				return "i\u0307\u0301"
+			case 0x128:
+				return "i\u0307\u0303"
+			}
+		} else if r == 0x307 && unicode.Is(unicode.Soft_Dotted, runes[aboveBaseIndex(runes, i)]) {
+			return ""
+		}
+	case "el":
+		if mapping == toUpper {
+			if upper, ok := greekUpper(runes, i); ok {
+				return upper
+			}
+		}
+	}
+	switch mapping {
+	case toLower:
+		if r == 0x130 {
+			return "i\u0307"
+		}
+		if r == 0x3A3 && isFinalSigma(runes, i) {
+			return "\u03C2"
+		}
+		return string(unicode.ToLower(r))
+	case toUpper:
+		if upper, ok := fullUpperCase[r]; ok {
+			return upper
+		}
+		return string(unicode.ToUpper(r))
+	}
+	if title, ok := fullTitleCase[r]; ok {
+		return title
+	}
+	return string(unicode.ToTitle(r))
+}
+
+// baseIndex returns the index of the character the combining marks at index i belong to,
+// i itself if it is not a combining mark or the string starts with combining marks.
+func baseIndex(runes []rune, i int) int {
+	j := i
+	for j > 0 && unicode.Is(unicode.Mn, runes[j]) {
+		j--
+	}
+	if unicode.Is(unicode.Mn, runes[j]) {
+		return i
+	}
+	return j
+}
+
+// aboveBaseIndex returns the index of the character preceding the one at index i, skipping the combining
+// marks which are not placed above, or i if there is none.
+func aboveBaseIndex(runes []rune, i int) int {
+	for j := i - 1; j >= 0; j-- {
+		if !unicode.Is(unicode.Mn, runes[j]) || unicode.Is(combiningAbove, runes[j]) {
+			return j
+		}
+	}
+	return i
+}
+
+// isBeforeDot checks if the character at index i is followed by a combining dot above,
+// skipping the combining marks which are not placed above.
+func isBeforeDot(runes []rune, i int) bool {
+	for j := i + 1; j < len(runes) && unicode.Is(unicode.Mn, runes[j]); j++ {
+		if runes[j] == 0x307 {
+			return true
+		}
+		if unicode.Is(combiningAbove, runes[j]) {
+			return false
+		}
+	}
+	return false
+}
+
+// isMoreAbove checks if the character at index i is followed by a combining mark placed above.
+func isMoreAbove(runes []rune, i int) bool {
+	for j := i + 1; j < len(runes) && unicode.Is(unicode.Mn, runes[j]); j++ {
+		if unicode.Is(combiningAbove, runes[j]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCased checks if a character has a case.
+func isCased(r rune) bool {
+	return unicode.In(r, unicode.Lu, unicode.Ll, unicode.Lt, unicode.Other_Lowercase, unicode.Other_Uppercase)
+}
+
+// isCaseIgnorable checks if a character is ignored when looking at the case of the characters around
+// another, such as combining marks and apostrophes.
+func isCaseIgnorable(r rune) bool {
+	return r == '\'' || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk, wordMidLetter, wordMidNumLet)
+}
+
+// isFinalSigma checks if the capital sigma at index i ends a word, being preceded but not followed by
+// a cased character.
+func isFinalSigma(runes []rune, i int) bool {
+	j := i - 1
+	for j >= 0 && isCaseIgnorable(runes[j]) {
+		j--
+	}
+	if j < 0 || !isCased(runes[j]) {
+		return false
+	}
+	j = i + 1
+	for j < len(runes) && isCaseIgnorable(runes[j]) {
+		j++
+	}
+	return j == len(runes) || !isCased(runes[j])
+}
+
+// greekUpper returns the upper case form in Modern Greek of the character at index i, which loses its accents
+// and breathings. A capital iota or upsilon following an accented vowel gets a dialytika instead, telling
+// they are not a diphthong. It returns false if the character is not Greek.
+func greekUpper(runes []rune, i int) (string, bool) {
+	r := runes[i]
+	if unicode.Is(unicode.Mn, r) {
+		if base := baseIndex(runes, i); base == i || !unicode.Is(unicode.Greek, runes[base]) {
+			return "", false
+		}
+		switch r {
+		case 0x300, 0x301, 0x313, 0x314, 0x342, 0x343:
+			return "", true
+		case 0x344:
+			return "\u0308", true
+		case 0x345:
+			return "\u0399", true
+		}
+		return "", false
+	}
+	if !unicode.Is(unicode.Greek, r) {
+		return "", false
+	}
+	isLetter := func(j int) bool {
+		return j >= 0 && j < len(runes) && unicode.IsLetter(runes[j])
+	}
+	if r == 0x3AE && !isLetter(i-1) && !isLetter(i+1) {
+		// the disjunctive eta keeps its accent
+		return "\u0389", true
+	}
+	if letter, ok := greekUpperCase[r]; ok {
+		return letter.upper, true
+	}
+	if upper, ok := fullUpperCase[r]; ok {
+		return upper, true
+	}
+	upper := unicode.ToUpper(r)
+	if (upper == 0x399 || upper == 0x3A5) && isAfterGreekAccent(runes, i) &&
+		(i+1 == len(runes) || !unicode.Is(unicode.Mn, runes[i+1])) {
+		if upper == 0x399 {
+			return "\u03AA", true
+		}
+		return "\u03AB", true
+	}
+	return string(upper), true
+}
+
+// isAfterGreekAccent checks if the character at index i follows a Greek vowel with an accent.
+func isAfterGreekAccent(runes []rune, i int) bool {
+	accent := false
+	j := i - 1
+	for ; j >= 0 && unicode.Is(unicode.Mn, runes[j]); j-- {
+		accent = accent || runes[j] == 0x300 || runes[j] == 0x301 || runes[j] == 0x342
+	}
+	if j < 0 {
+		return false
+	}
+	if letter, ok := greekUpperCase[runes[j]]; ok {
+		return letter.accent
+	}
+	return accent && strings.ContainsRune("ΑΕΗΙΟΥΩαεηιουω", runes[j])
+}
@@ -0,0 +1,95 @@
+package stringUtils
+
+import "testing"
+
+func TestNewCaseMapper(t *testing.T) {
+	if NewCaseMapper("").Language() != "" || NewCaseMapper("tr").Language() != "tr" {
+		t.Errorf("fail test NewCaseMapper 1")
+	}
+	if NewCaseMapper("tr-TR").Language() != "tr" || NewCaseMapper("EL_gr").Language() != "el" {
+		t.Errorf("fail test NewCaseMapper 2")
+	}
+}
+
+func TestCaseMapperToLower(t *testing.T) {
+	root, tr, lt := CaseMapper{}, NewCaseMapper("tr"), NewCaseMapper("lt")
+	if root.ToLower("") != "" || root.ToLower("HeLLo") != "hello" {
+		t.Errorf("fail test CaseMapperToLower 1")
+	}
+	if root.ToLower("İI") != "i̇i" {
+		t.Errorf("fail test CaseMapperToLower 2")
+	}
+	if tr.ToLower("DİYARBAKIR") != "diyarbakır" || NewCaseMapper("az").ToLower("Iİ") != "ıi" {
+		t.Errorf("fail test CaseMapperToLower 3")
+	}
+	if tr.ToLower("İỊ̇") != "iị" {
+		t.Errorf("fail test CaseMapperToLower 4")
+	}
+	if root.ToLower("ΟΔΟΣ ΟΔΟΣ. Σ ΑΣ'Σ") != "οδος οδος. σ ασ'ς" {
+		t.Errorf("fail test CaseMapperToLower 5")
+	}
+	if lt.ToLower("Í J Ì Í Ĩ Į̃") != "i̇́ j i̇̀ i̇́ i̇̃ į̇̃" {
+		t.Errorf("fail test CaseMapperToLower 6")
+	}
+}
+
+func TestCaseMapperToUpper(t *testing.T) {
+	root, tr, lt, el := CaseMapper{}, NewCaseMapper("tr"), NewCaseMapper("lt"), NewCaseMapper("el")
+	if root.ToUpper("") != "" || root.ToUpper("straße ﬁne") != "STRASSE FINE" {
+		t.Errorf("fail test CaseMapperToUpper 1")
+	}
+	if root.ToUpper("iı") != "II" || tr.ToUpper("diyarbakır") != "DİYARBAKIR" {
+		t.Errorf("fail test CaseMapperToUpper 2")
+	}
+	if lt.ToUpper("i̇́ j̇ ȧ") != "Í J Ȧ" || root.ToUpper("i̇") != "İ" {
+		t.Errorf("fail test CaseMapperToUpper 3")
+	}
+	if root.ToUpper("Μάιος") != "ΜΆΙΟΣ" || el.ToUpper("Μάιος") != "ΜΑΪΟΣ" {
+		t.Errorf("fail test CaseMapperToUpper 4")
+	}
+	if el.ToUpper("ἀρχή ή ΐ ᾳ") != "ΑΡΧΗ Ή Ϊ ΑΙ" {
+		t.Errorf("fail test CaseMapperToUpper 5")
+	}
+	if el.ToUpper("άι ἐ") != "ΑΪ Ε" || el.ToUpper("éa") != "ÉA" {
+		t.Errorf("fail test CaseMapperToUpper 6")
+	}
+}
+
+func TestCaseMapperCapitalize(t *testing.T) {
+	if (CaseMapper{}).Capitalize("") != "" || (CaseMapper{}).Capitalize("ǆungla") != "ǅungla" {
+		t.Errorf("fail test CaseMapperCapitalize 1")
+	}
+	if NewCaseMapper("tr").Capitalize("istanbul") != "İstanbul" || (CaseMapper{}).Capitalize("istanbul") != "Istanbul" {
+		t.Errorf("fail test CaseMapperCapitalize 2")
+	}
+	if NewCaseMapper("nl").Capitalize("ijsselmeer") != "IJsselmeer" || (CaseMapper{}).Capitalize("ijs") != "Ijs" {
+		t.Errorf("fail test CaseMapperCapitalize 3")
+	}
+	if NewCaseMapper("lt").Capitalize("i̇́s") != "Ís" || (CaseMapper{}).Capitalize("ßa") != "Ssa" {
+		t.Errorf("fail test CaseMapperCapitalize 4")
+	}
+}
+
+func TestCaseMapperUncapitalize(t *testing.T) {
+	if (CaseMapper{}).Uncapitalize("") != "" || (CaseMapper{}).Uncapitalize("ABC") != "aBC" {
+		t.Errorf("fail test CaseMapperUncapitalize 1")
+	}
+	if NewCaseMapper("tr").Uncapitalize("Istanbul") != "ıstanbul" || NewCaseMapper("tr").Uncapitalize("İzmir") != "izmir" {
+		t.Errorf("fail test CaseMapperUncapitalize 2")
+	}
+	if (CaseMapper{}).Uncapitalize("Σ") != "σ" {
+		t.Errorf("fail test CaseMapperUncapitalize 3")
+	}
+}
+
+func TestCaseMapperSwapCase(t *testing.T) {
+	if (CaseMapper{}).SwapCase("") != "" || (CaseMapper{}).SwapCase("Hello, World 1") != "hELLO, wORLD 1" {
+		t.Errorf("fail test CaseMapperSwapCase 1")
+	}
+	if NewCaseMapper("tr").SwapCase("Iİiı") != "ıiİI" {
+		t.Errorf("fail test CaseMapperSwapCase 2")
+	}
+	if (CaseMapper{}).SwapCase("aΣ") != "Aς" || (CaseMapper{}).SwapCase("ǅ") != "ǆ" {
+		t.Errorf("fail test CaseMapperSwapCase 3")
+	}
+}
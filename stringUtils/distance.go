@@ -0,0 +1,241 @@
+package stringUtils
+
+// minInt returns the smallest of the given integers.
+func minInt(first int, others ...int) int {
+	for _, i := range others {
+		if i < first {
+			first = i
+		}
+	}
+	return first
+}
+
+// maxInt returns the greatest of the given integers.
+func maxInt(first int, others ...int) int {
+	for _, i := range others {
+		if i > first {
+			first = i
+		}
+	}
+	return first
+}
+
+// LevenshteinDistance finds the Levenshtein distance between two strings, that is the number of
+// characters (runes) that need to be inserted, deleted or substituted to change one string into the other.
+//
+//	LevenshteinDistance("kitten", "sitting") = 3
+//	LevenshteinDistance("hello", "hallo")    = 1
+func LevenshteinDistance(s string, t string) int {
+	left, right := []rune(s), []rune(t)
+	n, m := len(left), len(right)
+	if n == 0 {
+		return m
+	}
+	if m == 0 {
+		return n
+	}
+	if n > m {
+		left, right = right, left
+		n, m = m, n
+	}
+	// only two rows of the distance matrix are needed
+	p := make([]int, n+1)
+	d := make([]int, n+1)
+	for i := range p {
+		p[i] = i
+	}
+	for j := 1; j <= m; j++ {
+		d[0] = j
+		for i := 1; i <= n; i++ {
+			cost := 1
+			if left[i-1] == right[j-1] {
+				cost = 0
+			}
+			d[i] = minInt(d[i-1]+1, p[i]+1, p[i-1]+cost)
+		}
+		p, d = d, p
+	}
+	return p[n]
+}
+
+// LevenshteinDistanceWithThreshold finds the Levenshtein distance between two strings if it's less than
+// or equal to a given threshold, and returns -1 otherwise.
+// Only a diagonal stripe of width 2*threshold+1 of the distance matrix is computed,
+// which makes it much faster than LevenshteinDistance for small thresholds and lets it bail out early.
+//
+//	LevenshteinDistanceWithThreshold("elephant", "hippo", 7) = 7
+//	LevenshteinDistanceWithThreshold("elephant", "hippo", 6) = -1
+func LevenshteinDistanceWithThreshold(s string, t string, threshold int) int {
+	if threshold < 0 {
+		return -1
+	}
+	left, right := []rune(s), []rune(t)
+	n, m := len(left), len(right)
+	if n == 0 || m == 0 {
+		if n+m <= threshold {
+			return n + m
+		}
+		return -1
+	}
+	if n > m {
+		left, right = right, left
+		n, m = m, n
+	}
+	if m-n > threshold {
+		return -1
+	}
+	// any value greater than the longest possible distance stands for infinity
+	infinity := m + 1
+	p := make([]int, n+1)
+	d := make([]int, n+1)
+	boundary := minInt(n, threshold) + 1
+	for i := range p {
+		if i < boundary {
+			p[i] = i
+		} else {
+			p[i] = infinity
+		}
+		d[i] = infinity
+	}
+	for j := 1; j <= m; j++ {
+		d[0] = j
+		// compute the stripe indices, constrained by the array size
+		start := maxInt(1, j-threshold)
+		end := n
+		if j <= n-threshold {
+			end = j + threshold
+		}
+		if start > end {
+			return -1
+		}
+		if start > 1 {
+			d[start-1] = infinity
+		}
+		for i := start; i <= end; i++ {
+			if left[i-1] == right[j-1] {
+				d[i] = p[i-1]
+			} else {
+				d[i] = 1 + minInt(d[i-1], p[i], p[i-1])
+			}
+		}
+		p, d = d, p
+	}
+	if p[n] <= threshold {
+		return p[n]
+	}
+	return -1
+}
+
+// DamerauLevenshteinDistance finds the Damerau-Levenshtein distance between two strings, that is the number
+// of characters (runes) that need to be inserted, deleted or substituted, or of adjacent characters that
+// need to be transposed, to change one string into the other.
+//
+//	DamerauLevenshteinDistance("abcdef", "abcdfe") = 1
+//	DamerauLevenshteinDistance("ca", "abc")        = 2
+func DamerauLevenshteinDistance(s string, t string) int {
+	left, right := []rune(s), []rune(t)
+	n, m := len(left), len(right)
+	if n == 0 {
+		return m
+	}
+	if m == 0 {
+		return n
+	}
+	infinity := n + m
+	d := make([][]int, n+2)
+	for i := range d {
+		d[i] = make([]int, m+2)
+		d[i][0] = infinity
+		if i > 0 {
+			d[i][1] = i - 1
+		}
+	}
+	for j := 0; j <= m+1; j++ {
+		d[0][j] = infinity
+		if j > 0 {
+			d[1][j] = j - 1
+		}
+	}
+	// last row in which each character was seen
+	lastRow := make(map[rune]int)
+	for i := 1; i <= n; i++ {
+		lastMatchColumn := 0
+		for j := 1; j <= m; j++ {
+			i1 := lastRow[right[j-1]]
+			j1 := lastMatchColumn
+			cost := 1
+			if left[i-1] == right[j-1] {
+				cost = 0
+				lastMatchColumn = j
+			}
+			d[i+1][j+1] = minInt(d[i][j]+cost, d[i+1][j]+1, d[i][j+1]+1,
+				d[i1][j1]+(i-i1-1)+1+(j-j1-1))
+		}
+		lastRow[left[i-1]] = i
+	}
+	return d[n+1][m+1]
+}
+
+// JaroWinklerSimilarity computes the Jaro-Winkler similarity between two strings, a value between
+// 0 (no similarity) and 1 (identical strings) which favours strings sharing a common prefix.
+//
+//	JaroWinklerSimilarity("frog", "fog")     = 0.925
+//	JaroWinklerSimilarity("hello", "hallo")  = 0.88
+//	JaroWinklerSimilarity("fly", "ant")      = 0
+func JaroWinklerSimilarity(s string, t string) float64 {
+	left, right := []rune(s), []rune(t)
+	jaro := jaroSimilarity(left, right)
+	prefix := 0
+	for prefix < minInt(4, len(left), len(right)) && left[prefix] == right[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// JaroWinklerDistance computes the Jaro-Winkler distance between two strings, that is one minus
+// their Jaro-Winkler similarity.
+func JaroWinklerDistance(s string, t string) float64 {
+	return 1 - JaroWinklerSimilarity(s, t)
+}
+
+// jaroSimilarity computes the Jaro similarity between two sequences of characters.
+func jaroSimilarity(left []rune, right []rune) float64 {
+	if len(left) == 0 && len(right) == 0 {
+		return 1
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return 0
+	}
+	window := maxInt(0, maxInt(len(left), len(right))/2-1)
+	leftMatched := make([]bool, len(left))
+	rightMatched := make([]bool, len(right))
+	matches := 0
+	for i, c := range left {
+		for j := maxInt(0, i-window); j < minInt(len(right), i+window+1); j++ {
+			if !rightMatched[j] && right[j] == c {
+				leftMatched[i], rightMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions := 0
+	j := 0
+	for i, c := range left {
+		if !leftMatched[i] {
+			continue
+		}
+		for !rightMatched[j] {
+			j++
+		}
+		if c != right[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(left)) + m/float64(len(right)) + (m-float64(transpositions/2))/m) / 3
+}
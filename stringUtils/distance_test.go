@@ -0,0 +1,129 @@
+package stringUtils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	if LevenshteinDistance("", "") != 0 {
+		t.Errorf("fail test LevenshteinDistance 1")
+	}
+	if LevenshteinDistance("", "a") != 1 || LevenshteinDistance("aaapppp", "") != 7 {
+		t.Errorf("fail test LevenshteinDistance 2")
+	}
+	if LevenshteinDistance("frog", "fog") != 1 {
+		t.Errorf("fail test LevenshteinDistance 3")
+	}
+	if LevenshteinDistance("fly", "ant") != 3 {
+		t.Errorf("fail test LevenshteinDistance 4")
+	}
+	if LevenshteinDistance("elephant", "hippo") != 7 || LevenshteinDistance("hippo", "elephant") != 7 {
+		t.Errorf("fail test LevenshteinDistance 5")
+	}
+	if LevenshteinDistance("hippo", "zzzzzzzz") != 8 {
+		t.Errorf("fail test LevenshteinDistance 6")
+	}
+	if LevenshteinDistance("kitten", "sitting") != 3 {
+		t.Errorf("fail test LevenshteinDistance 7")
+	}
+	if LevenshteinDistance("héllo", "hello") != 1 {
+		t.Errorf("fail test LevenshteinDistance 8")
+	}
+	if LevenshteinDistance("東京都", "京都") != 1 {
+		t.Errorf("fail test LevenshteinDistance 9")
+	}
+}
+
+func TestLevenshteinDistanceWithThreshold(t *testing.T) {
+	if LevenshteinDistanceWithThreshold("", "", 0) != 0 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 1")
+	}
+	if LevenshteinDistanceWithThreshold("aaapppp", "", 8) != 7 || LevenshteinDistanceWithThreshold("aaapppp", "", 7) != 7 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 2")
+	}
+	if LevenshteinDistanceWithThreshold("aaapppp", "", 6) != -1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 3")
+	}
+	if LevenshteinDistanceWithThreshold("b", "a", 0) != -1 || LevenshteinDistanceWithThreshold("a", "b", 1) != 1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 4")
+	}
+	if LevenshteinDistanceWithThreshold("aa", "aa", 0) != 0 || LevenshteinDistanceWithThreshold("aa", "aa", 2) != 0 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 5")
+	}
+	if LevenshteinDistanceWithThreshold("aaa", "bbb", 2) != -1 || LevenshteinDistanceWithThreshold("aaa", "bbb", 3) != 3 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 6")
+	}
+	if LevenshteinDistanceWithThreshold("aaaaaa", "b", 10) != 6 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 7")
+	}
+	if LevenshteinDistanceWithThreshold("elephant", "hippo", 7) != 7 || LevenshteinDistanceWithThreshold("elephant", "hippo", 6) != -1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 8")
+	}
+	if LevenshteinDistanceWithThreshold("hippo", "elephant", 7) != 7 || LevenshteinDistanceWithThreshold("hippo", "elephant", 6) != -1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 9")
+	}
+	if LevenshteinDistanceWithThreshold("hippo", "zzzzzzzz", 8) != 8 || LevenshteinDistanceWithThreshold("hello", "hallo", 1) != 1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 10")
+	}
+	if LevenshteinDistanceWithThreshold("kitten", "sitting", math.MaxInt32) != 3 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 11")
+	}
+	if LevenshteinDistanceWithThreshold("a", "b", -1) != -1 {
+		t.Errorf("fail test LevenshteinDistanceWithThreshold 12")
+	}
+}
+
+func TestDamerauLevenshteinDistance(t *testing.T) {
+	if DamerauLevenshteinDistance("", "abc") != 3 || DamerauLevenshteinDistance("abc", "") != 3 {
+		t.Errorf("fail test DamerauLevenshteinDistance 1")
+	}
+	if DamerauLevenshteinDistance("abcdef", "abcdfe") != 1 {
+		t.Errorf("fail test DamerauLevenshteinDistance 2")
+	}
+	if DamerauLevenshteinDistance("ca", "abc") != 2 {
+		t.Errorf("fail test DamerauLevenshteinDistance 3")
+	}
+	if DamerauLevenshteinDistance("kitten", "sitting") != 3 {
+		t.Errorf("fail test DamerauLevenshteinDistance 4")
+	}
+	if DamerauLevenshteinDistance("éa", "aé") != 1 {
+		t.Errorf("fail test DamerauLevenshteinDistance 5")
+	}
+}
+
+func TestJaroWinklerSimilarity(t *testing.T) {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 0.0001
+	}
+	if JaroWinklerSimilarity("", "") != 1 || JaroWinklerSimilarity("foo", "") != 0 {
+		t.Errorf("fail test JaroWinklerSimilarity 1")
+	}
+	if JaroWinklerSimilarity("foo", "foo") != 1 {
+		t.Errorf("fail test JaroWinklerSimilarity 2")
+	}
+	if !near(JaroWinklerSimilarity("frog", "fog"), 0.925) {
+		t.Errorf("fail test JaroWinklerSimilarity 3")
+	}
+	if JaroWinklerSimilarity("fly", "ant") != 0 || JaroWinklerSimilarity("hippo", "zzzzzzzz") != 0 {
+		t.Errorf("fail test JaroWinklerSimilarity 4")
+	}
+	if !near(JaroWinklerSimilarity("elephant", "hippo"), 0.44166) || !near(JaroWinklerSimilarity("hippo", "elephant"), 0.44166) {
+		t.Errorf("fail test JaroWinklerSimilarity 5")
+	}
+	if !near(JaroWinklerSimilarity("hello", "hallo"), 0.88) {
+		t.Errorf("fail test JaroWinklerSimilarity 6")
+	}
+	if !near(JaroWinklerSimilarity("ABC Corporation", "ABC Corp"), 0.90666) {
+		t.Errorf("fail test JaroWinklerSimilarity 7")
+	}
+	if !near(JaroWinklerSimilarity("PENNSYLVANIA", "PENNCISYLVNIA"), 0.89801) {
+		t.Errorf("fail test JaroWinklerSimilarity 8")
+	}
+	if !near(JaroWinklerSimilarity("D N H Enterprises Inc", "D & H Enterprises, Inc."), 0.95251) {
+		t.Errorf("fail test JaroWinklerSimilarity 9")
+	}
+	if !near(JaroWinklerDistance("frog", "fog"), 0.075) {
+		t.Errorf("fail test JaroWinklerDistance 1")
+	}
+}
@@ -0,0 +1,135 @@
+package stringUtils
+
+import (
+	"container/heap"
+	"sort"
+	"unicode/utf8"
+)
+
+// SimilarityFunc computes a similarity score between two strings, a higher score meaning more similar strings.
+type SimilarityFunc func(s string, t string) float64
+
+// Match is a candidate string scored against a query.
+type Match struct {
+	// Value is the candidate string.
+	Value string
+	// Index is the position of the candidate in the slice of candidates.
+	Index int
+	// Score is the similarity between the query and the candidate.
+	Score float64
+}
+
+// FuzzyScore finds the fuzzy score of a query against a term, ignoring case.
+// One point is given for every query character found in the term, in order,
+// and two bonus points for every character matching right after the previous match.
+// A higher score indicates a higher similarity.
+//
+//	FuzzyScore("Workshop", "b")                     = 0
+//	FuzzyScore("Workshop", "wo")                    = 4
+//	FuzzyScore("Apache Software Foundation", "asf") = 3
+func FuzzyScore(term string, query string) int {
+	termRunes := []rune(LowerCase(term))
+	score := 0
+	termIndex := 0
+	previousMatch := -2
+	for _, q := range LowerCase(query) {
+		for termIndex < len(termRunes) {
+			matched := termRunes[termIndex] == q
+			if matched {
+				score++
+				if previousMatch+1 == termIndex {
+					score += 2
+				}
+				previousMatch = termIndex
+			}
+			termIndex++
+			if matched {
+				break
+			}
+		}
+	}
+	return score
+}
+
+// FuzzySimilarity is a SimilarityFunc based on FuzzyScore, normalized to a value between 0 and 1.
+// The query is the first argument.
+func FuzzySimilarity(query string, candidate string) float64 {
+	queryLen := utf8.RuneCountInString(query)
+	if queryLen == 0 {
+		return 0
+	}
+	// a query fully found as a contiguous run scores 1 + 3 for each following character
+	return float64(FuzzyScore(candidate, query)) / float64(3*queryLen-2)
+}
+
+// LevenshteinSimilarity is a SimilarityFunc based on the Levenshtein distance, normalized to a value
+// between 0 (nothing in common) and 1 (identical strings).
+func LevenshteinSimilarity(s string, t string) float64 {
+	maxLen := maxInt(utf8.RuneCountInString(s), utf8.RuneCountInString(t))
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(LevenshteinDistance(s, t))/float64(maxLen)
+}
+
+// IgnoreCaseSimilarity returns a SimilarityFunc comparing the case foldings of the strings with the given one.
+func IgnoreCaseSimilarity(similarity SimilarityFunc) SimilarityFunc {
+	return func(s string, t string) float64 {
+		return similarity(FoldCase(s), FoldCase(t))
+	}
+}
+
+// ClosestMatches ranks candidates by their Jaro-Winkler similarity to a query, ignoring case, and returns
+// the n best ones, best first. Candidates with the same score keep their original order.
+//
+//	ClosestMatches("stauts", []string{"stash", "status", "start"}, 1)[0].Value = "status"
+func ClosestMatches(query string, candidates []string, n int) []Match {
+	return ClosestMatchesWithSimilarity(query, candidates, n, IgnoreCaseSimilarity(JaroWinklerSimilarity))
+}
+
+// ClosestMatchesWithSimilarity ranks candidates by their similarity to a query and returns the n best ones,
+// best first. The query is always given as the first argument of the similarity function.
+// Candidates with the same score keep their original order.
+func ClosestMatchesWithSimilarity(query string, candidates []string, n int, similarity SimilarityFunc) []Match {
+	if n <= 0 {
+		return []Match{}
+	}
+	// the worst of the n best matches found so far sits at the top of the heap
+	best := make(matchHeap, 0, minInt(n, len(candidates)))
+	for i, candidate := range candidates {
+		match := Match{Value: candidate, Index: i, Score: similarity(query, candidate)}
+		if len(best) < n {
+			heap.Push(&best, match)
+		} else if match.isBetterThan(best[0]) {
+			best[0] = match
+			heap.Fix(&best, 0)
+		}
+	}
+	matches := []Match(best)
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].isBetterThan(matches[j])
+	})
+	return matches
+}
+
+// isBetterThan reports whether a match ranks before another one.
+func (m Match) isBetterThan(other Match) bool {
+	if m.Score != other.Score {
+		return m.Score > other.Score
+	}
+	return m.Index < other.Index
+}
+
+// matchHeap is a heap of matches, the worst match being at the top.
+type matchHeap []Match
+
+func (h matchHeap) Len() int            { return len(h) }
+func (h matchHeap) Less(i, j int) bool  { return h[j].isBetterThan(h[i]) }
+func (h matchHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *matchHeap) Push(x interface{}) { *h = append(*h, x.(Match)) }
+func (h *matchHeap) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,101 @@
+package stringUtils
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	if FuzzyScore("", "") != 0 {
+		t.Errorf("fail test FuzzyScore 1")
+	}
+	if FuzzyScore("Workshop", "b") != 0 {
+		t.Errorf("fail test FuzzyScore 2")
+	}
+	if FuzzyScore("Room", "o") != 1 {
+		t.Errorf("fail test FuzzyScore 3")
+	}
+	if FuzzyScore("Workshop", "w") != 1 || FuzzyScore("Workshop", "ws") != 2 {
+		t.Errorf("fail test FuzzyScore 4")
+	}
+	if FuzzyScore("Workshop", "wo") != 4 {
+		t.Errorf("fail test FuzzyScore 5")
+	}
+	if FuzzyScore("Apache Software Foundation", "asf") != 3 {
+		t.Errorf("fail test FuzzyScore 6")
+	}
+	if FuzzyScore("Élysée", "éLy") != 7 {
+		t.Errorf("fail test FuzzyScore 7")
+	}
+}
+
+func TestFuzzySimilarity(t *testing.T) {
+	if FuzzySimilarity("", "abc") != 0 {
+		t.Errorf("fail test FuzzySimilarity 1")
+	}
+	if FuzzySimilarity("wo", "Workshop") != 1 {
+		t.Errorf("fail test FuzzySimilarity 2")
+	}
+	if FuzzySimilarity("wb", "Workshop") != 0.25 {
+		t.Errorf("fail test FuzzySimilarity 3")
+	}
+}
+
+func TestLevenshteinSimilarity(t *testing.T) {
+	if LevenshteinSimilarity("", "") != 1 {
+		t.Errorf("fail test LevenshteinSimilarity 1")
+	}
+	if LevenshteinSimilarity("abcd", "abce") != 0.75 {
+		t.Errorf("fail test LevenshteinSimilarity 2")
+	}
+	if LevenshteinSimilarity("abc", "xyz") != 0 {
+		t.Errorf("fail test LevenshteinSimilarity 3")
+	}
+}
+
+func TestIgnoreCaseSimilarity(t *testing.T) {
+	if IgnoreCaseSimilarity(LevenshteinSimilarity)("ABCD", "abcd") != 1 {
+		t.Errorf("fail test IgnoreCaseSimilarity 1")
+	}
+	if LevenshteinSimilarity("ABCD", "abcd") != 0 {
+		t.Errorf("fail test IgnoreCaseSimilarity 2")
+	}
+}
+
+func TestClosestMatches(t *testing.T) {
+	if len(ClosestMatches("status", nil, 3)) != 0 || len(ClosestMatches("status", []string{"status"}, 0)) != 0 {
+		t.Errorf("fail test ClosestMatches 1")
+	}
+	commands := []string{"commit", "stash", "status", "start", "checkout", "STATUS"}
+	m := ClosestMatches("stauts", commands, 2)
+	if len(m) != 2 || m[0].Value != "status" || m[0].Index != 2 || m[1].Value != "STATUS" || m[1].Index != 5 {
+		t.Errorf("fail test ClosestMatches 2")
+	}
+	if m[0].Score != m[1].Score || m[0].Score <= 0.9 {
+		t.Errorf("fail test ClosestMatches 3")
+	}
+	m = ClosestMatches("chekout", commands, 10)
+	if len(m) != len(commands) || m[0].Value != "checkout" {
+		t.Errorf("fail test ClosestMatches 4")
+	}
+	for i := 1; i < len(m); i++ {
+		if m[i].Score > m[i-1].Score {
+			t.Errorf("fail test ClosestMatches 5")
+		}
+	}
+}
+
+func TestClosestMatchesWithSimilarity(t *testing.T) {
+	m := ClosestMatchesWithSimilarity("asf", []string{"Apache Commons", "Apache Software Foundation", "ASF"}, 2, FuzzySimilarity)
+	if len(m) != 2 || m[0].Value != "ASF" || m[1].Value != "Apache Software Foundation" {
+		t.Errorf("fail test ClosestMatchesWithSimilarity 1")
+	}
+	candidates := make([]string, 5000)
+	for i := range candidates {
+		candidates[i] = "candidate-" + strconv.Itoa(i)
+	}
+	m = ClosestMatchesWithSimilarity("candidate-4242", candidates, 3, LevenshteinSimilarity)
+	if len(m) != 3 || m[0].Value != "candidate-4242" || m[0].Score != 1 || m[1].Value != "candidate-242" {
+		t.Errorf("fail test ClosestMatchesWithSimilarity 2")
+	}
+}
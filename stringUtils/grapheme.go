@@ -0,0 +1,406 @@
+package stringUtils
+
+import "unicode"
+
+// graphemeProperty is the Grapheme_Cluster_Break property of a character,
+// as defined by Unicode Standard Annex #29.
+type graphemeProperty int
+
+const (
+	gpAny graphemeProperty = iota
+	gpCR
+	gpLF
+	gpControl
+	gpExtend
+	gpZWJ
+	gpRegionalIndicator
+	gpPrepend
+	gpSpacingMark
+	gpL
+	gpV
+	gpT
+	gpLV
+	gpLVT
+	gpExtendedPictographic
+)
+
+// extendedPictographic holds the characters having the Extended_Pictographic property (emoji-data.txt).
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00A9, 0x00AE, 5},
+		{0x203C, 0x2049, 13},
+		{0x2122, 0x2139, 23},
+		{0x2194, 0x2199, 1},
+		{0x21A9, 0x21AA, 1},
+		{0x231A, 0x231B, 1},
+		{0x2328, 0x2388, 96},
+		{0x23CF, 0x23CF, 1},
+		{0x23E9, 0x23F3, 1},
+		{0x23F8, 0x23FA, 1},
+		{0x24C2, 0x24C2, 1},
+		{0x25AA, 0x25AB, 1},
+		{0x25B6, 0x25C0, 10},
+		{0x25FB, 0x25FE, 1},
+		{0x2600, 0x2605, 1},
+		{0x2607, 0x2612, 1},
+		{0x2614, 0x2685, 1},
+		{0x2690, 0x2705, 1},
+		{0x2708, 0x2712, 1},
+		{0x2714, 0x2716, 2},
+		{0x271D, 0x2721, 4},
+		{0x2728, 0x2728, 1},
+		{0x2733, 0x2734, 1},
+		{0x2744, 0x2747, 3},
+		{0x274C, 0x274E, 2},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1},
+		{0x2795, 0x2797, 1},
+		{0x27A1, 0x27B0, 15},
+		{0x27BF, 0x27BF, 1},
+		{0x2934, 0x2935, 1},
+		{0x2B05, 0x2B07, 1},
+		{0x2B1B, 0x2B1C, 1},
+		{0x2B50, 0x2B55, 5},
+		{0x3030, 0x303D, 13},
+		{0x3297, 0x3299, 2},
+	},
+	R32: []unicode.Range32{
+		{0x1F000, 0x1F0FF, 1},
+		{0x1F10D, 0x1F10F, 1},
+		{0x1F12F, 0x1F12F, 1},
+		{0x1F16C, 0x1F171, 1},
+		{0x1F17E, 0x1F17F, 1},
+		{0x1F18E, 0x1F18E, 1},
+		{0x1F191, 0x1F19A, 1},
+		{0x1F1AD, 0x1F1E5, 1},
+		{0x1F201, 0x1F20F, 1},
+		{0x1F21A, 0x1F22F, 21},
+		{0x1F232, 0x1F23A, 1},
+		{0x1F23C, 0x1F23F, 1},
+		{0x1F249, 0x1F3FA, 1},
+		{0x1F400, 0x1F53D, 1},
+		{0x1F546, 0x1F64F, 1},
+		{0x1F680, 0x1F6FF, 1},
+		{0x1F774, 0x1F77F, 1},
+		{0x1F7D5, 0x1F7FF, 1},
+		{0x1F80C, 0x1F80F, 1},
+		{0x1F848, 0x1F84F, 1},
+		{0x1F85A, 0x1F85F, 1},
+		{0x1F888, 0x1F88F, 1},
+		{0x1F8AE, 0x1F8FF, 1},
+		{0x1F90C, 0x1F93A, 1},
+		{0x1F93C, 0x1F945, 1},
+		{0x1F947, 0x1FAFF, 1},
+		{0x1FC00, 0x1FFFD, 1},
+	},
+	LatinOffset: 1,
+}
+
+// graphemePrepend holds the characters having the Prepend property which are not
+// Prepended_Concatenation_Mark.
+var graphemePrepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0D4E, 0x0D4E, 1},
+	},
+	R32: []unicode.Range32{
+		{0x111C2, 0x111C3, 1},
+		{0x1193F, 0x11941, 2},
+		{0x11A3A, 0x11A3A, 1},
+		{0x11A84, 0x11A89, 1},
+		{0x11D46, 0x11D46, 1},
+		{0x11F02, 0x11F02, 1},
+	},
+}
+
+// notSpacingMark holds the spacing combining marks (Mc) which are excluded from SpacingMark.
+var notSpacingMark = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x102B, 0x102C, 1},
+		{0x1038, 0x1038, 1},
+		{0x1062, 0x1064, 1},
+		{0x1067, 0x106D, 1},
+		{0x1083, 0x1083, 1},
+		{0x1087, 0x108C, 1},
+		{0x108F, 0x108F, 1},
+		{0x109A, 0x109C, 1},
+		{0x1A61, 0x1A63, 2},
+		{0x1A64, 0x1A64, 1},
+		{0xAA7B, 0xAA7D, 2},
+	},
+	R32: []unicode.Range32{
+		{0x11720, 0x11721, 1},
+	},
+}
+
+// conjunctLinker holds the viramas having the Indic_Conjunct_Break=Linker property.
+var conjunctLinker = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x094D, 0x09CD, 128},
+		{0x0ACD, 0x0B4D, 128},
+		{0x0C4D, 0x0D4D, 256},
+	},
+}
+
+// conjunctConsonant holds the consonants having the Indic_Conjunct_Break=Consonant property.
+var conjunctConsonant = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0915, 0x0939, 1},
+		{0x0958, 0x095F, 1},
+		{0x0978, 0x097F, 1},
+		{0x0995, 0x09A8, 1},
+		{0x09AA, 0x09B0, 1},
+		{0x09B2, 0x09B2, 1},
+		{0x09B6, 0x09B9, 1},
+		{0x09DC, 0x09DD, 1},
+		{0x09DF, 0x09DF, 1},
+		{0x09F0, 0x09F1, 1},
+		{0x0A95, 0x0AA8, 1},
+		{0x0AAA, 0x0AB0, 1},
+		{0x0AB2, 0x0AB3, 1},
+		{0x0AB5, 0x0AB9, 1},
+		{0x0AF9, 0x0AF9, 1},
+		{0x0B15, 0x0B28, 1},
+		{0x0B2A, 0x0B30, 1},
+		{0x0B32, 0x0B33, 1},
+		{0x0B35, 0x0B39, 1},
+		{0x0B5C, 0x0B5D, 1},
+		{0x0B5F, 0x0B71, 18},
+		{0x0C15, 0x0C28, 1},
+		{0x0C2A, 0x0C39, 1},
+		{0x0C58, 0x0C5A, 1},
+		{0x0D15, 0x0D3A, 1},
+	},
+}
+
+// graphemePropertyOf returns the Grapheme_Cluster_Break property of a character.
+func graphemePropertyOf(r rune) graphemeProperty {
+	switch {
+	case r < 0x7F:
+		if r == '\r' {
+			return gpCR
+		} else if r == '\n' {
+			return gpLF
+		} else if r < 0x20 {
+			return gpControl
+		}
+		return gpAny
+	case r == 0x200D:
+		return gpZWJ
+	case r == 0x200C || (r >= 0x1F3FB && r <= 0x1F3FF) ||
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend):
+		return gpExtend
+	case unicode.Is(unicode.Regional_Indicator, r):
+		return gpRegionalIndicator
+	case unicode.In(r, unicode.Prepended_Concatenation_Mark, graphemePrepend):
+		return gpPrepend
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Cs, unicode.Zl, unicode.Zp):
+		return gpControl
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return gpL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return gpV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return gpT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gpLV
+		}
+		return gpLVT
+	case r == 0x0E33 || r == 0x0EB3 || (unicode.Is(unicode.Mc, r) && !unicode.Is(notSpacingMark, r)):
+		return gpSpacingMark
+	case unicode.Is(extendedPictographic, r):
+		return gpExtendedPictographic
+	}
+	return gpAny
+}
+
+// graphemeState holds the context needed to find a grapheme cluster boundary
+// between the previous character and the next one.
+type graphemeState struct {
+	// prev is the property of the previous character.
+	prev graphemeProperty
+	// emoji is 1 after Extended_Pictographic Extend*, 2 after Extended_Pictographic Extend* ZWJ.
+	emoji int
+	// regional is the number of consecutive regional indicators before the next character.
+	regional int
+	// conjunct is 1 after an Indic consonant followed by extenders,
+	// 2 when at least one linker followed it.
+	conjunct int
+}
+
+// isBoundary reports whether there is a grapheme cluster boundary before a character.
+func (s *graphemeState) isBoundary(r rune, next graphemeProperty) bool {
+	prev := s.prev
+	switch {
+	case prev == gpCR && next == gpLF:
+		return false
+	case prev == gpCR || prev == gpLF || prev == gpControl:
+		return true
+	case next == gpCR || next == gpLF || next == gpControl:
+		return true
+	case prev == gpL && (next == gpL || next == gpV || next == gpLV || next == gpLVT):
+		return false
+	case (prev == gpLV || prev == gpV) && (next == gpV || next == gpT):
+		return false
+	case (prev == gpLVT || prev == gpT) && next == gpT:
+		return false
+	case next == gpExtend || next == gpZWJ || next == gpSpacingMark:
+		return false
+	case prev == gpPrepend:
+		return false
+	case s.conjunct == 2 && unicode.Is(conjunctConsonant, r):
+		return false
+	case prev == gpZWJ && next == gpExtendedPictographic && s.emoji == 2:
+		return false
+	case prev == gpRegionalIndicator && next == gpRegionalIndicator && s.regional%2 == 1:
+		return false
+	}
+	return true
+}
+
+// advance updates the state after a character.
+func (s *graphemeState) advance(r rune, next graphemeProperty) {
+	switch {
+	case next == gpExtendedPictographic:
+		s.emoji = 1
+	case s.emoji == 1 && next == gpExtend:
+	case s.emoji == 1 && next == gpZWJ:
+		s.emoji = 2
+	default:
+		s.emoji = 0
+	}
+	if next == gpRegionalIndicator {
+		s.regional++
+	} else {
+		s.regional = 0
+	}
+	switch {
+	case unicode.Is(conjunctConsonant, r):
+		s.conjunct = 1
+	case s.conjunct > 0 && unicode.Is(conjunctLinker, r):
+		s.conjunct = 2
+	case s.conjunct > 0 && (next == gpExtend || next == gpZWJ):
+	default:
+		s.conjunct = 0
+	}
+	s.prev = next
+}
+
+// firstGraphemeLen returns the length in bytes of the first grapheme cluster of a string.
+func firstGraphemeLen(str string) int {
+	var state graphemeState
+	for i, r := range str {
+		prop := graphemePropertyOf(r)
+		if i > 0 && state.isBoundary(r, prop) {
+			return i
+		}
+		state.advance(r, prop)
+	}
+	return len(str)
+}
+
+// graphemeBoundaries returns the byte offsets at which each grapheme cluster of a string starts,
+// followed by the length of the string.
+func graphemeBoundaries(str string) []int {
+	bounds := make([]int, 0, len(str)+1)
+	for i := 0; i < len(str); i += firstGraphemeLen(str[i:]) {
+		bounds = append(bounds, i)
+	}
+	return append(bounds, len(str))
+}
+
+// runeBoundaries returns the byte offsets at which each character (rune) of a string starts,
+// followed by the length of the string.
+func runeBoundaries(str string) []int {
+	bounds := make([]int, 0, len(str)+1)
+	for i := range str {
+		bounds = append(bounds, i)
+	}
+	return append(bounds, len(str))
+}
+
+// Graphemes splits a string into its extended grapheme clusters, as defined by Unicode Standard Annex #29.
+// A grapheme cluster is what a user perceives as a single character, for instance a letter followed by
+// combining accents, a flag made of two regional indicators or an emoji ZWJ sequence.
+func Graphemes(str string) []string {
+	bounds := graphemeBoundaries(str)
+	clusters := make([]string, len(bounds)-1)
+	for i := range clusters {
+		clusters[i] = str[bounds[i]:bounds[i+1]]
+	}
+	return clusters
+}
+
+// GraphemeCount returns the number of grapheme clusters (user-perceived characters) of a string.
+func GraphemeCount(str string) int {
+	count := 0
+	for i := 0; i < len(str); i += firstGraphemeLen(str[i:]) {
+		count++
+	}
+	return count
+}
+
+// AbbreviateGraphemes abbreviates a string using ellipses, counting grapheme clusters
+// so that a user-perceived character is never split.
+func AbbreviateGraphemes(str string, maxWidth int) string {
+	return AbbreviateGraphemesWithOffset(str, 0, maxWidth)
+}
+
+// AbbreviateGraphemesWithOffset abbreviates a string using ellipses at a specific offset,
+// counting grapheme clusters so that a user-perceived character is never split.
+func AbbreviateGraphemesWithOffset(str string, offset int, maxWidth int) string {
+	return internalAbbreviate(str, graphemeBoundaries(str), offset, maxWidth)
+}
+
+// LeftGraphemes gets the leftmost size grapheme clusters of a string.
+func LeftGraphemes(str string, size int) string {
+	if str == "" || size < 0 {
+		return ""
+	}
+	end := 0
+	for ; size > 0 && end < len(str); size-- {
+		end += firstGraphemeLen(str[end:])
+	}
+	return str[:end]
+}
+
+// MidGraphemes gets size grapheme clusters from the middle of a string.
+func MidGraphemes(str string, pos int, size int) string {
+	bounds := graphemeBoundaries(str)
+	count := len(bounds) - 1
+	if str == "" || size < 0 || pos > count {
+		return ""
+	}
+	if pos < 0 {
+		pos = 0
+	}
+	if count <= pos+size {
+		return str[bounds[pos]:]
+	}
+	return str[bounds[pos]:bounds[pos+size]]
+}
+
+// RightGraphemes gets the rightmost size grapheme clusters of a string.
+func RightGraphemes(str string, size int) string {
+	if str == "" || size < 0 {
+		return ""
+	}
+	bounds := graphemeBoundaries(str)
+	count := len(bounds) - 1
+	if count <= size {
+		return str
+	}
+	return str[bounds[count-size]:]
+}
+
+// ReverseGraphemes reverses a string grapheme cluster by grapheme cluster, so that combining
+// accents, emoji modifiers and flags are kept together.
+func ReverseGraphemes(str string) string {
+	bounds := graphemeBoundaries(str)
+	buff := make([]byte, 0, len(str))
+	for i := len(bounds) - 1; i > 0; i-- {
+		buff = append(buff, str[bounds[i-1]:bounds[i]]...)
+	}
+	return string(buff)
+}
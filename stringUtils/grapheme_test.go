@@ -0,0 +1,139 @@
+package stringUtils
+
+import "testing"
+
+func TestGraphemes(t *testing.T) {
+	if len(Graphemes("")) != 0 {
+		t.Errorf("fail test Graphemes 1")
+	}
+	g := Graphemes("e\u0301te\u0301")
+	if len(g) != 3 || g[0] != "e\u0301" || g[1] != "t" || g[2] != "e\u0301" {
+		t.Errorf("fail test Graphemes 2")
+	}
+	g = Graphemes("🇫🇷🇯🇵🇩")
+	if len(g) != 3 || g[0] != "🇫🇷" || g[1] != "🇯🇵" || g[2] != "🇩" {
+		t.Errorf("fail test Graphemes 3")
+	}
+	g = Graphemes("👍🏽\U0001F468\u200D\U0001F469\u200D\U0001F467!")
+	if len(g) != 3 || g[0] != "👍🏽" || g[1] != "\U0001F468\u200D\U0001F469\u200D\U0001F467" || g[2] != "!" {
+		t.Errorf("fail test Graphemes 4")
+	}
+	g = Graphemes("a\r\nb\n\r")
+	if len(g) != 5 || g[1] != "\r\n" {
+		t.Errorf("fail test Graphemes 5")
+	}
+	g = Graphemes("각각")
+	if len(g) != 2 || g[0] != "각" {
+		t.Errorf("fail test Graphemes 6")
+	}
+	g = Graphemes("नमस्ते")
+	if len(g) != 3 || g[2] != "स्ते" {
+		t.Errorf("fail test Graphemes 7")
+	}
+	g = Graphemes("a\u200db")
+	if len(g) != 2 || g[0] != "a\u200d" {
+		t.Errorf("fail test Graphemes 8")
+	}
+}
+
+func TestGraphemeCount(t *testing.T) {
+	if GraphemeCount("") != 0 {
+		t.Errorf("fail test GraphemeCount 1")
+	}
+	if GraphemeCount("abc") != 3 {
+		t.Errorf("fail test GraphemeCount 2")
+	}
+	if GraphemeCount("Ame\u0301lie") != 6 {
+		t.Errorf("fail test GraphemeCount 3")
+	}
+	if GraphemeCount("\U0001F3F3\uFE0F\u200D\U0001F308🇨🇦") != 2 {
+		t.Errorf("fail test GraphemeCount 4")
+	}
+}
+
+func TestAbbreviateGraphemes(t *testing.T) {
+	if AbbreviateGraphemes("", 4) != "" {
+		t.Errorf("fail test AbbreviateGraphemes 1")
+	}
+	if AbbreviateGraphemes("abcdefg", 6) != "abc..." {
+		t.Errorf("fail test AbbreviateGraphemes 2")
+	}
+	if AbbreviateGraphemes("ame\u0301lie", 6) != "ame\u0301lie" {
+		t.Errorf("fail test AbbreviateGraphemes 3")
+	}
+	if AbbreviateGraphemes("ame\u0301lie", 5) != "am..." {
+		t.Errorf("fail test AbbreviateGraphemes 4")
+	}
+	if AbbreviateGraphemes("👍🏽👍🏽👍🏽👍🏽👍🏽", 4) != "👍🏽..." {
+		t.Errorf("fail test AbbreviateGraphemes 5")
+	}
+	if AbbreviateGraphemesWithOffset("🇫🇷🇩🇪🇮🇹🇪🇸🇵🇹🇧🇪🇳🇱🇱🇺🇦🇹🇨🇭", 5, 7) != "...🇧🇪..." {
+		t.Errorf("fail test AbbreviateGraphemes 6")
+	}
+}
+
+func TestLeftGraphemes(t *testing.T) {
+	if LeftGraphemes("", 2) != "" {
+		t.Errorf("fail test LeftGraphemes 1")
+	}
+	if LeftGraphemes("abc", -1) != "" {
+		t.Errorf("fail test LeftGraphemes 2")
+	}
+	if LeftGraphemes("e\u0301te\u0301", 1) != "e\u0301" {
+		t.Errorf("fail test LeftGraphemes 3")
+	}
+	if LeftGraphemes("🇫🇷🇯🇵", 5) != "🇫🇷🇯🇵" {
+		t.Errorf("fail test LeftGraphemes 4")
+	}
+}
+
+func TestMidGraphemes(t *testing.T) {
+	if MidGraphemes("", 0, 2) != "" {
+		t.Errorf("fail test MidGraphemes 1")
+	}
+	if MidGraphemes("e\u0301te\u0301", 1, 5) != "te\u0301" {
+		t.Errorf("fail test MidGraphemes 2")
+	}
+	if MidGraphemes("👍🏽\U0001F468\u200D\U0001F469\u200D\U0001F467!", 1, 1) != "\U0001F468\u200D\U0001F469\u200D\U0001F467" {
+		t.Errorf("fail test MidGraphemes 3")
+	}
+	if MidGraphemes("abc", -1, 2) != "ab" {
+		t.Errorf("fail test MidGraphemes 4")
+	}
+	if MidGraphemes("abc", 4, 2) != "" {
+		t.Errorf("fail test MidGraphemes 5")
+	}
+}
+
+func TestRightGraphemes(t *testing.T) {
+	if RightGraphemes("", 2) != "" {
+		t.Errorf("fail test RightGraphemes 1")
+	}
+	if RightGraphemes("e\u0301te\u0301", 1) != "e\u0301" {
+		t.Errorf("fail test RightGraphemes 2")
+	}
+	if RightGraphemes("👍🏽\U0001F468\u200D\U0001F469\u200D\U0001F467", 1) != "\U0001F468\u200D\U0001F469\u200D\U0001F467" {
+		t.Errorf("fail test RightGraphemes 3")
+	}
+	if RightGraphemes("abc", 5) != "abc" {
+		t.Errorf("fail test RightGraphemes 4")
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	if ReverseGraphemes("") != "" {
+		t.Errorf("fail test ReverseGraphemes 1")
+	}
+	if ReverseGraphemes("abc") != "cba" {
+		t.Errorf("fail test ReverseGraphemes 2")
+	}
+	if ReverseGraphemes("e\u0301te\u0301") != "e\u0301te\u0301" {
+		t.Errorf("fail test ReverseGraphemes 3")
+	}
+	if ReverseGraphemes("🇫🇷👍🏽") != "👍🏽🇫🇷" {
+		t.Errorf("fail test ReverseGraphemes 4")
+	}
+	if ReverseGraphemes("a\r\nb") != "b\r\na" {
+		t.Errorf("fail test ReverseGraphemes 5")
+	}
+}
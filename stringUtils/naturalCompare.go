@@ -0,0 +1,149 @@
+package stringUtils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NaturalOrder sorts strings in natural order, as compared by NaturalCompare.
+//
+//	sort.Sort(NaturalOrder(files))
+type NaturalOrder []string
+
+func (n NaturalOrder) Len() int           { return len(n) }
+func (n NaturalOrder) Less(i, j int) bool { return NaturalCompare(n[i], n[j]) < 0 }
+func (n NaturalOrder) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+
+// NaturalOrderIgnoreCase sorts strings in natural order ignoring case, as compared by NaturalCompareIgnoreCase,
+// the strings differing only by case being sorted by NaturalCompare.
+type NaturalOrderIgnoreCase []string
+
+func (n NaturalOrderIgnoreCase) Len() int { return len(n) }
+func (n NaturalOrderIgnoreCase) Less(i, j int) bool {
+	if c := NaturalCompareIgnoreCase(n[i], n[j]); c != 0 {
+		return c < 0
+	}
+	return NaturalCompare(n[i], n[j]) < 0
+}
+func (n NaturalOrderIgnoreCase) Swap(i, j int) { n[i], n[j] = n[j], n[i] }
+
+// NaturalCompare compares two strings in natural order, returning -1, 0 or 1 like strings.Compare.
+// It can be given to slices.SortFunc.
+//
+// The runs of digits, in any script, are compared by their numeric value, whatever their length,
+// and come before the other characters, which are compared by code point. The strings whose numbers
+// are equal but written differently are ordered by their leading zeros, fewer first, and then
+// by strings.Compare, so that only equal strings compare equal.
+//
+//	NaturalCompare("file2", "file10") = -1
+//	NaturalCompare("v1.10", "v1.9")   = 1
+//	NaturalCompare("a01", "a1")       = 1
+//	NaturalCompare("x٣", "x10")       = -1
+//	NaturalCompare("file2", "file2")  = 0
+func NaturalCompare(a, b string) int {
+	if c := naturalCompare(a, b); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// NaturalCompareIgnoreCase compares two strings in natural order as NaturalCompare does, ignoring case
+// as FoldCase does. The strings differing only by case compare equal.
+//
+//	NaturalCompareIgnoreCase("File2", "file10")   = -1
+//	NaturalCompareIgnoreCase("FILE2", "file2")    = 0
+//	NaturalCompareIgnoreCase("STRASSE", "straße") = 0
+func NaturalCompareIgnoreCase(a, b string) int {
+	return naturalCompare(FoldCase(a), FoldCase(b))
+}
+
+// naturalCompare compares two strings in natural order, returning 0 for the strings
+// whose numbers are written with different digits.
+func naturalCompare(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	i, j := 0, 0
+	// zeros is the comparison of the leading zeros of the first numbers which have different ones
+	zeros := 0
+	for i < len(ra) && j < len(rb) {
+		da, db := digitValue(ra[i]), digitValue(rb[j])
+		switch {
+		case da >= 0 && db >= 0:
+			endA, endB := digitsEnd(ra, i), digitsEnd(rb, j)
+			// skip the leading zeros, keeping the last digit
+			startA, startB := i, j
+			for startA < endA-1 && digitValue(ra[startA]) == 0 {
+				startA++
+			}
+			for startB < endB-1 && digitValue(rb[startB]) == 0 {
+				startB++
+			}
+			if c := compareInts(endA-startA, endB-startB); c != 0 {
+				return c
+			}
+			for k := 0; k < endA-startA; k++ {
+				if c := compareInts(digitValue(ra[startA+k]), digitValue(rb[startB+k])); c != 0 {
+					return c
+				}
+			}
+			if zeros == 0 {
+				zeros = compareInts(startA-i, startB-j)
+			}
+			i, j = endA, endB
+		case da >= 0:
+			return -1
+		case db >= 0:
+			return 1
+		default:
+			if c := compareInts(int(ra[i]), int(rb[j])); c != 0 {
+				return c
+			}
+			i++
+			j++
+		}
+	}
+	if c := compareInts(len(ra)-i, len(rb)-j); c != 0 {
+		return c
+	}
+	return zeros
+}
+
+// digitsEnd returns the index following the run of digits starting at index i.
+func digitsEnd(runes []rune, i int) int {
+	for i < len(runes) && digitValue(runes[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// digitValue returns the value of a decimal digit in any script, or -1 if the character is not one.
+// The decimal digits of each script are consecutive, from 0 to 9.
+func digitValue(r rune) int {
+	if r >= '0' && r <= '9' {
+		return int(r - '0')
+	}
+	if r < 0x80 || !unicode.IsDigit(r) {
+		return -1
+	}
+	for _, r16 := range unicode.Nd.R16 {
+		if r >= rune(r16.Lo) && r <= rune(r16.Hi) {
+			return int(r-rune(r16.Lo)) % 10
+		}
+	}
+	for _, r32 := range unicode.Nd.R32 {
+		if r >= rune(r32.Lo) && r <= rune(r32.Hi) {
+			return int(r-rune(r32.Lo)) % 10
+		}
+	}
+	return -1
+}
+
+// compareInts returns -1, 0 or 1 as a is less than, equal to or greater than b.
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
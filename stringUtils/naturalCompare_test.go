@@ -0,0 +1,56 @@
+package stringUtils
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	if NaturalCompare("", "") != 0 || NaturalCompare("", "a") != -1 || NaturalCompare("file2", "file2") != 0 {
+		t.Errorf("fail test NaturalCompare 1")
+	}
+	if NaturalCompare("file2", "file10") != -1 || NaturalCompare("file10", "file2") != 1 || NaturalCompare("v1.10", "v1.9") != 1 {
+		t.Errorf("fail test NaturalCompare 2")
+	}
+	// leading zeros only order the strings whose numbers are equal
+	if NaturalCompare("a01", "a1") != 1 || NaturalCompare("a001", "a2") != -1 || NaturalCompare("a01b", "a1c") != -1 {
+		t.Errorf("fail test NaturalCompare 3")
+	}
+	if NaturalCompare("x٣", "x10") != -1 || NaturalCompare("x٣", "x3") == 0 || NaturalCompare("x٣", "x3") != -NaturalCompare("x3", "x٣") {
+		t.Errorf("fail test NaturalCompare 4")
+	}
+	// numbers come before the other characters
+	if NaturalCompare("a1", "a-") != -1 || NaturalCompare("1", "a") != -1 || NaturalCompare("a", "a1") != -1 {
+		t.Errorf("fail test NaturalCompare 5")
+	}
+	if NaturalCompare("99999999999999999999999", "100000000000000000000000") != -1 {
+		t.Errorf("fail test NaturalCompare 6")
+	}
+}
+
+func TestNaturalCompareIgnoreCase(t *testing.T) {
+	if NaturalCompareIgnoreCase("File2", "file10") != -1 || NaturalCompareIgnoreCase("FILE2", "file2") != 0 {
+		t.Errorf("fail test NaturalCompareIgnoreCase 1")
+	}
+	if NaturalCompareIgnoreCase("STRASSE", "straße") != 0 || NaturalCompareIgnoreCase("b", "A") != 1 {
+		t.Errorf("fail test NaturalCompareIgnoreCase 2")
+	}
+}
+
+func TestNaturalOrder(t *testing.T) {
+	files := []string{"file10.txt", "file2.txt", "File1.txt", "file02.txt", "file1.txt", "file"}
+	sort.Sort(NaturalOrder(files))
+	if !slicesEqual(files, "File1.txt", "file", "file1.txt", "file2.txt", "file02.txt", "file10.txt") {
+		t.Errorf("fail test NaturalOrder 1")
+	}
+	sort.Sort(NaturalOrderIgnoreCase(files))
+	if !slicesEqual(files, "file", "File1.txt", "file1.txt", "file2.txt", "file02.txt", "file10.txt") {
+		t.Errorf("fail test NaturalOrder 2")
+	}
+	mixed := []string{"x10", "v1.10", "b", "a01", "img12", "10", "x٣", "v1.9", "img10b", "a1", "9"}
+	slices.SortFunc(mixed, NaturalCompare)
+	if !slicesEqual(mixed, "9", "10", "a1", "a01", "b", "img10b", "img12", "v1.9", "v1.10", "x٣", "x10") {
+		t.Errorf("fail test NaturalOrder 3")
+	}
+}
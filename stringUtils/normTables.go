@@ -0,0 +1,1929 @@
+// Code generated by normTablesGen.go from the Unicode Character Database 17.0.0. DO NOT EDIT.
+
+package stringUtils
+
+// unicodeVersion is the version of the Unicode Character Database the normalization tables come from.
+const unicodeVersion = "17.0.0"
+
+// combiningClasses holds the ranges of characters having a non zero canonical combining class, sorted.
+var combiningClasses = []combiningClassRange{
+	{0x0300, 0x0314, 230}, {0x0315, 0x0315, 232}, {0x0316, 0x0319, 220}, {0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216}, {0x031C, 0x0320, 220}, {0x0321, 0x0322, 202}, {0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202}, {0x0329, 0x0333, 220}, {0x0334, 0x0338, 1}, {0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230}, {0x0345, 0x0345, 240}, {0x0346, 0x0346, 230}, {0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230}, {0x034D, 0x034E, 220}, {0x0350, 0x0352, 230}, {0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230}, {0x0358, 0x0358, 232}, {0x0359, 0x035A, 220}, {0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233}, {0x035D, 0x035E, 234}, {0x035F, 0x035F, 233}, {0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233}, {0x0363, 0x036F, 230}, {0x0483, 0x0487, 230}, {0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230}, {0x0596, 0x0596, 220}, {0x0597, 0x0599, 230}, {0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220}, {0x059C, 0x05A1, 230}, {0x05A2, 0x05A7, 220}, {0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220}, {0x05AB, 0x05AC, 230}, {0x05AD, 0x05AD, 222}, {0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230}, {0x05B0, 0x05B0, 10}, {0x05B1, 0x05B1, 11}, {0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13}, {0x05B4, 0x05B4, 14}, {0x05B5, 0x05B5, 15}, {0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17}, {0x05B8, 0x05B8, 18}, {0x05B9, 0x05BA, 19}, {0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21}, {0x05BD, 0x05BD, 22}, {0x05BF, 0x05BF, 23}, {0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25}, {0x05C4, 0x05C4, 230}, {0x05C5, 0x05C5, 220}, {0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230}, {0x0618, 0x0618, 30}, {0x0619, 0x0619, 31}, {0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27}, {0x064C, 0x064C, 28}, {0x064D, 0x064D, 29}, {0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31}, {0x0650, 0x0650, 32}, {0x0651, 0x0651, 33}, {0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230}, {0x0655, 0x0656, 220}, {0x0657, 0x065B, 230}, {0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230}, {0x065F, 0x065F, 220}, {0x0670, 0x0670, 35}, {0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230}, {0x06E3, 0x06E3, 220}, {0x06E4, 0x06E4, 230}, {0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220}, {0x06EB, 0x06EC, 230}, {0x06ED, 0x06ED, 220}, {0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230}, {0x0731, 0x0731, 220}, {0x0732, 0x0733, 230}, {0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230}, {0x0737, 0x0739, 220}, {0x073A, 0x073A, 230}, {0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230}, {0x073E, 0x073E, 220}, {0x073F, 0x0741, 230}, {0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230}, {0x0744, 0x0744, 220}, {0x0745, 0x0745, 230}, {0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230}, {0x0748, 0x0748, 220}, {0x0749, 0x074A, 230}, {0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220}, {0x07F3, 0x07F3, 230}, {0x07FD, 0x07FD, 220}, {0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230}, {0x0825, 0x0827, 230}, {0x0829, 0x082D, 230}, {0x0859, 0x085B, 220},
+	{0x0897, 0x0898, 230}, {0x0899, 0x089B, 220}, {0x089C, 0x089F, 230}, {0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220}, {0x08D4, 0x08E1, 230}, {0x08E3, 0x08E3, 220}, {0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220}, {0x08E7, 0x08E8, 230}, {0x08E9, 0x08E9, 220}, {0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220}, {0x08F0, 0x08F0, 27}, {0x08F1, 0x08F1, 28}, {0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230}, {0x08F6, 0x08F6, 220}, {0x08F7, 0x08F8, 230}, {0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230}, {0x093C, 0x093C, 7}, {0x094D, 0x094D, 9}, {0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220}, {0x0953, 0x0954, 230}, {0x09BC, 0x09BC, 7}, {0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230}, {0x0A3C, 0x0A3C, 7}, {0x0A4D, 0x0A4D, 9}, {0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9}, {0x0B3C, 0x0B3C, 7}, {0x0B4D, 0x0B4D, 9}, {0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7}, {0x0C4D, 0x0C4D, 9}, {0x0C55, 0x0C55, 84}, {0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7}, {0x0CCD, 0x0CCD, 9}, {0x0D3B, 0x0D3C, 9}, {0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9}, {0x0E38, 0x0E39, 103}, {0x0E3A, 0x0E3A, 9}, {0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118}, {0x0EBA, 0x0EBA, 9}, {0x0EC8, 0x0ECB, 122}, {0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220}, {0x0F37, 0x0F37, 220}, {0x0F39, 0x0F39, 216}, {0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130}, {0x0F74, 0x0F74, 132}, {0x0F7A, 0x0F7D, 130}, {0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230}, {0x0F84, 0x0F84, 9}, {0x0F86, 0x0F87, 230}, {0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7}, {0x1039, 0x103A, 9}, {0x108D, 0x108D, 220}, {0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9}, {0x1734, 0x1734, 9}, {0x17D2, 0x17D2, 9}, {0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228}, {0x1939, 0x1939, 222}, {0x193A, 0x193A, 230}, {0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230}, {0x1A18, 0x1A18, 220}, {0x1A60, 0x1A60, 9}, {0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220}, {0x1AB0, 0x1AB4, 230}, {0x1AB5, 0x1ABA, 220}, {0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220}, {0x1ABF, 0x1AC0, 220}, {0x1AC1, 0x1AC2, 230}, {0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230}, {0x1ACA, 0x1ACA, 220}, {0x1ACB, 0x1ADC, 230}, {0x1ADD, 0x1ADD, 220},
+	{0x1AE0, 0x1AE5, 230}, {0x1AE6, 0x1AE6, 220}, {0x1AE7, 0x1AEA, 230}, {0x1AEB, 0x1AEB, 234},
+	{0x1B34, 0x1B34, 7}, {0x1B44, 0x1B44, 9}, {0x1B6B, 0x1B6B, 230}, {0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230}, {0x1BAA, 0x1BAB, 9}, {0x1BE6, 0x1BE6, 7}, {0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7}, {0x1CD0, 0x1CD2, 230}, {0x1CD4, 0x1CD4, 1}, {0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230}, {0x1CDC, 0x1CDF, 220}, {0x1CE0, 0x1CE0, 230}, {0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220}, {0x1CF4, 0x1CF4, 230}, {0x1CF8, 0x1CF9, 230}, {0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220}, {0x1DC3, 0x1DC9, 230}, {0x1DCA, 0x1DCA, 220}, {0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234}, {0x1DCE, 0x1DCE, 214}, {0x1DCF, 0x1DCF, 220}, {0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230}, {0x1DF6, 0x1DF6, 232}, {0x1DF7, 0x1DF8, 228}, {0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218}, {0x1DFB, 0x1DFB, 230}, {0x1DFC, 0x1DFC, 233}, {0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230}, {0x1DFF, 0x1DFF, 220}, {0x20D0, 0x20D1, 230}, {0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230}, {0x20D8, 0x20DA, 1}, {0x20DB, 0x20DC, 230}, {0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1}, {0x20E7, 0x20E7, 230}, {0x20E8, 0x20E8, 220}, {0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1}, {0x20EC, 0x20EF, 220}, {0x20F0, 0x20F0, 230}, {0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9}, {0x2DE0, 0x2DFF, 230}, {0x302A, 0x302A, 218}, {0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232}, {0x302D, 0x302D, 222}, {0x302E, 0x302F, 224}, {0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230}, {0xA674, 0xA67D, 230}, {0xA69E, 0xA69F, 230}, {0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9}, {0xA82C, 0xA82C, 9}, {0xA8C4, 0xA8C4, 9}, {0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220}, {0xA953, 0xA953, 9}, {0xA9B3, 0xA9B3, 7}, {0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230}, {0xAAB2, 0xAAB3, 230}, {0xAAB4, 0xAAB4, 220}, {0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230}, {0xAAC1, 0xAAC1, 230}, {0xAAF6, 0xAAF6, 9}, {0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26}, {0xFE20, 0xFE26, 230}, {0xFE27, 0xFE2D, 220}, {0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220}, {0x102E0, 0x102E0, 220}, {0x10376, 0x1037A, 230}, {0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230}, {0x10A38, 0x10A38, 230}, {0x10A39, 0x10A39, 1}, {0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9}, {0x10AE5, 0x10AE5, 230}, {0x10AE6, 0x10AE6, 220}, {0x10D24, 0x10D27, 230},
+	{0x10D69, 0x10D6D, 230}, {0x10EAB, 0x10EAC, 230}, {0x10EFA, 0x10EFB, 220}, {0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220}, {0x10F48, 0x10F4A, 230}, {0x10F4B, 0x10F4B, 220}, {0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220}, {0x10F82, 0x10F82, 230}, {0x10F83, 0x10F83, 220}, {0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220}, {0x11046, 0x11046, 9}, {0x11070, 0x11070, 9}, {0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9}, {0x110BA, 0x110BA, 7}, {0x11100, 0x11102, 230}, {0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7}, {0x111C0, 0x111C0, 9}, {0x111CA, 0x111CA, 7}, {0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7}, {0x112E9, 0x112E9, 7}, {0x112EA, 0x112EA, 9}, {0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9}, {0x11366, 0x1136C, 230}, {0x11370, 0x11374, 230}, {0x113CE, 0x113D0, 9},
+	{0x11442, 0x11442, 9}, {0x11446, 0x11446, 7}, {0x1145E, 0x1145E, 230}, {0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7}, {0x115BF, 0x115BF, 9}, {0x115C0, 0x115C0, 7}, {0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9}, {0x116B7, 0x116B7, 7}, {0x1172B, 0x1172B, 9}, {0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7}, {0x1193D, 0x1193E, 9}, {0x11943, 0x11943, 7}, {0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9}, {0x11A47, 0x11A47, 9}, {0x11A99, 0x11A99, 9}, {0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7}, {0x11D44, 0x11D45, 9}, {0x11D97, 0x11D97, 9}, {0x11F41, 0x11F42, 9},
+	{0x1612F, 0x1612F, 9}, {0x16AF0, 0x16AF4, 1}, {0x16B30, 0x16B36, 230}, {0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1}, {0x1D165, 0x1D166, 216}, {0x1D167, 0x1D169, 1}, {0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216}, {0x1D17B, 0x1D182, 220}, {0x1D185, 0x1D189, 230}, {0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230}, {0x1D242, 0x1D244, 230}, {0x1E000, 0x1E006, 230}, {0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230}, {0x1E023, 0x1E024, 230}, {0x1E026, 0x1E02A, 230}, {0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230}, {0x1E2AE, 0x1E2AE, 230}, {0x1E2EC, 0x1E2EF, 230}, {0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220}, {0x1E4EF, 0x1E4EF, 230}, {0x1E5EE, 0x1E5EE, 230}, {0x1E5EF, 0x1E5EF, 220},
+	{0x1E6E3, 0x1E6E3, 230}, {0x1E6E6, 0x1E6E6, 230}, {0x1E6EE, 0x1E6EF, 230}, {0x1E6F5, 0x1E6F5, 230},
+	{0x1E8D0, 0x1E8D6, 220}, {0x1E944, 0x1E949, 230}, {0x1E94A, 0x1E94A, 7},
+}
+
+// canonicalDecompositions holds the full canonical decompositions of the characters, Hangul syllables excepted.
+var canonicalDecompositions = map[rune]string{
+	0x00C0: "A\u0300", 0x00C1: "A\u0301", 0x00C2: "A\u0302", 0x00C3: "A\u0303",
+	0x00C4: "A\u0308", 0x00C5: "A\u030A", 0x00C7: "C\u0327", 0x00C8: "E\u0300",
+	0x00C9: "E\u0301", 0x00CA: "E\u0302", 0x00CB: "E\u0308", 0x00CC: "I\u0300",
+	0x00CD: "I\u0301", 0x00CE: "I\u0302", 0x00CF: "I\u0308", 0x00D1: "N\u0303",
+	0x00D2: "O\u0300", 0x00D3: "O\u0301", 0x00D4: "O\u0302", 0x00D5: "O\u0303",
+	0x00D6: "O\u0308", 0x00D9: "U\u0300", 0x00DA: "U\u0301", 0x00DB: "U\u0302",
+	0x00DC: "U\u0308", 0x00DD: "Y\u0301", 0x00E0: "a\u0300", 0x00E1: "a\u0301",
+	0x00E2: "a\u0302", 0x00E3: "a\u0303", 0x00E4: "a\u0308", 0x00E5: "a\u030A",
+	0x00E7: "c\u0327", 0x00E8: "e\u0300", 0x00E9: "e\u0301", 0x00EA: "e\u0302",
+	0x00EB: "e\u0308", 0x00EC: "i\u0300", 0x00ED: "i\u0301", 0x00EE: "i\u0302",
+	0x00EF: "i\u0308", 0x00F1: "n\u0303", 0x00F2: "o\u0300", 0x00F3: "o\u0301",
+	0x00F4: "o\u0302", 0x00F5: "o\u0303", 0x00F6: "o\u0308", 0x00F9: "u\u0300",
+	0x00FA: "u\u0301", 0x00FB: "u\u0302", 0x00FC: "u\u0308", 0x00FD: "y\u0301",
+	0x00FF: "y\u0308", 0x0100: "A\u0304", 0x0101: "a\u0304", 0x0102: "A\u0306",
+	0x0103: "a\u0306", 0x0104: "A\u0328", 0x0105: "a\u0328", 0x0106: "C\u0301",
+	0x0107: "c\u0301", 0x0108: "C\u0302", 0x0109: "c\u0302", 0x010A: "C\u0307",
+	0x010B: "c\u0307", 0x010C: "C\u030C", 0x010D: "c\u030C", 0x010E: "D\u030C",
+	0x010F: "d\u030C", 0x0112: "E\u0304", 0x0113: "e\u0304", 0x0114: "E\u0306",
+	0x0115: "e\u0306", 0x0116: "E\u0307", 0x0117: "e\u0307", 0x0118: "E\u0328",
+	0x0119: "e\u0328", 0x011A: "E\u030C", 0x011B: "e\u030C", 0x011C: "G\u0302",
+	0x011D: "g\u0302", 0x011E: "G\u0306", 0x011F: "g\u0306", 0x0120: "G\u0307",
+	0x0121: "g\u0307", 0x0122: "G\u0327", 0x0123: "g\u0327", 0x0124: "H\u0302",
+	0x0125: "h\u0302", 0x0128: "I\u0303", 0x0129: "i\u0303", 0x012A: "I\u0304",
+	0x012B: "i\u0304", 0x012C: "I\u0306", 0x012D: "i\u0306", 0x012E: "I\u0328",
+	0x012F: "i\u0328", 0x0130: "I\u0307", 0x0134: "J\u0302", 0x0135: "j\u0302",
+	0x0136: "K\u0327", 0x0137: "k\u0327", 0x0139: "L\u0301", 0x013A: "l\u0301",
+	0x013B: "L\u0327", 0x013C: "l\u0327", 0x013D: "L\u030C", 0x013E: "l\u030C",
+	0x0143: "N\u0301", 0x0144: "n\u0301", 0x0145: "N\u0327", 0x0146: "n\u0327",
+	0x0147: "N\u030C", 0x0148: "n\u030C", 0x014C: "O\u0304", 0x014D: "o\u0304",
+	0x014E: "O\u0306", 0x014F: "o\u0306", 0x0150: "O\u030B", 0x0151: "o\u030B",
+	0x0154: "R\u0301", 0x0155: "r\u0301", 0x0156: "R\u0327", 0x0157: "r\u0327",
+	0x0158: "R\u030C", 0x0159: "r\u030C", 0x015A: "S\u0301", 0x015B: "s\u0301",
+	0x015C: "S\u0302", 0x015D: "s\u0302", 0x015E: "S\u0327", 0x015F: "s\u0327",
+	0x0160: "S\u030C", 0x0161: "s\u030C", 0x0162: "T\u0327", 0x0163: "t\u0327",
+	0x0164: "T\u030C", 0x0165: "t\u030C", 0x0168: "U\u0303", 0x0169: "u\u0303",
+	0x016A: "U\u0304", 0x016B: "u\u0304", 0x016C: "U\u0306", 0x016D: "u\u0306",
+	0x016E: "U\u030A", 0x016F: "u\u030A", 0x0170: "U\u030B", 0x0171: "u\u030B",
+	0x0172: "U\u0328", 0x0173: "u\u0328", 0x0174: "W\u0302", 0x0175: "w\u0302",
+	0x0176: "Y\u0302", 0x0177: "y\u0302", 0x0178: "Y\u0308", 0x0179: "Z\u0301",
+	0x017A: "z\u0301", 0x017B: "Z\u0307", 0x017C: "z\u0307", 0x017D: "Z\u030C",
+	0x017E: "z\u030C", 0x01A0: "O\u031B", 0x01A1: "o\u031B", 0x01AF: "U\u031B",
+	0x01B0: "u\u031B", 0x01CD: "A\u030C", 0x01CE: "a\u030C", 0x01CF: "I\u030C",
+	0x01D0: "i\u030C", 0x01D1: "O\u030C", 0x01D2: "o\u030C", 0x01D3: "U\u030C",
+	0x01D4: "u\u030C", 0x01D5: "U\u0308\u0304", 0x01D6: "u\u0308\u0304", 0x01D7: "U\u0308\u0301",
+	0x01D8: "u\u0308\u0301", 0x01D9: "U\u0308\u030C", 0x01DA: "u\u0308\u030C", 0x01DB: "U\u0308\u0300",
+	0x01DC: "u\u0308\u0300", 0x01DE: "A\u0308\u0304", 0x01DF: "a\u0308\u0304", 0x01E0: "A\u0307\u0304",
+	0x01E1: "a\u0307\u0304", 0x01E2: "\u00C6\u0304", 0x01E3: "\u00E6\u0304", 0x01E6: "G\u030C",
+	0x01E7: "g\u030C", 0x01E8: "K\u030C", 0x01E9: "k\u030C", 0x01EA: "O\u0328",
+	0x01EB: "o\u0328", 0x01EC: "O\u0328\u0304", 0x01ED: "o\u0328\u0304", 0x01EE: "\u01B7\u030C",
+	0x01EF: "\u0292\u030C", 0x01F0: "j\u030C", 0x01F4: "G\u0301", 0x01F5: "g\u0301",
+	0x01F8: "N\u0300", 0x01F9: "n\u0300", 0x01FA: "A\u030A\u0301", 0x01FB: "a\u030A\u0301",
+	0x01FC: "\u00C6\u0301", 0x01FD: "\u00E6\u0301", 0x01FE: "\u00D8\u0301", 0x01FF: "\u00F8\u0301",
+	0x0200: "A\u030F", 0x0201: "a\u030F", 0x0202: "A\u0311", 0x0203: "a\u0311",
+	0x0204: "E\u030F", 0x0205: "e\u030F", 0x0206: "E\u0311", 0x0207: "e\u0311",
+	0x0208: "I\u030F", 0x0209: "i\u030F", 0x020A: "I\u0311", 0x020B: "i\u0311",
+	0x020C: "O\u030F", 0x020D: "o\u030F", 0x020E: "O\u0311", 0x020F: "o\u0311",
+	0x0210: "R\u030F", 0x0211: "r\u030F", 0x0212: "R\u0311", 0x0213: "r\u0311",
+	0x0214: "U\u030F", 0x0215: "u\u030F", 0x0216: "U\u0311", 0x0217: "u\u0311",
+	0x0218: "S\u0326", 0x0219: "s\u0326", 0x021A: "T\u0326", 0x021B: "t\u0326",
+	0x021E: "H\u030C", 0x021F: "h\u030C", 0x0226: "A\u0307", 0x0227: "a\u0307",
+	0x0228: "E\u0327", 0x0229: "e\u0327", 0x022A: "O\u0308\u0304", 0x022B: "o\u0308\u0304",
+	0x022C: "O\u0303\u0304", 0x022D: "o\u0303\u0304", 0x022E: "O\u0307", 0x022F: "o\u0307",
+	0x0230: "O\u0307\u0304", 0x0231: "o\u0307\u0304", 0x0232: "Y\u0304", 0x0233: "y\u0304",
+	0x0340: "\u0300", 0x0341: "\u0301", 0x0343: "\u0313", 0x0344: "\u0308\u0301",
+	0x0374: "\u02B9", 0x037E: ";", 0x0385: "\u00A8\u0301", 0x0386: "\u0391\u0301",
+	0x0387: "\u00B7", 0x0388: "\u0395\u0301", 0x0389: "\u0397\u0301", 0x038A: "\u0399\u0301",
+	0x038C: "\u039F\u0301", 0x038E: "\u03A5\u0301", 0x038F: "\u03A9\u0301", 0x0390: "\u03B9\u0308\u0301",
+	0x03AA: "\u0399\u0308", 0x03AB: "\u03A5\u0308", 0x03AC: "\u03B1\u0301", 0x03AD: "\u03B5\u0301",
+	0x03AE: "\u03B7\u0301", 0x03AF: "\u03B9\u0301", 0x03B0: "\u03C5\u0308\u0301", 0x03CA: "\u03B9\u0308",
+	0x03CB: "\u03C5\u0308", 0x03CC: "\u03BF\u0301", 0x03CD: "\u03C5\u0301", 0x03CE: "\u03C9\u0301",
+	0x03D3: "\u03D2\u0301", 0x03D4: "\u03D2\u0308", 0x0400: "\u0415\u0300", 0x0401: "\u0415\u0308",
+	0x0403: "\u0413\u0301", 0x0407: "\u0406\u0308", 0x040C: "\u041A\u0301", 0x040D: "\u0418\u0300",
+	0x040E: "\u0423\u0306", 0x0419: "\u0418\u0306", 0x0439: "\u0438\u0306", 0x0450: "\u0435\u0300",
+	0x0451: "\u0435\u0308", 0x0453: "\u0433\u0301", 0x0457: "\u0456\u0308", 0x045C: "\u043A\u0301",
+	0x045D: "\u0438\u0300", 0x045E: "\u0443\u0306", 0x0476: "\u0474\u030F", 0x0477: "\u0475\u030F",
+	0x04C1: "\u0416\u0306", 0x04C2: "\u0436\u0306", 0x04D0: "\u0410\u0306", 0x04D1: "\u0430\u0306",
+	0x04D2: "\u0410\u0308", 0x04D3: "\u0430\u0308", 0x04D6: "\u0415\u0306", 0x04D7: "\u0435\u0306",
+	0x04DA: "\u04D8\u0308", 0x04DB: "\u04D9\u0308", 0x04DC: "\u0416\u0308", 0x04DD: "\u0436\u0308",
+	0x04DE: "\u0417\u0308", 0x04DF: "\u0437\u0308", 0x04E2: "\u0418\u0304", 0x04E3: "\u0438\u0304",
+	0x04E4: "\u0418\u0308", 0x04E5: "\u0438\u0308", 0x04E6: "\u041E\u0308", 0x04E7: "\u043E\u0308",
+	0x04EA: "\u04E8\u0308", 0x04EB: "\u04E9\u0308", 0x04EC: "\u042D\u0308", 0x04ED: "\u044D\u0308",
+	0x04EE: "\u0423\u0304", 0x04EF: "\u0443\u0304", 0x04F0: "\u0423\u0308", 0x04F1: "\u0443\u0308",
+	0x04F2: "\u0423\u030B", 0x04F3: "\u0443\u030B", 0x04F4: "\u0427\u0308", 0x04F5: "\u0447\u0308",
+	0x04F8: "\u042B\u0308", 0x04F9: "\u044B\u0308", 0x0622: "\u0627\u0653", 0x0623: "\u0627\u0654",
+	0x0624: "\u0648\u0654", 0x0625: "\u0627\u0655", 0x0626: "\u064A\u0654", 0x06C0: "\u06D5\u0654",
+	0x06C2: "\u06C1\u0654", 0x06D3: "\u06D2\u0654", 0x0929: "\u0928\u093C", 0x0931: "\u0930\u093C",
+	0x0934: "\u0933\u093C", 0x0958: "\u0915\u093C", 0x0959: "\u0916\u093C", 0x095A: "\u0917\u093C",
+	0x095B: "\u091C\u093C", 0x095C: "\u0921\u093C", 0x095D: "\u0922\u093C", 0x095E: "\u092B\u093C",
+	0x095F: "\u092F\u093C", 0x09CB: "\u09C7\u09BE", 0x09CC: "\u09C7\u09D7", 0x09DC: "\u09A1\u09BC",
+	0x09DD: "\u09A2\u09BC", 0x09DF: "\u09AF\u09BC", 0x0A33: "\u0A32\u0A3C", 0x0A36: "\u0A38\u0A3C",
+	0x0A59: "\u0A16\u0A3C", 0x0A5A: "\u0A17\u0A3C", 0x0A5B: "\u0A1C\u0A3C", 0x0A5E: "\u0A2B\u0A3C",
+	0x0B48: "\u0B47\u0B56", 0x0B4B: "\u0B47\u0B3E", 0x0B4C: "\u0B47\u0B57", 0x0B5C: "\u0B21\u0B3C",
+	0x0B5D: "\u0B22\u0B3C", 0x0B94: "\u0B92\u0BD7", 0x0BCA: "\u0BC6\u0BBE", 0x0BCB: "\u0BC7\u0BBE",
+	0x0BCC: "\u0BC6\u0BD7", 0x0C48: "\u0C46\u0C56", 0x0CC0: "\u0CBF\u0CD5", 0x0CC7: "\u0CC6\u0CD5",
+	0x0CC8: "\u0CC6\u0CD6", 0x0CCA: "\u0CC6\u0CC2", 0x0CCB: "\u0CC6\u0CC2\u0CD5", 0x0D4A: "\u0D46\u0D3E",
+	0x0D4B: "\u0D47\u0D3E", 0x0D4C: "\u0D46\u0D57", 0x0DDA: "\u0DD9\u0DCA", 0x0DDC: "\u0DD9\u0DCF",
+	0x0DDD: "\u0DD9\u0DCF\u0DCA", 0x0DDE: "\u0DD9\u0DDF", 0x0F43: "\u0F42\u0FB7", 0x0F4D: "\u0F4C\u0FB7",
+	0x0F52: "\u0F51\u0FB7", 0x0F57: "\u0F56\u0FB7", 0x0F5C: "\u0F5B\u0FB7", 0x0F69: "\u0F40\u0FB5",
+	0x0F73: "\u0F71\u0F72", 0x0F75: "\u0F71\u0F74", 0x0F76: "\u0FB2\u0F80", 0x0F78: "\u0FB3\u0F80",
+	0x0F81: "\u0F71\u0F80", 0x0F93: "\u0F92\u0FB7", 0x0F9D: "\u0F9C\u0FB7", 0x0FA2: "\u0FA1\u0FB7",
+	0x0FA7: "\u0FA6\u0FB7", 0x0FAC: "\u0FAB\u0FB7", 0x0FB9: "\u0F90\u0FB5", 0x1026: "\u1025\u102E",
+	0x1B06: "\u1B05\u1B35", 0x1B08: "\u1B07\u1B35", 0x1B0A: "\u1B09\u1B35", 0x1B0C: "\u1B0B\u1B35",
+	0x1B0E: "\u1B0D\u1B35", 0x1B12: "\u1B11\u1B35", 0x1B3B: "\u1B3A\u1B35", 0x1B3D: "\u1B3C\u1B35",
+	0x1B40: "\u1B3E\u1B35", 0x1B41: "\u1B3F\u1B35", 0x1B43: "\u1B42\u1B35", 0x1E00: "A\u0325",
+	0x1E01: "a\u0325", 0x1E02: "B\u0307", 0x1E03: "b\u0307", 0x1E04: "B\u0323",
+	0x1E05: "b\u0323", 0x1E06: "B\u0331", 0x1E07: "b\u0331", 0x1E08: "C\u0327\u0301",
+	0x1E09: "c\u0327\u0301", 0x1E0A: "D\u0307", 0x1E0B: "d\u0307", 0x1E0C: "D\u0323",
+	0x1E0D: "d\u0323", 0x1E0E: "D\u0331", 0x1E0F: "d\u0331", 0x1E10: "D\u0327",
+	0x1E11: "d\u0327", 0x1E12: "D\u032D", 0x1E13: "d\u032D", 0x1E14: "E\u0304\u0300",
+	0x1E15: "e\u0304\u0300", 0x1E16: "E\u0304\u0301", 0x1E17: "e\u0304\u0301", 0x1E18: "E\u032D",
+	0x1E19: "e\u032D", 0x1E1A: "E\u0330", 0x1E1B: "e\u0330", 0x1E1C: "E\u0327\u0306",
+	0x1E1D: "e\u0327\u0306", 0x1E1E: "F\u0307", 0x1E1F: "f\u0307", 0x1E20: "G\u0304",
+	0x1E21: "g\u0304", 0x1E22: "H\u0307", 0x1E23: "h\u0307", 0x1E24: "H\u0323",
+	0x1E25: "h\u0323", 0x1E26: "H\u0308", 0x1E27: "h\u0308", 0x1E28: "H\u0327",
+	0x1E29: "h\u0327", 0x1E2A: "H\u032E", 0x1E2B: "h\u032E", 0x1E2C: "I\u0330",
+	0x1E2D: "i\u0330", 0x1E2E: "I\u0308\u0301", 0x1E2F: "i\u0308\u0301", 0x1E30: "K\u0301",
+	0x1E31: "k\u0301", 0x1E32: "K\u0323", 0x1E33: "k\u0323", 0x1E34: "K\u0331",
+	0x1E35: "k\u0331", 0x1E36: "L\u0323", 0x1E37: "l\u0323", 0x1E38: "L\u0323\u0304",
+	0x1E39: "l\u0323\u0304", 0x1E3A: "L\u0331", 0x1E3B: "l\u0331", 0x1E3C: "L\u032D",
+	0x1E3D: "l\u032D", 0x1E3E: "M\u0301", 0x1E3F: "m\u0301", 0x1E40: "M\u0307",
+	0x1E41: "m\u0307", 0x1E42: "M\u0323", 0x1E43: "m\u0323", 0x1E44: "N\u0307",
+	0x1E45: "n\u0307", 0x1E46: "N\u0323", 0x1E47: "n\u0323", 0x1E48: "N\u0331",
+	0x1E49: "n\u0331", 0x1E4A: "N\u032D", 0x1E4B: "n\u032D", 0x1E4C: "O\u0303\u0301",
+	0x1E4D: "o\u0303\u0301", 0x1E4E: "O\u0303\u0308", 0x1E4F: "o\u0303\u0308", 0x1E50: "O\u0304\u0300",
+	0x1E51: "o\u0304\u0300", 0x1E52: "O\u0304\u0301", 0x1E53: "o\u0304\u0301", 0x1E54: "P\u0301",
+	0x1E55: "p\u0301", 0x1E56: "P\u0307", 0x1E57: "p\u0307", 0x1E58: "R\u0307",
+	0x1E59: "r\u0307", 0x1E5A: "R\u0323", 0x1E5B: "r\u0323", 0x1E5C: "R\u0323\u0304",
+	0x1E5D: "r\u0323\u0304", 0x1E5E: "R\u0331", 0x1E5F: "r\u0331", 0x1E60: "S\u0307",
+	0x1E61: "s\u0307", 0x1E62: "S\u0323", 0x1E63: "s\u0323", 0x1E64: "S\u0301\u0307",
+	0x1E65: "s\u0301\u0307", 0x1E66: "S\u030C\u0307", 0x1E67: "s\u030C\u0307", 0x1E68: "S\u0323\u0307",
+	0x1E69: "s\u0323\u0307", 0x1E6A: "T\u0307", 0x1E6B: "t\u0307", 0x1E6C: "T\u0323",
+	0x1E6D: "t\u0323", 0x1E6E: "T\u0331", 0x1E6F: "t\u0331", 0x1E70: "T\u032D",
+	0x1E71: "t\u032D", 0x1E72: "U\u0324", 0x1E73: "u\u0324", 0x1E74: "U\u0330",
+	0x1E75: "u\u0330", 0x1E76: "U\u032D", 0x1E77: "u\u032D", 0x1E78: "U\u0303\u0301",
+	0x1E79: "u\u0303\u0301", 0x1E7A: "U\u0304\u0308", 0x1E7B: "u\u0304\u0308", 0x1E7C: "V\u0303",
+	0x1E7D: "v\u0303", 0x1E7E: "V\u0323", 0x1E7F: "v\u0323", 0x1E80: "W\u0300",
+	0x1E81: "w\u0300", 0x1E82: "W\u0301", 0x1E83: "w\u0301", 0x1E84: "W\u0308",
+	0x1E85: "w\u0308", 0x1E86: "W\u0307", 0x1E87: "w\u0307", 0x1E88: "W\u0323",
+	0x1E89: "w\u0323", 0x1E8A: "X\u0307", 0x1E8B: "x\u0307", 0x1E8C: "X\u0308",
+	0x1E8D: "x\u0308", 0x1E8E: "Y\u0307", 0x1E8F: "y\u0307", 0x1E90: "Z\u0302",
+	0x1E91: "z\u0302", 0x1E92: "Z\u0323", 0x1E93: "z\u0323", 0x1E94: "Z\u0331",
+	0x1E95: "z\u0331", 0x1E96: "h\u0331", 0x1E97: "t\u0308", 0x1E98: "w\u030A",
+	0x1E99: "y\u030A", 0x1E9B: "\u017F\u0307", 0x1EA0: "A\u0323", 0x1EA1: "a\u0323",
+	0x1EA2: "A\u0309", 0x1EA3: "a\u0309", 0x1EA4: "A\u0302\u0301", 0x1EA5: "a\u0302\u0301",
+	0x1EA6: "A\u0302\u0300", 0x1EA7: "a\u0302\u0300", 0x1EA8: "A\u0302\u0309", 0x1EA9: "a\u0302\u0309",
+	0x1EAA: "A\u0302\u0303", 0x1EAB: "a\u0302\u0303", 0x1EAC: "A\u0323\u0302", 0x1EAD: "a\u0323\u0302",
+	0x1EAE: "A\u0306\u0301", 0x1EAF: "a\u0306\u0301", 0x1EB0: "A\u0306\u0300", 0x1EB1: "a\u0306\u0300",
+	0x1EB2: "A\u0306\u0309", 0x1EB3: "a\u0306\u0309", 0x1EB4: "A\u0306\u0303", 0x1EB5: "a\u0306\u0303",
+	0x1EB6: "A\u0323\u0306", 0x1EB7: "a\u0323\u0306", 0x1EB8: "E\u0323", 0x1EB9: "e\u0323",
+	0x1EBA: "E\u0309", 0x1EBB: "e\u0309", 0x1EBC: "E\u0303", 0x1EBD: "e\u0303",
+	0x1EBE: "E\u0302\u0301", 0x1EBF: "e\u0302\u0301", 0x1EC0: "E\u0302\u0300", 0x1EC1: "e\u0302\u0300",
+	0x1EC2: "E\u0302\u0309", 0x1EC3: "e\u0302\u0309", 0x1EC4: "E\u0302\u0303", 0x1EC5: "e\u0302\u0303",
+	0x1EC6: "E\u0323\u0302", 0x1EC7: "e\u0323\u0302", 0x1EC8: "I\u0309", 0x1EC9: "i\u0309",
+	0x1ECA: "I\u0323", 0x1ECB: "i\u0323", 0x1ECC: "O\u0323", 0x1ECD: "o\u0323",
+	0x1ECE: "O\u0309", 0x1ECF: "o\u0309", 0x1ED0: "O\u0302\u0301", 0x1ED1: "o\u0302\u0301",
+	0x1ED2: "O\u0302\u0300", 0x1ED3: "o\u0302\u0300", 0x1ED4: "O\u0302\u0309", 0x1ED5: "o\u0302\u0309",
+	0x1ED6: "O\u0302\u0303", 0x1ED7: "o\u0302\u0303", 0x1ED8: "O\u0323\u0302", 0x1ED9: "o\u0323\u0302",
+	0x1EDA: "O\u031B\u0301", 0x1EDB: "o\u031B\u0301", 0x1EDC: "O\u031B\u0300", 0x1EDD: "o\u031B\u0300",
+	0x1EDE: "O\u031B\u0309", 0x1EDF: "o\u031B\u0309", 0x1EE0: "O\u031B\u0303", 0x1EE1: "o\u031B\u0303",
+	0x1EE2: "O\u031B\u0323", 0x1EE3: "o\u031B\u0323", 0x1EE4: "U\u0323", 0x1EE5: "u\u0323",
+	0x1EE6: "U\u0309", 0x1EE7: "u\u0309", 0x1EE8: "U\u031B\u0301", 0x1EE9: "u\u031B\u0301",
+	0x1EEA: "U\u031B\u0300", 0x1EEB: "u\u031B\u0300", 0x1EEC: "U\u031B\u0309", 0x1EED: "u\u031B\u0309",
+	0x1EEE: "U\u031B\u0303", 0x1EEF: "u\u031B\u0303", 0x1EF0: "U\u031B\u0323", 0x1EF1: "u\u031B\u0323",
+	0x1EF2: "Y\u0300", 0x1EF3: "y\u0300", 0x1EF4: "Y\u0323", 0x1EF5: "y\u0323",
+	0x1EF6: "Y\u0309", 0x1EF7: "y\u0309", 0x1EF8: "Y\u0303", 0x1EF9: "y\u0303",
+	0x1F00: "\u03B1\u0313", 0x1F01: "\u03B1\u0314", 0x1F02: "\u03B1\u0313\u0300", 0x1F03: "\u03B1\u0314\u0300",
+	0x1F04: "\u03B1\u0313\u0301", 0x1F05: "\u03B1\u0314\u0301", 0x1F06: "\u03B1\u0313\u0342", 0x1F07: "\u03B1\u0314\u0342",
+	0x1F08: "\u0391\u0313", 0x1F09: "\u0391\u0314", 0x1F0A: "\u0391\u0313\u0300", 0x1F0B: "\u0391\u0314\u0300",
+	0x1F0C: "\u0391\u0313\u0301", 0x1F0D: "\u0391\u0314\u0301", 0x1F0E: "\u0391\u0313\u0342", 0x1F0F: "\u0391\u0314\u0342",
+	0x1F10: "\u03B5\u0313", 0x1F11: "\u03B5\u0314", 0x1F12: "\u03B5\u0313\u0300", 0x1F13: "\u03B5\u0314\u0300",
+	0x1F14: "\u03B5\u0313\u0301", 0x1F15: "\u03B5\u0314\u0301", 0x1F18: "\u0395\u0313", 0x1F19: "\u0395\u0314",
+	0x1F1A: "\u0395\u0313\u0300", 0x1F1B: "\u0395\u0314\u0300", 0x1F1C: "\u0395\u0313\u0301", 0x1F1D: "\u0395\u0314\u0301",
+	0x1F20: "\u03B7\u0313", 0x1F21: "\u03B7\u0314", 0x1F22: "\u03B7\u0313\u0300", 0x1F23: "\u03B7\u0314\u0300",
+	0x1F24: "\u03B7\u0313\u0301", 0x1F25: "\u03B7\u0314\u0301", 0x1F26: "\u03B7\u0313\u0342", 0x1F27: "\u03B7\u0314\u0342",
+	0x1F28: "\u0397\u0313", 0x1F29: "\u0397\u0314", 0x1F2A: "\u0397\u0313\u0300", 0x1F2B: "\u0397\u0314\u0300",
+	0x1F2C: "\u0397\u0313\u0301", 0x1F2D: "\u0397\u0314\u0301", 0x1F2E: "\u0397\u0313\u0342", 0x1F2F: "\u0397\u0314\u0342",
+	0x1F30: "\u03B9\u0313", 0x1F31: "\u03B9\u0314", 0x1F32: "\u03B9\u0313\u0300", 0x1F33: "\u03B9\u0314\u0300",
+	0x1F34: "\u03B9\u0313\u0301", 0x1F35: "\u03B9\u0314\u0301", 0x1F36: "\u03B9\u0313\u0342", 0x1F37: "\u03B9\u0314\u0342",
+	0x1F38: "\u0399\u0313", 0x1F39: "\u0399\u0314", 0x1F3A: "\u0399\u0313\u0300", 0x1F3B: "\u0399\u0314\u0300",
+	0x1F3C: "\u0399\u0313\u0301", 0x1F3D: "\u0399\u0314\u0301", 0x1F3E: "\u0399\u0313\u0342", 0x1F3F: "\u0399\u0314\u0342",
+	0x1F40: "\u03BF\u0313", 0x1F41: "\u03BF\u0314", 0x1F42: "\u03BF\u0313\u0300", 0x1F43: "\u03BF\u0314\u0300",
+	0x1F44: "\u03BF\u0313\u0301", 0x1F45: "\u03BF\u0314\u0301", 0x1F48: "\u039F\u0313", 0x1F49: "\u039F\u0314",
+	0x1F4A: "\u039F\u0313\u0300", 0x1F4B: "\u039F\u0314\u0300", 0x1F4C: "\u039F\u0313\u0301", 0x1F4D: "\u039F\u0314\u0301",
+	0x1F50: "\u03C5\u0313", 0x1F51: "\u03C5\u0314", 0x1F52: "\u03C5\u0313\u0300", 0x1F53: "\u03C5\u0314\u0300",
+	0x1F54: "\u03C5\u0313\u0301", 0x1F55: "\u03C5\u0314\u0301", 0x1F56: "\u03C5\u0313\u0342", 0x1F57: "\u03C5\u0314\u0342",
+	0x1F59: "\u03A5\u0314", 0x1F5B: "\u03A5\u0314\u0300", 0x1F5D: "\u03A5\u0314\u0301", 0x1F5F: "\u03A5\u0314\u0342",
+	0x1F60: "\u03C9\u0313", 0x1F61: "\u03C9\u0314", 0x1F62: "\u03C9\u0313\u0300", 0x1F63: "\u03C9\u0314\u0300",
+	0x1F64: "\u03C9\u0313\u0301", 0x1F65: "\u03C9\u0314\u0301", 0x1F66: "\u03C9\u0313\u0342", 0x1F67: "\u03C9\u0314\u0342",
+	0x1F68: "\u03A9\u0313", 0x1F69: "\u03A9\u0314", 0x1F6A: "\u03A9\u0313\u0300", 0x1F6B: "\u03A9\u0314\u0300",
+	0x1F6C: "\u03A9\u0313\u0301", 0x1F6D: "\u03A9\u0314\u0301", 0x1F6E: "\u03A9\u0313\u0342", 0x1F6F: "\u03A9\u0314\u0342",
+	0x1F70: "\u03B1\u0300", 0x1F71: "\u03B1\u0301", 0x1F72: "\u03B5\u0300", 0x1F73: "\u03B5\u0301",
+	0x1F74: "\u03B7\u0300", 0x1F75: "\u03B7\u0301", 0x1F76: "\u03B9\u0300", 0x1F77: "\u03B9\u0301",
+	0x1F78: "\u03BF\u0300", 0x1F79: "\u03BF\u0301", 0x1F7A: "\u03C5\u0300", 0x1F7B: "\u03C5\u0301",
+	0x1F7C: "\u03C9\u0300", 0x1F7D: "\u03C9\u0301", 0x1F80: "\u03B1\u0313\u0345", 0x1F81: "\u03B1\u0314\u0345",
+	0x1F82: "\u03B1\u0313\u0300\u0345", 0x1F83: "\u03B1\u0314\u0300\u0345", 0x1F84: "\u03B1\u0313\u0301\u0345", 0x1F85: "\u03B1\u0314\u0301\u0345",
+	0x1F86: "\u03B1\u0313\u0342\u0345", 0x1F87: "\u03B1\u0314\u0342\u0345", 0x1F88: "\u0391\u0313\u0345", 0x1F89: "\u0391\u0314\u0345",
+	0x1F8A: "\u0391\u0313\u0300\u0345", 0x1F8B: "\u0391\u0314\u0300\u0345", 0x1F8C: "\u0391\u0313\u0301\u0345", 0x1F8D: "\u0391\u0314\u0301\u0345",
+	0x1F8E: "\u0391\u0313\u0342\u0345", 0x1F8F: "\u0391\u0314\u0342\u0345", 0x1F90: "\u03B7\u0313\u0345", 0x1F91: "\u03B7\u0314\u0345",
+	0x1F92: "\u03B7\u0313\u0300\u0345", 0x1F93: "\u03B7\u0314\u0300\u0345", 0x1F94: "\u03B7\u0313\u0301\u0345", 0x1F95: "\u03B7\u0314\u0301\u0345",
+	0x1F96: "\u03B7\u0313\u0342\u0345", 0x1F97: "\u03B7\u0314\u0342\u0345", 0x1F98: "\u0397\u0313\u0345", 0x1F99: "\u0397\u0314\u0345",
+	0x1F9A: "\u0397\u0313\u0300\u0345", 0x1F9B: "\u0397\u0314\u0300\u0345", 0x1F9C: "\u0397\u0313\u0301\u0345", 0x1F9D: "\u0397\u0314\u0301\u0345",
+	0x1F9E: "\u0397\u0313\u0342\u0345", 0x1F9F: "\u0397\u0314\u0342\u0345", 0x1FA0: "\u03C9\u0313\u0345", 0x1FA1: "\u03C9\u0314\u0345",
+	0x1FA2: "\u03C9\u0313\u0300\u0345", 0x1FA3: "\u03C9\u0314\u0300\u0345", 0x1FA4: "\u03C9\u0313\u0301\u0345", 0x1FA5: "\u03C9\u0314\u0301\u0345",
+	0x1FA6: "\u03C9\u0313\u0342\u0345", 0x1FA7: "\u03C9\u0314\u0342\u0345", 0x1FA8: "\u03A9\u0313\u0345", 0x1FA9: "\u03A9\u0314\u0345",
+	0x1FAA: "\u03A9\u0313\u0300\u0345", 0x1FAB: "\u03A9\u0314\u0300\u0345", 0x1FAC: "\u03A9\u0313\u0301\u0345", 0x1FAD: "\u03A9\u0314\u0301\u0345",
+	0x1FAE: "\u03A9\u0313\u0342\u0345", 0x1FAF: "\u03A9\u0314\u0342\u0345", 0x1FB0: "\u03B1\u0306", 0x1FB1: "\u03B1\u0304",
+	0x1FB2: "\u03B1\u0300\u0345", 0x1FB3: "\u03B1\u0345", 0x1FB4: "\u03B1\u0301\u0345", 0x1FB6: "\u03B1\u0342",
+	0x1FB7: "\u03B1\u0342\u0345", 0x1FB8: "\u0391\u0306", 0x1FB9: "\u0391\u0304", 0x1FBA: "\u0391\u0300",
+	0x1FBB: "\u0391\u0301", 0x1FBC: "\u0391\u0345", 0x1FBE: "\u03B9", 0x1FC1: "\u00A8\u0342",
+	0x1FC2: "\u03B7\u0300\u0345", 0x1FC3: "\u03B7\u0345", 0x1FC4: "\u03B7\u0301\u0345", 0x1FC6: "\u03B7\u0342",
+	0x1FC7: "\u03B7\u0342\u0345", 0x1FC8: "\u0395\u0300", 0x1FC9: "\u0395\u0301", 0x1FCA: "\u0397\u0300",
+	0x1FCB: "\u0397\u0301", 0x1FCC: "\u0397\u0345", 0x1FCD: "\u1FBF\u0300", 0x1FCE: "\u1FBF\u0301",
+	0x1FCF: "\u1FBF\u0342", 0x1FD0: "\u03B9\u0306", 0x1FD1: "\u03B9\u0304", 0x1FD2: "\u03B9\u0308\u0300",
+	0x1FD3: "\u03B9\u0308\u0301", 0x1FD6: "\u03B9\u0342", 0x1FD7: "\u03B9\u0308\u0342", 0x1FD8: "\u0399\u0306",
+	0x1FD9: "\u0399\u0304", 0x1FDA: "\u0399\u0300", 0x1FDB: "\u0399\u0301", 0x1FDD: "\u1FFE\u0300",
+	0x1FDE: "\u1FFE\u0301", 0x1FDF: "\u1FFE\u0342", 0x1FE0: "\u03C5\u0306", 0x1FE1: "\u03C5\u0304",
+	0x1FE2: "\u03C5\u0308\u0300", 0x1FE3: "\u03C5\u0308\u0301", 0x1FE4: "\u03C1\u0313", 0x1FE5: "\u03C1\u0314",
+	0x1FE6: "\u03C5\u0342", 0x1FE7: "\u03C5\u0308\u0342", 0x1FE8: "\u03A5\u0306", 0x1FE9: "\u03A5\u0304",
+	0x1FEA: "\u03A5\u0300", 0x1FEB: "\u03A5\u0301", 0x1FEC: "\u03A1\u0314", 0x1FED: "\u00A8\u0300",
+	0x1FEE: "\u00A8\u0301", 0x1FEF: "`", 0x1FF2: "\u03C9\u0300\u0345", 0x1FF3: "\u03C9\u0345",
+	0x1FF4: "\u03C9\u0301\u0345", 0x1FF6: "\u03C9\u0342", 0x1FF7: "\u03C9\u0342\u0345", 0x1FF8: "\u039F\u0300",
+	0x1FF9: "\u039F\u0301", 0x1FFA: "\u03A9\u0300", 0x1FFB: "\u03A9\u0301", 0x1FFC: "\u03A9\u0345",
+	0x1FFD: "\u00B4", 0x2000: "\u2002", 0x2001: "\u2003", 0x2126: "\u03A9",
+	0x212A: "K", 0x212B: "A\u030A", 0x219A: "\u2190\u0338", 0x219B: "\u2192\u0338",
+	0x21AE: "\u2194\u0338", 0x21CD: "\u21D0\u0338", 0x21CE: "\u21D4\u0338", 0x21CF: "\u21D2\u0338",
+	0x2204: "\u2203\u0338", 0x2209: "\u2208\u0338", 0x220C: "\u220B\u0338", 0x2224: "\u2223\u0338",
+	0x2226: "\u2225\u0338", 0x2241: "\u223C\u0338", 0x2244: "\u2243\u0338", 0x2247: "\u2245\u0338",
+	0x2249: "\u2248\u0338", 0x2260: "=\u0338", 0x2262: "\u2261\u0338", 0x226D: "\u224D\u0338",
+	0x226E: "<\u0338", 0x226F: ">\u0338", 0x2270: "\u2264\u0338", 0x2271: "\u2265\u0338",
+	0x2274: "\u2272\u0338", 0x2275: "\u2273\u0338", 0x2278: "\u2276\u0338", 0x2279: "\u2277\u0338",
+	0x2280: "\u227A\u0338", 0x2281: "\u227B\u0338", 0x2284: "\u2282\u0338", 0x2285: "\u2283\u0338",
+	0x2288: "\u2286\u0338", 0x2289: "\u2287\u0338", 0x22AC: "\u22A2\u0338", 0x22AD: "\u22A8\u0338",
+	0x22AE: "\u22A9\u0338", 0x22AF: "\u22AB\u0338", 0x22E0: "\u227C\u0338", 0x22E1: "\u227D\u0338",
+	0x22E2: "\u2291\u0338", 0x22E3: "\u2292\u0338", 0x22EA: "\u22B2\u0338", 0x22EB: "\u22B3\u0338",
+	0x22EC: "\u22B4\u0338", 0x22ED: "\u22B5\u0338", 0x2329: "\u3008", 0x232A: "\u3009",
+	0x2ADC: "\u2ADD\u0338", 0x304C: "\u304B\u3099", 0x304E: "\u304D\u3099", 0x3050: "\u304F\u3099",
+	0x3052: "\u3051\u3099", 0x3054: "\u3053\u3099", 0x3056: "\u3055\u3099", 0x3058: "\u3057\u3099",
+	0x305A: "\u3059\u3099", 0x305C: "\u305B\u3099", 0x305E: "\u305D\u3099", 0x3060: "\u305F\u3099",
+	0x3062: "\u3061\u3099", 0x3065: "\u3064\u3099", 0x3067: "\u3066\u3099", 0x3069: "\u3068\u3099",
+	0x3070: "\u306F\u3099", 0x3071: "\u306F\u309A", 0x3073: "\u3072\u3099", 0x3074: "\u3072\u309A",
+	0x3076: "\u3075\u3099", 0x3077: "\u3075\u309A", 0x3079: "\u3078\u3099", 0x307A: "\u3078\u309A",
+	0x307C: "\u307B\u3099", 0x307D: "\u307B\u309A", 0x3094: "\u3046\u3099", 0x309E: "\u309D\u3099",
+	0x30AC: "\u30AB\u3099", 0x30AE: "\u30AD\u3099", 0x30B0: "\u30AF\u3099", 0x30B2: "\u30B1\u3099",
+	0x30B4: "\u30B3\u3099", 0x30B6: "\u30B5\u3099", 0x30B8: "\u30B7\u3099", 0x30BA: "\u30B9\u3099",
+	0x30BC: "\u30BB\u3099", 0x30BE: "\u30BD\u3099", 0x30C0: "\u30BF\u3099", 0x30C2: "\u30C1\u3099",
+	0x30C5: "\u30C4\u3099", 0x30C7: "\u30C6\u3099", 0x30C9: "\u30C8\u3099", 0x30D0: "\u30CF\u3099",
+	0x30D1: "\u30CF\u309A", 0x30D3: "\u30D2\u3099", 0x30D4: "\u30D2\u309A", 0x30D6: "\u30D5\u3099",
+	0x30D7: "\u30D5\u309A", 0x30D9: "\u30D8\u3099", 0x30DA: "\u30D8\u309A", 0x30DC: "\u30DB\u3099",
+	0x30DD: "\u30DB\u309A", 0x30F4: "\u30A6\u3099", 0x30F7: "\u30EF\u3099", 0x30F8: "\u30F0\u3099",
+	0x30F9: "\u30F1\u3099", 0x30FA: "\u30F2\u3099", 0x30FE: "\u30FD\u3099", 0xF900: "\u8C48",
+	0xF901: "\u66F4", 0xF902: "\u8ECA", 0xF903: "\u8CC8", 0xF904: "\u6ED1",
+	0xF905: "\u4E32", 0xF906: "\u53E5", 0xF907: "\u9F9C", 0xF908: "\u9F9C",
+	0xF909: "\u5951", 0xF90A: "\u91D1", 0xF90B: "\u5587", 0xF90C: "\u5948",
+	0xF90D: "\u61F6", 0xF90E: "\u7669", 0xF90F: "\u7F85", 0xF910: "\u863F",
+	0xF911: "\u87BA", 0xF912: "\u88F8", 0xF913: "\u908F", 0xF914: "\u6A02",
+	0xF915: "\u6D1B", 0xF916: "\u70D9", 0xF917: "\u73DE", 0xF918: "\u843D",
+	0xF919: "\u916A", 0xF91A: "\u99F1", 0xF91B: "\u4E82", 0xF91C: "\u5375",
+	0xF91D: "\u6B04", 0xF91E: "\u721B", 0xF91F: "\u862D", 0xF920: "\u9E1E",
+	0xF921: "\u5D50", 0xF922: "\u6FEB", 0xF923: "\u85CD", 0xF924: "\u8964",
+	0xF925: "\u62C9", 0xF926: "\u81D8", 0xF927: "\u881F", 0xF928: "\u5ECA",
+	0xF929: "\u6717", 0xF92A: "\u6D6A", 0xF92B: "\u72FC", 0xF92C: "\u90CE",
+	0xF92D: "\u4F86", 0xF92E: "\u51B7", 0xF92F: "\u52DE", 0xF930: "\u64C4",
+	0xF931: "\u6AD3", 0xF932: "\u7210", 0xF933: "\u76E7", 0xF934: "\u8001",
+	0xF935: "\u8606", 0xF936: "\u865C", 0xF937: "\u8DEF", 0xF938: "\u9732",
+	0xF939: "\u9B6F", 0xF93A: "\u9DFA", 0xF93B: "\u788C", 0xF93C: "\u797F",
+	0xF93D: "\u7DA0", 0xF93E: "\u83C9", 0xF93F: "\u9304", 0xF940: "\u9E7F",
+	0xF941: "\u8AD6", 0xF942: "\u58DF", 0xF943: "\u5F04", 0xF944: "\u7C60",
+	0xF945: "\u807E", 0xF946: "\u7262", 0xF947: "\u78CA", 0xF948: "\u8CC2",
+	0xF949: "\u96F7", 0xF94A: "\u58D8", 0xF94B: "\u5C62", 0xF94C: "\u6A13",
+	0xF94D: "\u6DDA", 0xF94E: "\u6F0F", 0xF94F: "\u7D2F", 0xF950: "\u7E37",
+	0xF951: "\u964B", 0xF952: "\u52D2", 0xF953: "\u808B", 0xF954: "\u51DC",
+	0xF955: "\u51CC", 0xF956: "\u7A1C", 0xF957: "\u7DBE", 0xF958: "\u83F1",
+	0xF959: "\u9675", 0xF95A: "\u8B80", 0xF95B: "\u62CF", 0xF95C: "\u6A02",
+	0xF95D: "\u8AFE", 0xF95E: "\u4E39", 0xF95F: "\u5BE7", 0xF960: "\u6012",
+	0xF961: "\u7387", 0xF962: "\u7570", 0xF963: "\u5317", 0xF964: "\u78FB",
+	0xF965: "\u4FBF", 0xF966: "\u5FA9", 0xF967: "\u4E0D", 0xF968: "\u6CCC",
+	0xF969: "\u6578", 0xF96A: "\u7D22", 0xF96B: "\u53C3", 0xF96C: "\u585E",
+	0xF96D: "\u7701", 0xF96E: "\u8449", 0xF96F: "\u8AAA", 0xF970: "\u6BBA",
+	0xF971: "\u8FB0", 0xF972: "\u6C88", 0xF973: "\u62FE", 0xF974: "\u82E5",
+	0xF975: "\u63A0", 0xF976: "\u7565", 0xF977: "\u4EAE", 0xF978: "\u5169",
+	0xF979: "\u51C9", 0xF97A: "\u6881", 0xF97B: "\u7CE7", 0xF97C: "\u826F",
+	0xF97D: "\u8AD2", 0xF97E: "\u91CF", 0xF97F: "\u52F5", 0xF980: "\u5442",
+	0xF981: "\u5973", 0xF982: "\u5EEC", 0xF983: "\u65C5", 0xF984: "\u6FFE",
+	0xF985: "\u792A", 0xF986: "\u95AD", 0xF987: "\u9A6A", 0xF988: "\u9E97",
+	0xF989: "\u9ECE", 0xF98A: "\u529B", 0xF98B: "\u66C6", 0xF98C: "\u6B77",
+	0xF98D: "\u8F62", 0xF98E: "\u5E74", 0xF98F: "\u6190", 0xF990: "\u6200",
+	0xF991: "\u649A", 0xF992: "\u6F23", 0xF993: "\u7149", 0xF994: "\u7489",
+	0xF995: "\u79CA", 0xF996: "\u7DF4", 0xF997: "\u806F", 0xF998: "\u8F26",
+	0xF999: "\u84EE", 0xF99A: "\u9023", 0xF99B: "\u934A", 0xF99C: "\u5217",
+	0xF99D: "\u52A3", 0xF99E: "\u54BD", 0xF99F: "\u70C8", 0xF9A0: "\u88C2",
+	0xF9A1: "\u8AAA", 0xF9A2: "\u5EC9", 0xF9A3: "\u5FF5", 0xF9A4: "\u637B",
+	0xF9A5: "\u6BAE", 0xF9A6: "\u7C3E", 0xF9A7: "\u7375", 0xF9A8: "\u4EE4",
+	0xF9A9: "\u56F9", 0xF9AA: "\u5BE7", 0xF9AB: "\u5DBA", 0xF9AC: "\u601C",
+	0xF9AD: "\u73B2", 0xF9AE: "\u7469", 0xF9AF: "\u7F9A", 0xF9B0: "\u8046",
+	0xF9B1: "\u9234", 0xF9B2: "\u96F6", 0xF9B3: "\u9748", 0xF9B4: "\u9818",
+	0xF9B5: "\u4F8B", 0xF9B6: "\u79AE", 0xF9B7: "\u91B4", 0xF9B8: "\u96B8",
+	0xF9B9: "\u60E1", 0xF9BA: "\u4E86", 0xF9BB: "\u50DA", 0xF9BC: "\u5BEE",
+	0xF9BD: "\u5C3F", 0xF9BE: "\u6599", 0xF9BF: "\u6A02", 0xF9C0: "\u71CE",
+	0xF9C1: "\u7642", 0xF9C2: "\u84FC", 0xF9C3: "\u907C", 0xF9C4: "\u9F8D",
+	0xF9C5: "\u6688", 0xF9C6: "\u962E", 0xF9C7: "\u5289", 0xF9C8: "\u677B",
+	0xF9C9: "\u67F3", 0xF9CA: "\u6D41", 0xF9CB: "\u6E9C", 0xF9CC: "\u7409",
+	0xF9CD: "\u7559", 0xF9CE: "\u786B", 0xF9CF: "\u7D10", 0xF9D0: "\u985E",
+	0xF9D1: "\u516D", 0xF9D2: "\u622E", 0xF9D3: "\u9678", 0xF9D4: "\u502B",
+	0xF9D5: "\u5D19", 0xF9D6: "\u6DEA", 0xF9D7: "\u8F2A", 0xF9D8: "\u5F8B",
+	0xF9D9: "\u6144", 0xF9DA: "\u6817", 0xF9DB: "\u7387", 0xF9DC: "\u9686",
+	0xF9DD: "\u5229", 0xF9DE: "\u540F", 0xF9DF: "\u5C65", 0xF9E0: "\u6613",
+	0xF9E1: "\u674E", 0xF9E2: "\u68A8", 0xF9E3: "\u6CE5", 0xF9E4: "\u7406",
+	0xF9E5: "\u75E2", 0xF9E6: "\u7F79", 0xF9E7: "\u88CF", 0xF9E8: "\u88E1",
+	0xF9E9: "\u91CC", 0xF9EA: "\u96E2", 0xF9EB: "\u533F", 0xF9EC: "\u6EBA",
+	0xF9ED: "\u541D", 0xF9EE: "\u71D0", 0xF9EF: "\u7498", 0xF9F0: "\u85FA",
+	0xF9F1: "\u96A3", 0xF9F2: "\u9C57", 0xF9F3: "\u9E9F", 0xF9F4: "\u6797",
+	0xF9F5: "\u6DCB", 0xF9F6: "\u81E8", 0xF9F7: "\u7ACB", 0xF9F8: "\u7B20",
+	0xF9F9: "\u7C92", 0xF9FA: "\u72C0", 0xF9FB: "\u7099", 0xF9FC: "\u8B58",
+	0xF9FD: "\u4EC0", 0xF9FE: "\u8336", 0xF9FF: "\u523A", 0xFA00: "\u5207",
+	0xFA01: "\u5EA6", 0xFA02: "\u62D3", 0xFA03: "\u7CD6", 0xFA04: "\u5B85",
+	0xFA05: "\u6D1E", 0xFA06: "\u66B4", 0xFA07: "\u8F3B", 0xFA08: "\u884C",
+	0xFA09: "\u964D", 0xFA0A: "\u898B", 0xFA0B: "\u5ED3", 0xFA0C: "\u5140",
+	0xFA0D: "\u55C0", 0xFA10: "\u585A", 0xFA12: "\u6674", 0xFA15: "\u51DE",
+	0xFA16: "\u732A", 0xFA17: "\u76CA", 0xFA18: "\u793C", 0xFA19: "\u795E",
+	0xFA1A: "\u7965", 0xFA1B: "\u798F", 0xFA1C: "\u9756", 0xFA1D: "\u7CBE",
+	0xFA1E: "\u7FBD", 0xFA20: "\u8612", 0xFA22: "\u8AF8", 0xFA25: "\u9038",
+	0xFA26: "\u90FD", 0xFA2A: "\u98EF", 0xFA2B: "\u98FC", 0xFA2C: "\u9928",
+	0xFA2D: "\u9DB4", 0xFA2E: "\u90DE", 0xFA2F: "\u96B7", 0xFA30: "\u4FAE",
+	0xFA31: "\u50E7", 0xFA32: "\u514D", 0xFA33: "\u52C9", 0xFA34: "\u52E4",
+	0xFA35: "\u5351", 0xFA36: "\u559D", 0xFA37: "\u5606", 0xFA38: "\u5668",
+	0xFA39: "\u5840", 0xFA3A: "\u58A8", 0xFA3B: "\u5C64", 0xFA3C: "\u5C6E",
+	0xFA3D: "\u6094", 0xFA3E: "\u6168", 0xFA3F: "\u618E", 0xFA40: "\u61F2",
+	0xFA41: "\u654F", 0xFA42: "\u65E2", 0xFA43: "\u6691", 0xFA44: "\u6885",
+	0xFA45: "\u6D77", 0xFA46: "\u6E1A", 0xFA47: "\u6F22", 0xFA48: "\u716E",
+	0xFA49: "\u722B", 0xFA4A: "\u7422", 0xFA4B: "\u7891", 0xFA4C: "\u793E",
+	0xFA4D: "\u7949", 0xFA4E: "\u7948", 0xFA4F: "\u7950", 0xFA50: "\u7956",
+	0xFA51: "\u795D", 0xFA52: "\u798D", 0xFA53: "\u798E", 0xFA54: "\u7A40",
+	0xFA55: "\u7A81", 0xFA56: "\u7BC0", 0xFA57: "\u7DF4", 0xFA58: "\u7E09",
+	0xFA59: "\u7E41", 0xFA5A: "\u7F72", 0xFA5B: "\u8005", 0xFA5C: "\u81ED",
+	0xFA5D: "\u8279", 0xFA5E: "\u8279", 0xFA5F: "\u8457", 0xFA60: "\u8910",
+	0xFA61: "\u8996", 0xFA62: "\u8B01", 0xFA63: "\u8B39", 0xFA64: "\u8CD3",
+	0xFA65: "\u8D08", 0xFA66: "\u8FB6", 0xFA67: "\u9038", 0xFA68: "\u96E3",
+	0xFA69: "\u97FF", 0xFA6A: "\u983B", 0xFA6B: "\u6075", 0xFA6C: "\U000242EE",
+	0xFA6D: "\u8218", 0xFA70: "\u4E26", 0xFA71: "\u51B5", 0xFA72: "\u5168",
+	0xFA73: "\u4F80", 0xFA74: "\u5145", 0xFA75: "\u5180", 0xFA76: "\u52C7",
+	0xFA77: "\u52FA", 0xFA78: "\u559D", 0xFA79: "\u5555", 0xFA7A: "\u5599",
+	0xFA7B: "\u55E2", 0xFA7C: "\u585A", 0xFA7D: "\u58B3", 0xFA7E: "\u5944",
+	0xFA7F: "\u5954", 0xFA80: "\u5A62", 0xFA81: "\u5B28", 0xFA82: "\u5ED2",
+	0xFA83: "\u5ED9", 0xFA84: "\u5F69", 0xFA85: "\u5FAD", 0xFA86: "\u60D8",
+	0xFA87: "\u614E", 0xFA88: "\u6108", 0xFA89: "\u618E", 0xFA8A: "\u6160",
+	0xFA8B: "\u61F2", 0xFA8C: "\u6234", 0xFA8D: "\u63C4", 0xFA8E: "\u641C",
+	0xFA8F: "\u6452", 0xFA90: "\u6556", 0xFA91: "\u6674", 0xFA92: "\u6717",
+	0xFA93: "\u671B", 0xFA94: "\u6756", 0xFA95: "\u6B79", 0xFA96: "\u6BBA",
+	0xFA97: "\u6D41", 0xFA98: "\u6EDB", 0xFA99: "\u6ECB", 0xFA9A: "\u6F22",
+	0xFA9B: "\u701E", 0xFA9C: "\u716E", 0xFA9D: "\u77A7", 0xFA9E: "\u7235",
+	0xFA9F: "\u72AF", 0xFAA0: "\u732A", 0xFAA1: "\u7471", 0xFAA2: "\u7506",
+	0xFAA3: "\u753B", 0xFAA4: "\u761D", 0xFAA5: "\u761F", 0xFAA6: "\u76CA",
+	0xFAA7: "\u76DB", 0xFAA8: "\u76F4", 0xFAA9: "\u774A", 0xFAAA: "\u7740",
+	0xFAAB: "\u78CC", 0xFAAC: "\u7AB1", 0xFAAD: "\u7BC0", 0xFAAE: "\u7C7B",
+	0xFAAF: "\u7D5B", 0xFAB0: "\u7DF4", 0xFAB1: "\u7F3E", 0xFAB2: "\u8005",
+	0xFAB3: "\u8352", 0xFAB4: "\u83EF", 0xFAB5: "\u8779", 0xFAB6: "\u8941",
+	0xFAB7: "\u8986", 0xFAB8: "\u8996", 0xFAB9: "\u8ABF", 0xFABA: "\u8AF8",
+	0xFABB: "\u8ACB", 0xFABC: "\u8B01", 0xFABD: "\u8AFE", 0xFABE: "\u8AED",
+	0xFABF: "\u8B39", 0xFAC0: "\u8B8A", 0xFAC1: "\u8D08", 0xFAC2: "\u8F38",
+	0xFAC3: "\u9072", 0xFAC4: "\u9199", 0xFAC5: "\u9276", 0xFAC6: "\u967C",
+	0xFAC7: "\u96E3", 0xFAC8: "\u9756", 0xFAC9: "\u97DB", 0xFACA: "\u97FF",
+	0xFACB: "\u980B", 0xFACC: "\u983B", 0xFACD: "\u9B12", 0xFACE: "\u9F9C",
+	0xFACF: "\U0002284A", 0xFAD0: "\U00022844", 0xFAD1: "\U000233D5", 0xFAD2: "\u3B9D",
+	0xFAD3: "\u4018", 0xFAD4: "\u4039", 0xFAD5: "\U00025249", 0xFAD6: "\U00025CD0",
+	0xFAD7: "\U00027ED3", 0xFAD8: "\u9F43", 0xFAD9: "\u9F8E", 0xFB1D: "\u05D9\u05B4",
+	0xFB1F: "\u05F2\u05B7", 0xFB2A: "\u05E9\u05C1", 0xFB2B: "\u05E9\u05C2", 0xFB2C: "\u05E9\u05BC\u05C1",
+	0xFB2D: "\u05E9\u05BC\u05C2", 0xFB2E: "\u05D0\u05B7", 0xFB2F: "\u05D0\u05B8", 0xFB30: "\u05D0\u05BC",
+	0xFB31: "\u05D1\u05BC", 0xFB32: "\u05D2\u05BC", 0xFB33: "\u05D3\u05BC", 0xFB34: "\u05D4\u05BC",
+	0xFB35: "\u05D5\u05BC", 0xFB36: "\u05D6\u05BC", 0xFB38: "\u05D8\u05BC", 0xFB39: "\u05D9\u05BC",
+	0xFB3A: "\u05DA\u05BC", 0xFB3B: "\u05DB\u05BC", 0xFB3C: "\u05DC\u05BC", 0xFB3E: "\u05DE\u05BC",
+	0xFB40: "\u05E0\u05BC", 0xFB41: "\u05E1\u05BC", 0xFB43: "\u05E3\u05BC", 0xFB44: "\u05E4\u05BC",
+	0xFB46: "\u05E6\u05BC", 0xFB47: "\u05E7\u05BC", 0xFB48: "\u05E8\u05BC", 0xFB49: "\u05E9\u05BC",
+	0xFB4A: "\u05EA\u05BC", 0xFB4B: "\u05D5\u05B9", 0xFB4C: "\u05D1\u05BF", 0xFB4D: "\u05DB\u05BF",
+	0xFB4E: "\u05E4\u05BF", 0x105C9: "\U000105D2\u0307", 0x105E4: "\U000105DA\u0307", 0x1109A: "\U00011099\U000110BA",
+	0x1109C: "\U0001109B\U000110BA", 0x110AB: "\U000110A5\U000110BA", 0x1112E: "\U00011131\U00011127", 0x1112F: "\U00011132\U00011127",
+	0x1134B: "\U00011347\U0001133E", 0x1134C: "\U00011347\U00011357", 0x11383: "\U00011382\U000113C9", 0x11385: "\U00011384\U000113BB",
+	0x1138E: "\U0001138B\U000113C2", 0x11391: "\U00011390\U000113C9", 0x113C5: "\U000113C2\U000113C2", 0x113C7: "\U000113C2\U000113B8",
+	0x113C8: "\U000113C2\U000113C9", 0x114BB: "\U000114B9\U000114BA", 0x114BC: "\U000114B9\U000114B0", 0x114BE: "\U000114B9\U000114BD",
+	0x115BA: "\U000115B8\U000115AF", 0x115BB: "\U000115B9\U000115AF", 0x11938: "\U00011935\U00011930", 0x16121: "\U0001611E\U0001611E",
+	0x16122: "\U0001611E\U00016129", 0x16123: "\U0001611E\U0001611F", 0x16124: "\U00016129\U0001611F", 0x16125: "\U0001611E\U00016120",
+	0x16126: "\U0001611E\U0001611E\U0001611F", 0x16127: "\U0001611E\U00016129\U0001611F", 0x16128: "\U0001611E\U0001611E\U00016120", 0x16D68: "\U00016D67\U00016D67",
+	0x16D69: "\U00016D63\U00016D67", 0x16D6A: "\U00016D63\U00016D67\U00016D67", 0x1D15E: "\U0001D157\U0001D165", 0x1D15F: "\U0001D158\U0001D165",
+	0x1D160: "\U0001D158\U0001D165\U0001D16E", 0x1D161: "\U0001D158\U0001D165\U0001D16F", 0x1D162: "\U0001D158\U0001D165\U0001D170", 0x1D163: "\U0001D158\U0001D165\U0001D171",
+	0x1D164: "\U0001D158\U0001D165\U0001D172", 0x1D1BB: "\U0001D1B9\U0001D165", 0x1D1BC: "\U0001D1BA\U0001D165", 0x1D1BD: "\U0001D1B9\U0001D165\U0001D16E",
+	0x1D1BE: "\U0001D1BA\U0001D165\U0001D16E", 0x1D1BF: "\U0001D1B9\U0001D165\U0001D16F", 0x1D1C0: "\U0001D1BA\U0001D165\U0001D16F", 0x2F800: "\u4E3D",
+	0x2F801: "\u4E38", 0x2F802: "\u4E41", 0x2F803: "\U00020122", 0x2F804: "\u4F60",
+	0x2F805: "\u4FAE", 0x2F806: "\u4FBB", 0x2F807: "\u5002", 0x2F808: "\u507A",
+	0x2F809: "\u5099", 0x2F80A: "\u50E7", 0x2F80B: "\u50CF", 0x2F80C: "\u349E",
+	0x2F80D: "\U0002063A", 0x2F80E: "\u514D", 0x2F80F: "\u5154", 0x2F810: "\u5164",
+	0x2F811: "\u5177", 0x2F812: "\U0002051C", 0x2F813: "\u34B9", 0x2F814: "\u5167",
+	0x2F815: "\u518D", 0x2F816: "\U0002054B", 0x2F817: "\u5197", 0x2F818: "\u51A4",
+	0x2F819: "\u4ECC", 0x2F81A: "\u51AC", 0x2F81B: "\u51B5", 0x2F81C: "\U000291DF",
+	0x2F81D: "\u51F5", 0x2F81E: "\u5203", 0x2F81F: "\u34DF", 0x2F820: "\u523B",
+	0x2F821: "\u5246", 0x2F822: "\u5272", 0x2F823: "\u5277", 0x2F824: "\u3515",
+	0x2F825: "\u52C7", 0x2F826: "\u52C9", 0x2F827: "\u52E4", 0x2F828: "\u52FA",
+	0x2F829: "\u5305", 0x2F82A: "\u5306", 0x2F82B: "\u5317", 0x2F82C: "\u5349",
+	0x2F82D: "\u5351", 0x2F82E: "\u535A", 0x2F82F: "\u5373", 0x2F830: "\u537D",
+	0x2F831: "\u537F", 0x2F832: "\u537F", 0x2F833: "\u537F", 0x2F834: "\U00020A2C",
+	0x2F835: "\u7070", 0x2F836: "\u53CA", 0x2F837: "\u53DF", 0x2F838: "\U00020B63",
+	0x2F839: "\u53EB", 0x2F83A: "\u53F1", 0x2F83B: "\u5406", 0x2F83C: "\u549E",
+	0x2F83D: "\u5438", 0x2F83E: "\u5448", 0x2F83F: "\u5468", 0x2F840: "\u54A2",
+	0x2F841: "\u54F6", 0x2F842: "\u5510", 0x2F843: "\u5553", 0x2F844: "\u5563",
+	0x2F845: "\u5584", 0x2F846: "\u5584", 0x2F847: "\u5599", 0x2F848: "\u55AB",
+	0x2F849: "\u55B3", 0x2F84A: "\u55C2", 0x2F84B: "\u5716", 0x2F84C: "\u5606",
+	0x2F84D: "\u5717", 0x2F84E: "\u5651", 0x2F84F: "\u5674", 0x2F850: "\u5207",
+	0x2F851: "\u58EE", 0x2F852: "\u57CE", 0x2F853: "\u57F4", 0x2F854: "\u580D",
+	0x2F855: "\u578B", 0x2F856: "\u5832", 0x2F857: "\u5831", 0x2F858: "\u58AC",
+	0x2F859: "\U000214E4", 0x2F85A: "\u58F2", 0x2F85B: "\u58F7", 0x2F85C: "\u5906",
+	0x2F85D: "\u591A", 0x2F85E: "\u5922", 0x2F85F: "\u5962", 0x2F860: "\U000216A8",
+	0x2F861: "\U000216EA", 0x2F862: "\u59EC", 0x2F863: "\u5A1B", 0x2F864: "\u5A27",
+	0x2F865: "\u59D8", 0x2F866: "\u5A66", 0x2F867: "\u36EE", 0x2F868: "\u36FC",
+	0x2F869: "\u5B08", 0x2F86A: "\u5B3E", 0x2F86B: "\u5B3E", 0x2F86C: "\U000219C8",
+	0x2F86D: "\u5BC3", 0x2F86E: "\u5BD8", 0x2F86F: "\u5BE7", 0x2F870: "\u5BF3",
+	0x2F871: "\U00021B18", 0x2F872: "\u5BFF", 0x2F873: "\u5C06", 0x2F874: "\u5F53",
+	0x2F875: "\u5C22", 0x2F876: "\u3781", 0x2F877: "\u5C60", 0x2F878: "\u5C6E",
+	0x2F879: "\u5CC0", 0x2F87A: "\u5C8D", 0x2F87B: "\U00021DE4", 0x2F87C: "\u5D43",
+	0x2F87D: "\U00021DE6", 0x2F87E: "\u5D6E", 0x2F87F: "\u5D6B", 0x2F880: "\u5D7C",
+	0x2F881: "\u5DE1", 0x2F882: "\u5DE2", 0x2F883: "\u382F", 0x2F884: "\u5DFD",
+	0x2F885: "\u5E28", 0x2F886: "\u5E3D", 0x2F887: "\u5E69", 0x2F888: "\u3862",
+	0x2F889: "\U00022183", 0x2F88A: "\u387C", 0x2F88B: "\u5EB0", 0x2F88C: "\u5EB3",
+	0x2F88D: "\u5EB6", 0x2F88E: "\u5ECA", 0x2F88F: "\U0002A392", 0x2F890: "\u5EFE",
+	0x2F891: "\U00022331", 0x2F892: "\U00022331", 0x2F893: "\u8201", 0x2F894: "\u5F22",
+	0x2F895: "\u5F22", 0x2F896: "\u38C7", 0x2F897: "\U000232B8", 0x2F898: "\U000261DA",
+	0x2F899: "\u5F62", 0x2F89A: "\u5F6B", 0x2F89B: "\u38E3", 0x2F89C: "\u5F9A",
+	0x2F89D: "\u5FCD", 0x2F89E: "\u5FD7", 0x2F89F: "\u5FF9", 0x2F8A0: "\u6081",
+	0x2F8A1: "\u393A", 0x2F8A2: "\u391C", 0x2F8A3: "\u6094", 0x2F8A4: "\U000226D4",
+	0x2F8A5: "\u60C7", 0x2F8A6: "\u6148", 0x2F8A7: "\u614C", 0x2F8A8: "\u614E",
+	0x2F8A9: "\u614C", 0x2F8AA: "\u617A", 0x2F8AB: "\u618E", 0x2F8AC: "\u61B2",
+	0x2F8AD: "\u61A4", 0x2F8AE: "\u61AF", 0x2F8AF: "\u61DE", 0x2F8B0: "\u61F2",
+	0x2F8B1: "\u61F6", 0x2F8B2: "\u6210", 0x2F8B3: "\u621B", 0x2F8B4: "\u625D",
+	0x2F8B5: "\u62B1", 0x2F8B6: "\u62D4", 0x2F8B7: "\u6350", 0x2F8B8: "\U00022B0C",
+	0x2F8B9: "\u633D", 0x2F8BA: "\u62FC", 0x2F8BB: "\u6368", 0x2F8BC: "\u6383",
+	0x2F8BD: "\u63E4", 0x2F8BE: "\U00022BF1", 0x2F8BF: "\u6422", 0x2F8C0: "\u63C5",
+	0x2F8C1: "\u63A9", 0x2F8C2: "\u3A2E", 0x2F8C3: "\u6469", 0x2F8C4: "\u647E",
+	0x2F8C5: "\u649D", 0x2F8C6: "\u6477", 0x2F8C7: "\u3A6C", 0x2F8C8: "\u654F",
+	0x2F8C9: "\u656C", 0x2F8CA: "\U0002300A", 0x2F8CB: "\u65E3", 0x2F8CC: "\u66F8",
+	0x2F8CD: "\u6649", 0x2F8CE: "\u3B19", 0x2F8CF: "\u6691", 0x2F8D0: "\u3B08",
+	0x2F8D1: "\u3AE4", 0x2F8D2: "\u5192", 0x2F8D3: "\u5195", 0x2F8D4: "\u6700",
+	0x2F8D5: "\u669C", 0x2F8D6: "\u80AD", 0x2F8D7: "\u43D9", 0x2F8D8: "\u6717",
+	0x2F8D9: "\u671B", 0x2F8DA: "\u6721", 0x2F8DB: "\u675E", 0x2F8DC: "\u6753",
+	0x2F8DD: "\U000233C3", 0x2F8DE: "\u3B49", 0x2F8DF: "\u67FA", 0x2F8E0: "\u6785",
+	0x2F8E1: "\u6852", 0x2F8E2: "\u6885", 0x2F8E3: "\U0002346D", 0x2F8E4: "\u688E",
+	0x2F8E5: "\u681F", 0x2F8E6: "\u6914", 0x2F8E7: "\u3B9D", 0x2F8E8: "\u6942",
+	0x2F8E9: "\u69A3", 0x2F8EA: "\u69EA", 0x2F8EB: "\u6AA8", 0x2F8EC: "\U000236A3",
+	0x2F8ED: "\u6ADB", 0x2F8EE: "\u3C18", 0x2F8EF: "\u6B21", 0x2F8F0: "\U000238A7",
+	0x2F8F1: "\u6B54", 0x2F8F2: "\u3C4E", 0x2F8F3: "\u6B72", 0x2F8F4: "\u6B9F",
+	0x2F8F5: "\u6BBA", 0x2F8F6: "\u6BBB", 0x2F8F7: "\U00023A8D", 0x2F8F8: "\U00021D0B",
+	0x2F8F9: "\U00023AFA", 0x2F8FA: "\u6C4E", 0x2F8FB: "\U00023CBC", 0x2F8FC: "\u6CBF",
+	0x2F8FD: "\u6CCD", 0x2F8FE: "\u6C67", 0x2F8FF: "\u6D16", 0x2F900: "\u6D3E",
+	0x2F901: "\u6D77", 0x2F902: "\u6D41", 0x2F903: "\u6D69", 0x2F904: "\u6D78",
+	0x2F905: "\u6D85", 0x2F906: "\U00023D1E", 0x2F907: "\u6D34", 0x2F908: "\u6E2F",
+	0x2F909: "\u6E6E", 0x2F90A: "\u3D33", 0x2F90B: "\u6ECB", 0x2F90C: "\u6EC7",
+	0x2F90D: "\U00023ED1", 0x2F90E: "\u6DF9", 0x2F90F: "\u6F6E", 0x2F910: "\U00023F5E",
+	0x2F911: "\U00023F8E", 0x2F912: "\u6FC6", 0x2F913: "\u7039", 0x2F914: "\u701E",
+	0x2F915: "\u701B", 0x2F916: "\u3D96", 0x2F917: "\u704A", 0x2F918: "\u707D",
+	0x2F919: "\u7077", 0x2F91A: "\u70AD", 0x2F91B: "\U00020525", 0x2F91C: "\u7145",
+	0x2F91D: "\U00024263", 0x2F91E: "\u719C", 0x2F91F: "\U000243AB", 0x2F920: "\u7228",
+	0x2F921: "\u7235", 0x2F922: "\u7250", 0x2F923: "\U00024608", 0x2F924: "\u7280",
+	0x2F925: "\u7295", 0x2F926: "\U00024735", 0x2F927: "\U00024814", 0x2F928: "\u737A",
+	0x2F929: "\u738B", 0x2F92A: "\u3EAC", 0x2F92B: "\u73A5", 0x2F92C: "\u3EB8",
+	0x2F92D: "\u3EB8", 0x2F92E: "\u7447", 0x2F92F: "\u745C", 0x2F930: "\u7471",
+	0x2F931: "\u7485", 0x2F932: "\u74CA", 0x2F933: "\u3F1B", 0x2F934: "\u7524",
+	0x2F935: "\U00024C36", 0x2F936: "\u753E", 0x2F937: "\U00024C92", 0x2F938: "\u7570",
+	0x2F939: "\U0002219F", 0x2F93A: "\u7610", 0x2F93B: "\U00024FA1", 0x2F93C: "\U00024FB8",
+	0x2F93D: "\U00025044", 0x2F93E: "\u3FFC", 0x2F93F: "\u4008", 0x2F940: "\u76F4",
+	0x2F941: "\U000250F3", 0x2F942: "\U000250F2", 0x2F943: "\U00025119", 0x2F944: "\U00025133",
+	0x2F945: "\u771E", 0x2F946: "\u771F", 0x2F947: "\u771F", 0x2F948: "\u774A",
+	0x2F949: "\u4039", 0x2F94A: "\u778B", 0x2F94B: "\u4046", 0x2F94C: "\u4096",
+	0x2F94D: "\U0002541D", 0x2F94E: "\u784E", 0x2F94F: "\u788C", 0x2F950: "\u78CC",
+	0x2F951: "\u40E3", 0x2F952: "\U00025626", 0x2F953: "\u7956", 0x2F954: "\U0002569A",
+	0x2F955: "\U000256C5", 0x2F956: "\u798F", 0x2F957: "\u79EB", 0x2F958: "\u412F",
+	0x2F959: "\u7A40", 0x2F95A: "\u7A4A", 0x2F95B: "\u7A4F", 0x2F95C: "\U0002597C",
+	0x2F95D: "\U00025AA7", 0x2F95E: "\U00025AA7", 0x2F95F: "\u7AEE", 0x2F960: "\u4202",
+	0x2F961: "\U00025BAB", 0x2F962: "\u7BC6", 0x2F963: "\u7BC9", 0x2F964: "\u4227",
+	0x2F965: "\U00025C80", 0x2F966: "\u7CD2", 0x2F967: "\u42A0", 0x2F968: "\u7CE8",
+	0x2F969: "\u7CE3", 0x2F96A: "\u7D00", 0x2F96B: "\U00025F86", 0x2F96C: "\u7D63",
+	0x2F96D: "\u4301", 0x2F96E: "\u7DC7", 0x2F96F: "\u7E02", 0x2F970: "\u7E45",
+	0x2F971: "\u4334", 0x2F972: "\U00026228", 0x2F973: "\U00026247", 0x2F974: "\u4359",
+	0x2F975: "\U000262D9", 0x2F976: "\u7F7A", 0x2F977: "\U0002633E", 0x2F978: "\u7F95",
+	0x2F979: "\u7FFA", 0x2F97A: "\u8005", 0x2F97B: "\U000264DA", 0x2F97C: "\U00026523",
+	0x2F97D: "\u8060", 0x2F97E: "\U000265A8", 0x2F97F: "\u8070", 0x2F980: "\U0002335F",
+	0x2F981: "\u43D5", 0x2F982: "\u80B2", 0x2F983: "\u8103", 0x2F984: "\u440B",
+	0x2F985: "\u813E", 0x2F986: "\u5AB5", 0x2F987: "\U000267A7", 0x2F988: "\U000267B5",
+	0x2F989: "\U00023393", 0x2F98A: "\U0002339C", 0x2F98B: "\u8201", 0x2F98C: "\u8204",
+	0x2F98D: "\u8F9E", 0x2F98E: "\u446B", 0x2F98F: "\u8291", 0x2F990: "\u828B",
+	0x2F991: "\u829D", 0x2F992: "\u52B3", 0x2F993: "\u82B1", 0x2F994: "\u82B3",
+	0x2F995: "\u82BD", 0x2F996: "\u82E6", 0x2F997: "\U00026B3C", 0x2F998: "\u82E5",
+	0x2F999: "\u831D", 0x2F99A: "\u8363", 0x2F99B: "\u83AD", 0x2F99C: "\u8323",
+	0x2F99D: "\u83BD", 0x2F99E: "\u83E7", 0x2F99F: "\u8457", 0x2F9A0: "\u8353",
+	0x2F9A1: "\u83CA", 0x2F9A2: "\u83CC", 0x2F9A3: "\u83DC", 0x2F9A4: "\U00026C36",
+	0x2F9A5: "\U00026D6B", 0x2F9A6: "\U00026CD5", 0x2F9A7: "\u452B", 0x2F9A8: "\u84F1",
+	0x2F9A9: "\u84F3", 0x2F9AA: "\u8516", 0x2F9AB: "\U000273CA", 0x2F9AC: "\u8564",
+	0x2F9AD: "\U00026F2C", 0x2F9AE: "\u455D", 0x2F9AF: "\u4561", 0x2F9B0: "\U00026FB1",
+	0x2F9B1: "\U000270D2", 0x2F9B2: "\u456B", 0x2F9B3: "\u8650", 0x2F9B4: "\u865C",
+	0x2F9B5: "\u8667", 0x2F9B6: "\u8669", 0x2F9B7: "\u86A9", 0x2F9B8: "\u8688",
+	0x2F9B9: "\u870E", 0x2F9BA: "\u86E2", 0x2F9BB: "\u8779", 0x2F9BC: "\u8728",
+	0x2F9BD: "\u876B", 0x2F9BE: "\u8786", 0x2F9BF: "\u45D7", 0x2F9C0: "\u87E1",
+	0x2F9C1: "\u8801", 0x2F9C2: "\u45F9", 0x2F9C3: "\u8860", 0x2F9C4: "\u8863",
+	0x2F9C5: "\U00027667", 0x2F9C6: "\u88D7", 0x2F9C7: "\u88DE", 0x2F9C8: "\u4635",
+	0x2F9C9: "\u88FA", 0x2F9CA: "\u34BB", 0x2F9CB: "\U000278AE", 0x2F9CC: "\U00027966",
+	0x2F9CD: "\u46BE", 0x2F9CE: "\u46C7", 0x2F9CF: "\u8AA0", 0x2F9D0: "\u8AED",
+	0x2F9D1: "\u8B8A", 0x2F9D2: "\u8C55", 0x2F9D3: "\U00027CA8", 0x2F9D4: "\u8CAB",
+	0x2F9D5: "\u8CC1", 0x2F9D6: "\u8D1B", 0x2F9D7: "\u8D77", 0x2F9D8: "\U00027F2F",
+	0x2F9D9: "\U00020804", 0x2F9DA: "\u8DCB", 0x2F9DB: "\u8DBC", 0x2F9DC: "\u8DF0",
+	0x2F9DD: "\U000208DE", 0x2F9DE: "\u8ED4", 0x2F9DF: "\u8F38", 0x2F9E0: "\U000285D2",
+	0x2F9E1: "\U000285ED", 0x2F9E2: "\u9094", 0x2F9E3: "\u90F1", 0x2F9E4: "\u9111",
+	0x2F9E5: "\U0002872E", 0x2F9E6: "\u911B", 0x2F9E7: "\u9238", 0x2F9E8: "\u92D7",
+	0x2F9E9: "\u92D8", 0x2F9EA: "\u927C", 0x2F9EB: "\u93F9", 0x2F9EC: "\u9415",
+	0x2F9ED: "\U00028BFA", 0x2F9EE: "\u958B", 0x2F9EF: "\u4995", 0x2F9F0: "\u95B7",
+	0x2F9F1: "\U00028D77", 0x2F9F2: "\u49E6", 0x2F9F3: "\u96C3", 0x2F9F4: "\u5DB2",
+	0x2F9F5: "\u9723", 0x2F9F6: "\U00029145", 0x2F9F7: "\U0002921A", 0x2F9F8: "\u4A6E",
+	0x2F9F9: "\u4A76", 0x2F9FA: "\u97E0", 0x2F9FB: "\U0002940A", 0x2F9FC: "\u4AB2",
+	0x2F9FD: "\U00029496", 0x2F9FE: "\u980B", 0x2F9FF: "\u980B", 0x2FA00: "\u9829",
+	0x2FA01: "\U000295B6", 0x2FA02: "\u98E2", 0x2FA03: "\u4B33", 0x2FA04: "\u9929",
+	0x2FA05: "\u99A7", 0x2FA06: "\u99C2", 0x2FA07: "\u99FE", 0x2FA08: "\u4BCE",
+	0x2FA09: "\U00029B30", 0x2FA0A: "\u9B12", 0x2FA0B: "\u9C40", 0x2FA0C: "\u9CFD",
+	0x2FA0D: "\u4CCE", 0x2FA0E: "\u4CED", 0x2FA0F: "\u9D67", 0x2FA10: "\U0002A0CE",
+	0x2FA11: "\u4CF8", 0x2FA12: "\U0002A105", 0x2FA13: "\U0002A20E", 0x2FA14: "\U0002A291",
+	0x2FA15: "\u9EBB", 0x2FA16: "\u4D56", 0x2FA17: "\u9EF9", 0x2FA18: "\u9EFE",
+	0x2FA19: "\u9F05", 0x2FA1A: "\u9F0F", 0x2FA1B: "\u9F16", 0x2FA1C: "\u9F3B",
+	0x2FA1D: "\U0002A600",
+}
+
+// compatibilityDecompositions holds the full compatibility decompositions of the characters which differ
+// from their canonical decompositions.
+var compatibilityDecompositions = map[rune]string{
+	0x00A0: " ", 0x00A8: " \u0308", 0x00AA: "a", 0x00AF: " \u0304",
+	0x00B2: "2", 0x00B3: "3", 0x00B4: " \u0301", 0x00B5: "\u03BC",
+	0x00B8: " \u0327", 0x00B9: "1", 0x00BA: "o", 0x00BC: "1\u20444",
+	0x00BD: "1\u20442", 0x00BE: "3\u20444", 0x0132: "IJ", 0x0133: "ij",
+	0x013F: "L\u00B7", 0x0140: "l\u00B7", 0x0149: "\u02BCn", 0x017F: "s",
+	0x01C4: "DZ\u030C", 0x01C5: "Dz\u030C", 0x01C6: "dz\u030C", 0x01C7: "LJ",
+	0x01C8: "Lj", 0x01C9: "lj", 0x01CA: "NJ", 0x01CB: "Nj",
+	0x01CC: "nj", 0x01F1: "DZ", 0x01F2: "Dz", 0x01F3: "dz",
+	0x02B0: "h", 0x02B1: "\u0266", 0x02B2: "j", 0x02B3: "r",
+	0x02B4: "\u0279", 0x02B5: "\u027B", 0x02B6: "\u0281", 0x02B7: "w",
+	0x02B8: "y", 0x02D8: " \u0306", 0x02D9: " \u0307", 0x02DA: " \u030A",
+	0x02DB: " \u0328", 0x02DC: " \u0303", 0x02DD: " \u030B", 0x02E0: "\u0263",
+	0x02E1: "l", 0x02E2: "s", 0x02E3: "x", 0x02E4: "\u0295",
+	0x037A: " \u0345", 0x0384: " \u0301", 0x0385: " \u0308\u0301", 0x03D0: "\u03B2",
+	0x03D1: "\u03B8", 0x03D2: "\u03A5", 0x03D3: "\u03A5\u0301", 0x03D4: "\u03A5\u0308",
+	0x03D5: "\u03C6", 0x03D6: "\u03C0", 0x03F0: "\u03BA", 0x03F1: "\u03C1",
+	0x03F2: "\u03C2", 0x03F4: "\u0398", 0x03F5: "\u03B5", 0x03F9: "\u03A3",
+	0x0587: "\u0565\u0582", 0x0675: "\u0627\u0674", 0x0676: "\u0648\u0674", 0x0677: "\u06C7\u0674",
+	0x0678: "\u064A\u0674", 0x0E33: "\u0E4D\u0E32", 0x0EB3: "\u0ECD\u0EB2", 0x0EDC: "\u0EAB\u0E99",
+	0x0EDD: "\u0EAB\u0EA1", 0x0F0C: "\u0F0B", 0x0F77: "\u0FB2\u0F71\u0F80", 0x0F79: "\u0FB3\u0F71\u0F80",
+	0x10FC: "\u10DC", 0x1D2C: "A", 0x1D2D: "\u00C6", 0x1D2E: "B",
+	0x1D30: "D", 0x1D31: "E", 0x1D32: "\u018E", 0x1D33: "G",
+	0x1D34: "H", 0x1D35: "I", 0x1D36: "J", 0x1D37: "K",
+	0x1D38: "L", 0x1D39: "M", 0x1D3A: "N", 0x1D3C: "O",
+	0x1D3D: "\u0222", 0x1D3E: "P", 0x1D3F: "R", 0x1D40: "T",
+	0x1D41: "U", 0x1D42: "W", 0x1D43: "a", 0x1D44: "\u0250",
+	0x1D45: "\u0251", 0x1D46: "\u1D02", 0x1D47: "b", 0x1D48: "d",
+	0x1D49: "e", 0x1D4A: "\u0259", 0x1D4B: "\u025B", 0x1D4C: "\u025C",
+	0x1D4D: "g", 0x1D4F: "k", 0x1D50: "m", 0x1D51: "\u014B",
+	0x1D52: "o", 0x1D53: "\u0254", 0x1D54: "\u1D16", 0x1D55: "\u1D17",
+	0x1D56: "p", 0x1D57: "t", 0x1D58: "u", 0x1D59: "\u1D1D",
+	0x1D5A: "\u026F", 0x1D5B: "v", 0x1D5C: "\u1D25", 0x1D5D: "\u03B2",
+	0x1D5E: "\u03B3", 0x1D5F: "\u03B4", 0x1D60: "\u03C6", 0x1D61: "\u03C7",
+	0x1D62: "i", 0x1D63: "r", 0x1D64: "u", 0x1D65: "v",
+	0x1D66: "\u03B2", 0x1D67: "\u03B3", 0x1D68: "\u03C1", 0x1D69: "\u03C6",
+	0x1D6A: "\u03C7", 0x1D78: "\u043D", 0x1D9B: "\u0252", 0x1D9C: "c",
+	0x1D9D: "\u0255", 0x1D9E: "\u00F0", 0x1D9F: "\u025C", 0x1DA0: "f",
+	0x1DA1: "\u025F", 0x1DA2: "\u0261", 0x1DA3: "\u0265", 0x1DA4: "\u0268",
+	0x1DA5: "\u0269", 0x1DA6: "\u026A", 0x1DA7: "\u1D7B", 0x1DA8: "\u029D",
+	0x1DA9: "\u026D", 0x1DAA: "\u1D85", 0x1DAB: "\u029F", 0x1DAC: "\u0271",
+	0x1DAD: "\u0270", 0x1DAE: "\u0272", 0x1DAF: "\u0273", 0x1DB0: "\u0274",
+	0x1DB1: "\u0275", 0x1DB2: "\u0278", 0x1DB3: "\u0282", 0x1DB4: "\u0283",
+	0x1DB5: "\u01AB", 0x1DB6: "\u0289", 0x1DB7: "\u028A", 0x1DB8: "\u1D1C",
+	0x1DB9: "\u028B", 0x1DBA: "\u028C", 0x1DBB: "z", 0x1DBC: "\u0290",
+	0x1DBD: "\u0291", 0x1DBE: "\u0292", 0x1DBF: "\u03B8", 0x1E9A: "a\u02BE",
+	0x1E9B: "s\u0307", 0x1FBD: " \u0313", 0x1FBF: " \u0313", 0x1FC0: " \u0342",
+	0x1FC1: " \u0308\u0342", 0x1FCD: " \u0313\u0300", 0x1FCE: " \u0313\u0301", 0x1FCF: " \u0313\u0342",
+	0x1FDD: " \u0314\u0300", 0x1FDE: " \u0314\u0301", 0x1FDF: " \u0314\u0342", 0x1FED: " \u0308\u0300",
+	0x1FEE: " \u0308\u0301", 0x1FFD: " \u0301", 0x1FFE: " \u0314", 0x2000: " ",
+	0x2001: " ", 0x2002: " ", 0x2003: " ", 0x2004: " ",
+	0x2005: " ", 0x2006: " ", 0x2007: " ", 0x2008: " ",
+	0x2009: " ", 0x200A: " ", 0x2011: "\u2010", 0x2017: " \u0333",
+	0x2024: ".", 0x2025: "..", 0x2026: "...", 0x202F: " ",
+	0x2033: "\u2032\u2032", 0x2034: "\u2032\u2032\u2032", 0x2036: "\u2035\u2035", 0x2037: "\u2035\u2035\u2035",
+	0x203C: "!!", 0x203E: " \u0305", 0x2047: "??", 0x2048: "?!",
+	0x2049: "!?", 0x2057: "\u2032\u2032\u2032\u2032", 0x205F: " ", 0x2070: "0",
+	0x2071: "i", 0x2074: "4", 0x2075: "5", 0x2076: "6",
+	0x2077: "7", 0x2078: "8", 0x2079: "9", 0x207A: "+",
+	0x207B: "\u2212", 0x207C: "=", 0x207D: "(", 0x207E: ")",
+	0x207F: "n", 0x2080: "0", 0x2081: "1", 0x2082: "2",
+	0x2083: "3", 0x2084: "4", 0x2085: "5", 0x2086: "6",
+	0x2087: "7", 0x2088: "8", 0x2089: "9", 0x208A: "+",
+	0x208B: "\u2212", 0x208C: "=", 0x208D: "(", 0x208E: ")",
+	0x2090: "a", 0x2091: "e", 0x2092: "o", 0x2093: "x",
+	0x2094: "\u0259", 0x2095: "h", 0x2096: "k", 0x2097: "l",
+	0x2098: "m", 0x2099: "n", 0x209A: "p", 0x209B: "s",
+	0x209C: "t", 0x20A8: "Rs", 0x2100: "a/c", 0x2101: "a/s",
+	0x2102: "C", 0x2103: "\u00B0C", 0x2105: "c/o", 0x2106: "c/u",
+	0x2107: "\u0190", 0x2109: "\u00B0F", 0x210A: "g", 0x210B: "H",
+	0x210C: "H", 0x210D: "H", 0x210E: "h", 0x210F: "\u0127",
+	0x2110: "I", 0x2111: "I", 0x2112: "L", 0x2113: "l",
+	0x2115: "N", 0x2116: "No", 0x2119: "P", 0x211A: "Q",
+	0x211B: "R", 0x211C: "R", 0x211D: "R", 0x2120: "SM",
+	0x2121: "TEL", 0x2122: "TM", 0x2124: "Z", 0x2128: "Z",
+	0x212C: "B", 0x212D: "C", 0x212F: "e", 0x2130: "E",
+	0x2131: "F", 0x2133: "M", 0x2134: "o", 0x2135: "\u05D0",
+	0x2136: "\u05D1", 0x2137: "\u05D2", 0x2138: "\u05D3", 0x2139: "i",
+	0x213B: "FAX", 0x213C: "\u03C0", 0x213D: "\u03B3", 0x213E: "\u0393",
+	0x213F: "\u03A0", 0x2140: "\u2211", 0x2145: "D", 0x2146: "d",
+	0x2147: "e", 0x2148: "i", 0x2149: "j", 0x2150: "1\u20447",
+	0x2151: "1\u20449", 0x2152: "1\u204410", 0x2153: "1\u20443", 0x2154: "2\u20443",
+	0x2155: "1\u20445", 0x2156: "2\u20445", 0x2157: "3\u20445", 0x2158: "4\u20445",
+	0x2159: "1\u20446", 0x215A: "5\u20446", 0x215B: "1\u20448", 0x215C: "3\u20448",
+	0x215D: "5\u20448", 0x215E: "7\u20448", 0x215F: "1\u2044", 0x2160: "I",
+	0x2161: "II", 0x2162: "III", 0x2163: "IV", 0x2164: "V",
+	0x2165: "VI", 0x2166: "VII", 0x2167: "VIII", 0x2168: "IX",
+	0x2169: "X", 0x216A: "XI", 0x216B: "XII", 0x216C: "L",
+	0x216D: "C", 0x216E: "D", 0x216F: "M", 0x2170: "i",
+	0x2171: "ii", 0x2172: "iii", 0x2173: "iv", 0x2174: "v",
+	0x2175: "vi", 0x2176: "vii", 0x2177: "viii", 0x2178: "ix",
+	0x2179: "x", 0x217A: "xi", 0x217B: "xii", 0x217C: "l",
+	0x217D: "c", 0x217E: "d", 0x217F: "m", 0x2189: "0\u20443",
+	0x222C: "\u222B\u222B", 0x222D: "\u222B\u222B\u222B", 0x222F: "\u222E\u222E", 0x2230: "\u222E\u222E\u222E",
+	0x2460: "1", 0x2461: "2", 0x2462: "3", 0x2463: "4",
+	0x2464: "5", 0x2465: "6", 0x2466: "7", 0x2467: "8",
+	0x2468: "9", 0x2469: "10", 0x246A: "11", 0x246B: "12",
+	0x246C: "13", 0x246D: "14", 0x246E: "15", 0x246F: "16",
+	0x2470: "17", 0x2471: "18", 0x2472: "19", 0x2473: "20",
+	0x2474: "(1)", 0x2475: "(2)", 0x2476: "(3)", 0x2477: "(4)",
+	0x2478: "(5)", 0x2479: "(6)", 0x247A: "(7)", 0x247B: "(8)",
+	0x247C: "(9)", 0x247D: "(10)", 0x247E: "(11)", 0x247F: "(12)",
+	0x2480: "(13)", 0x2481: "(14)", 0x2482: "(15)", 0x2483: "(16)",
+	0x2484: "(17)", 0x2485: "(18)", 0x2486: "(19)", 0x2487: "(20)",
+	0x2488: "1.", 0x2489: "2.", 0x248A: "3.", 0x248B: "4.",
+	0x248C: "5.", 0x248D: "6.", 0x248E: "7.", 0x248F: "8.",
+	0x2490: "9.", 0x2491: "10.", 0x2492: "11.", 0x2493: "12.",
+	0x2494: "13.", 0x2495: "14.", 0x2496: "15.", 0x2497: "16.",
+	0x2498: "17.", 0x2499: "18.", 0x249A: "19.", 0x249B: "20.",
+	0x249C: "(a)", 0x249D: "(b)", 0x249E: "(c)", 0x249F: "(d)",
+	0x24A0: "(e)", 0x24A1: "(f)", 0x24A2: "(g)", 0x24A3: "(h)",
+	0x24A4: "(i)", 0x24A5: "(j)", 0x24A6: "(k)", 0x24A7: "(l)",
+	0x24A8: "(m)", 0x24A9: "(n)", 0x24AA: "(o)", 0x24AB: "(p)",
+	0x24AC: "(q)", 0x24AD: "(r)", 0x24AE: "(s)", 0x24AF: "(t)",
+	0x24B0: "(u)", 0x24B1: "(v)", 0x24B2: "(w)", 0x24B3: "(x)",
+	0x24B4: "(y)", 0x24B5: "(z)", 0x24B6: "A", 0x24B7: "B",
+	0x24B8: "C", 0x24B9: "D", 0x24BA: "E", 0x24BB: "F",
+	0x24BC: "G", 0x24BD: "H", 0x24BE: "I", 0x24BF: "J",
+	0x24C0: "K", 0x24C1: "L", 0x24C2: "M", 0x24C3: "N",
+	0x24C4: "O", 0x24C5: "P", 0x24C6: "Q", 0x24C7: "R",
+	0x24C8: "S", 0x24C9: "T", 0x24CA: "U", 0x24CB: "V",
+	0x24CC: "W", 0x24CD: "X", 0x24CE: "Y", 0x24CF: "Z",
+	0x24D0: "a", 0x24D1: "b", 0x24D2: "c", 0x24D3: "d",
+	0x24D4: "e", 0x24D5: "f", 0x24D6: "g", 0x24D7: "h",
+	0x24D8: "i", 0x24D9: "j", 0x24DA: "k", 0x24DB: "l",
+	0x24DC: "m", 0x24DD: "n", 0x24DE: "o", 0x24DF: "p",
+	0x24E0: "q", 0x24E1: "r", 0x24E2: "s", 0x24E3: "t",
+	0x24E4: "u", 0x24E5: "v", 0x24E6: "w", 0x24E7: "x",
+	0x24E8: "y", 0x24E9: "z", 0x24EA: "0", 0x2A0C: "\u222B\u222B\u222B\u222B",
+	0x2A74: "::=", 0x2A75: "==", 0x2A76: "===", 0x2C7C: "j",
+	0x2C7D: "V", 0x2D6F: "\u2D61", 0x2E9F: "\u6BCD", 0x2EF3: "\u9F9F",
+	0x2F00: "\u4E00", 0x2F01: "\u4E28", 0x2F02: "\u4E36", 0x2F03: "\u4E3F",
+	0x2F04: "\u4E59", 0x2F05: "\u4E85", 0x2F06: "\u4E8C", 0x2F07: "\u4EA0",
+	0x2F08: "\u4EBA", 0x2F09: "\u513F", 0x2F0A: "\u5165", 0x2F0B: "\u516B",
+	0x2F0C: "\u5182", 0x2F0D: "\u5196", 0x2F0E: "\u51AB", 0x2F0F: "\u51E0",
+	0x2F10: "\u51F5", 0x2F11: "\u5200", 0x2F12: "\u529B", 0x2F13: "\u52F9",
+	0x2F14: "\u5315", 0x2F15: "\u531A", 0x2F16: "\u5338", 0x2F17: "\u5341",
+	0x2F18: "\u535C", 0x2F19: "\u5369", 0x2F1A: "\u5382", 0x2F1B: "\u53B6",
+	0x2F1C: "\u53C8", 0x2F1D: "\u53E3", 0x2F1E: "\u56D7", 0x2F1F: "\u571F",
+	0x2F20: "\u58EB", 0x2F21: "\u5902", 0x2F22: "\u590A", 0x2F23: "\u5915",
+	0x2F24: "\u5927", 0x2F25: "\u5973", 0x2F26: "\u5B50", 0x2F27: "\u5B80",
+	0x2F28: "\u5BF8", 0x2F29: "\u5C0F", 0x2F2A: "\u5C22", 0x2F2B: "\u5C38",
+	0x2F2C: "\u5C6E", 0x2F2D: "\u5C71", 0x2F2E: "\u5DDB", 0x2F2F: "\u5DE5",
+	0x2F30: "\u5DF1", 0x2F31: "\u5DFE", 0x2F32: "\u5E72", 0x2F33: "\u5E7A",
+	0x2F34: "\u5E7F", 0x2F35: "\u5EF4", 0x2F36: "\u5EFE", 0x2F37: "\u5F0B",
+	0x2F38: "\u5F13", 0x2F39: "\u5F50", 0x2F3A: "\u5F61", 0x2F3B: "\u5F73",
+	0x2F3C: "\u5FC3", 0x2F3D: "\u6208", 0x2F3E: "\u6236", 0x2F3F: "\u624B",
+	0x2F40: "\u652F", 0x2F41: "\u6534", 0x2F42: "\u6587", 0x2F43: "\u6597",
+	0x2F44: "\u65A4", 0x2F45: "\u65B9", 0x2F46: "\u65E0", 0x2F47: "\u65E5",
+	0x2F48: "\u66F0", 0x2F49: "\u6708", 0x2F4A: "\u6728", 0x2F4B: "\u6B20",
+	0x2F4C: "\u6B62", 0x2F4D: "\u6B79", 0x2F4E: "\u6BB3", 0x2F4F: "\u6BCB",
+	0x2F50: "\u6BD4", 0x2F51: "\u6BDB", 0x2F52: "\u6C0F", 0x2F53: "\u6C14",
+	0x2F54: "\u6C34", 0x2F55: "\u706B", 0x2F56: "\u722A", 0x2F57: "\u7236",
+	0x2F58: "\u723B", 0x2F59: "\u723F", 0x2F5A: "\u7247", 0x2F5B: "\u7259",
+	0x2F5C: "\u725B", 0x2F5D: "\u72AC", 0x2F5E: "\u7384", 0x2F5F: "\u7389",
+	0x2F60: "\u74DC", 0x2F61: "\u74E6", 0x2F62: "\u7518", 0x2F63: "\u751F",
+	0x2F64: "\u7528", 0x2F65: "\u7530", 0x2F66: "\u758B", 0x2F67: "\u7592",
+	0x2F68: "\u7676", 0x2F69: "\u767D", 0x2F6A: "\u76AE", 0x2F6B: "\u76BF",
+	0x2F6C: "\u76EE", 0x2F6D: "\u77DB", 0x2F6E: "\u77E2", 0x2F6F: "\u77F3",
+	0x2F70: "\u793A", 0x2F71: "\u79B8", 0x2F72: "\u79BE", 0x2F73: "\u7A74",
+	0x2F74: "\u7ACB", 0x2F75: "\u7AF9", 0x2F76: "\u7C73", 0x2F77: "\u7CF8",
+	0x2F78: "\u7F36", 0x2F79: "\u7F51", 0x2F7A: "\u7F8A", 0x2F7B: "\u7FBD",
+	0x2F7C: "\u8001", 0x2F7D: "\u800C", 0x2F7E: "\u8012", 0x2F7F: "\u8033",
+	0x2F80: "\u807F", 0x2F81: "\u8089", 0x2F82: "\u81E3", 0x2F83: "\u81EA",
+	0x2F84: "\u81F3", 0x2F85: "\u81FC", 0x2F86: "\u820C", 0x2F87: "\u821B",
+	0x2F88: "\u821F", 0x2F89: "\u826E", 0x2F8A: "\u8272", 0x2F8B: "\u8278",
+	0x2F8C: "\u864D", 0x2F8D: "\u866B", 0x2F8E: "\u8840", 0x2F8F: "\u884C",
+	0x2F90: "\u8863", 0x2F91: "\u897E", 0x2F92: "\u898B", 0x2F93: "\u89D2",
+	0x2F94: "\u8A00", 0x2F95: "\u8C37", 0x2F96: "\u8C46", 0x2F97: "\u8C55",
+	0x2F98: "\u8C78", 0x2F99: "\u8C9D", 0x2F9A: "\u8D64", 0x2F9B: "\u8D70",
+	0x2F9C: "\u8DB3", 0x2F9D: "\u8EAB", 0x2F9E: "\u8ECA", 0x2F9F: "\u8F9B",
+	0x2FA0: "\u8FB0", 0x2FA1: "\u8FB5", 0x2FA2: "\u9091", 0x2FA3: "\u9149",
+	0x2FA4: "\u91C6", 0x2FA5: "\u91CC", 0x2FA6: "\u91D1", 0x2FA7: "\u9577",
+	0x2FA8: "\u9580", 0x2FA9: "\u961C", 0x2FAA: "\u96B6", 0x2FAB: "\u96B9",
+	0x2FAC: "\u96E8", 0x2FAD: "\u9751", 0x2FAE: "\u975E", 0x2FAF: "\u9762",
+	0x2FB0: "\u9769", 0x2FB1: "\u97CB", 0x2FB2: "\u97ED", 0x2FB3: "\u97F3",
+	0x2FB4: "\u9801", 0x2FB5: "\u98A8", 0x2FB6: "\u98DB", 0x2FB7: "\u98DF",
+	0x2FB8: "\u9996", 0x2FB9: "\u9999", 0x2FBA: "\u99AC", 0x2FBB: "\u9AA8",
+	0x2FBC: "\u9AD8", 0x2FBD: "\u9ADF", 0x2FBE: "\u9B25", 0x2FBF: "\u9B2F",
+	0x2FC0: "\u9B32", 0x2FC1: "\u9B3C", 0x2FC2: "\u9B5A", 0x2FC3: "\u9CE5",
+	0x2FC4: "\u9E75", 0x2FC5: "\u9E7F", 0x2FC6: "\u9EA5", 0x2FC7: "\u9EBB",
+	0x2FC8: "\u9EC3", 0x2FC9: "\u9ECD", 0x2FCA: "\u9ED1", 0x2FCB: "\u9EF9",
+	0x2FCC: "\u9EFD", 0x2FCD: "\u9F0E", 0x2FCE: "\u9F13", 0x2FCF: "\u9F20",
+	0x2FD0: "\u9F3B", 0x2FD1: "\u9F4A", 0x2FD2: "\u9F52", 0x2FD3: "\u9F8D",
+	0x2FD4: "\u9F9C", 0x2FD5: "\u9FA0", 0x3000: " ", 0x3036: "\u3012",
+	0x3038: "\u5341", 0x3039: "\u5344", 0x303A: "\u5345", 0x309B: " \u3099",
+	0x309C: " \u309A", 0x309F: "\u3088\u308A", 0x30FF: "\u30B3\u30C8", 0x3131: "\u1100",
+	0x3132: "\u1101", 0x3133: "\u11AA", 0x3134: "\u1102", 0x3135: "\u11AC",
+	0x3136: "\u11AD", 0x3137: "\u1103", 0x3138: "\u1104", 0x3139: "\u1105",
+	0x313A: "\u11B0", 0x313B: "\u11B1", 0x313C: "\u11B2", 0x313D: "\u11B3",
+	0x313E: "\u11B4", 0x313F: "\u11B5", 0x3140: "\u111A", 0x3141: "\u1106",
+	0x3142: "\u1107", 0x3143: "\u1108", 0x3144: "\u1121", 0x3145: "\u1109",
+	0x3146: "\u110A", 0x3147: "\u110B", 0x3148: "\u110C", 0x3149: "\u110D",
+	0x314A: "\u110E", 0x314B: "\u110F", 0x314C: "\u1110", 0x314D: "\u1111",
+	0x314E: "\u1112", 0x314F: "\u1161", 0x3150: "\u1162", 0x3151: "\u1163",
+	0x3152: "\u1164", 0x3153: "\u1165", 0x3154: "\u1166", 0x3155: "\u1167",
+	0x3156: "\u1168", 0x3157: "\u1169", 0x3158: "\u116A", 0x3159: "\u116B",
+	0x315A: "\u116C", 0x315B: "\u116D", 0x315C: "\u116E", 0x315D: "\u116F",
+	0x315E: "\u1170", 0x315F: "\u1171", 0x3160: "\u1172", 0x3161: "\u1173",
+	0x3162: "\u1174", 0x3163: "\u1175", 0x3164: "\u1160", 0x3165: "\u1114",
+	0x3166: "\u1115", 0x3167: "\u11C7", 0x3168: "\u11C8", 0x3169: "\u11CC",
+	0x316A: "\u11CE", 0x316B: "\u11D3", 0x316C: "\u11D7", 0x316D: "\u11D9",
+	0x316E: "\u111C", 0x316F: "\u11DD", 0x3170: "\u11DF", 0x3171: "\u111D",
+	0x3172: "\u111E", 0x3173: "\u1120", 0x3174: "\u1122", 0x3175: "\u1123",
+	0x3176: "\u1127", 0x3177: "\u1129", 0x3178: "\u112B", 0x3179: "\u112C",
+	0x317A: "\u112D", 0x317B: "\u112E", 0x317C: "\u112F", 0x317D: "\u1132",
+	0x317E: "\u1136", 0x317F: "\u1140", 0x3180: "\u1147", 0x3181: "\u114C",
+	0x3182: "\u11F1", 0x3183: "\u11F2", 0x3184: "\u1157", 0x3185: "\u1158",
+	0x3186: "\u1159", 0x3187: "\u1184", 0x3188: "\u1185", 0x3189: "\u1188",
+	0x318A: "\u1191", 0x318B: "\u1192", 0x318C: "\u1194", 0x318D: "\u119E",
+	0x318E: "\u11A1", 0x3192: "\u4E00", 0x3193: "\u4E8C", 0x3194: "\u4E09",
+	0x3195: "\u56DB", 0x3196: "\u4E0A", 0x3197: "\u4E2D", 0x3198: "\u4E0B",
+	0x3199: "\u7532", 0x319A: "\u4E59", 0x319B: "\u4E19", 0x319C: "\u4E01",
+	0x319D: "\u5929", 0x319E: "\u5730", 0x319F: "\u4EBA", 0x3200: "(\u1100)",
+	0x3201: "(\u1102)", 0x3202: "(\u1103)", 0x3203: "(\u1105)", 0x3204: "(\u1106)",
+	0x3205: "(\u1107)", 0x3206: "(\u1109)", 0x3207: "(\u110B)", 0x3208: "(\u110C)",
+	0x3209: "(\u110E)", 0x320A: "(\u110F)", 0x320B: "(\u1110)", 0x320C: "(\u1111)",
+	0x320D: "(\u1112)", 0x320E: "(\u1100\u1161)", 0x320F: "(\u1102\u1161)", 0x3210: "(\u1103\u1161)",
+	0x3211: "(\u1105\u1161)", 0x3212: "(\u1106\u1161)", 0x3213: "(\u1107\u1161)", 0x3214: "(\u1109\u1161)",
+	0x3215: "(\u110B\u1161)", 0x3216: "(\u110C\u1161)", 0x3217: "(\u110E\u1161)", 0x3218: "(\u110F\u1161)",
+	0x3219: "(\u1110\u1161)", 0x321A: "(\u1111\u1161)", 0x321B: "(\u1112\u1161)", 0x321C: "(\u110C\u116E)",
+	0x321D: "(\u110B\u1169\u110C\u1165\u11AB)", 0x321E: "(\u110B\u1169\u1112\u116E)", 0x3220: "(\u4E00)", 0x3221: "(\u4E8C)",
+	0x3222: "(\u4E09)", 0x3223: "(\u56DB)", 0x3224: "(\u4E94)", 0x3225: "(\u516D)",
+	0x3226: "(\u4E03)", 0x3227: "(\u516B)", 0x3228: "(\u4E5D)", 0x3229: "(\u5341)",
+	0x322A: "(\u6708)", 0x322B: "(\u706B)", 0x322C: "(\u6C34)", 0x322D: "(\u6728)",
+	0x322E: "(\u91D1)", 0x322F: "(\u571F)", 0x3230: "(\u65E5)", 0x3231: "(\u682A)",
+	0x3232: "(\u6709)", 0x3233: "(\u793E)", 0x3234: "(\u540D)", 0x3235: "(\u7279)",
+	0x3236: "(\u8CA1)", 0x3237: "(\u795D)", 0x3238: "(\u52B4)", 0x3239: "(\u4EE3)",
+	0x323A: "(\u547C)", 0x323B: "(\u5B66)", 0x323C: "(\u76E3)", 0x323D: "(\u4F01)",
+	0x323E: "(\u8CC7)", 0x323F: "(\u5354)", 0x3240: "(\u796D)", 0x3241: "(\u4F11)",
+	0x3242: "(\u81EA)", 0x3243: "(\u81F3)", 0x3244: "\u554F", 0x3245: "\u5E7C",
+	0x3246: "\u6587", 0x3247: "\u7B8F", 0x3250: "PTE", 0x3251: "21",
+	0x3252: "22", 0x3253: "23", 0x3254: "24", 0x3255: "25",
+	0x3256: "26", 0x3257: "27", 0x3258: "28", 0x3259: "29",
+	0x325A: "30", 0x325B: "31", 0x325C: "32", 0x325D: "33",
+	0x325E: "34", 0x325F: "35", 0x3260: "\u1100", 0x3261: "\u1102",
+	0x3262: "\u1103", 0x3263: "\u1105", 0x3264: "\u1106", 0x3265: "\u1107",
+	0x3266: "\u1109", 0x3267: "\u110B", 0x3268: "\u110C", 0x3269: "\u110E",
+	0x326A: "\u110F", 0x326B: "\u1110", 0x326C: "\u1111", 0x326D: "\u1112",
+	0x326E: "\u1100\u1161", 0x326F: "\u1102\u1161", 0x3270: "\u1103\u1161", 0x3271: "\u1105\u1161",
+	0x3272: "\u1106\u1161", 0x3273: "\u1107\u1161", 0x3274: "\u1109\u1161", 0x3275: "\u110B\u1161",
+	0x3276: "\u110C\u1161", 0x3277: "\u110E\u1161", 0x3278: "\u110F\u1161", 0x3279: "\u1110\u1161",
+	0x327A: "\u1111\u1161", 0x327B: "\u1112\u1161", 0x327C: "\u110E\u1161\u11B7\u1100\u1169", 0x327D: "\u110C\u116E\u110B\u1174",
+	0x327E: "\u110B\u116E", 0x3280: "\u4E00", 0x3281: "\u4E8C", 0x3282: "\u4E09",
+	0x3283: "\u56DB", 0x3284: "\u4E94", 0x3285: "\u516D", 0x3286: "\u4E03",
+	0x3287: "\u516B", 0x3288: "\u4E5D", 0x3289: "\u5341", 0x328A: "\u6708",
+	0x328B: "\u706B", 0x328C: "\u6C34", 0x328D: "\u6728", 0x328E: "\u91D1",
+	0x328F: "\u571F", 0x3290: "\u65E5", 0x3291: "\u682A", 0x3292: "\u6709",
+	0x3293: "\u793E", 0x3294: "\u540D", 0x3295: "\u7279", 0x3296: "\u8CA1",
+	0x3297: "\u795D", 0x3298: "\u52B4", 0x3299: "\u79D8", 0x329A: "\u7537",
+	0x329B: "\u5973", 0x329C: "\u9069", 0x329D: "\u512A", 0x329E: "\u5370",
+	0x329F: "\u6CE8", 0x32A0: "\u9805", 0x32A1: "\u4F11", 0x32A2: "\u5199",
+	0x32A3: "\u6B63", 0x32A4: "\u4E0A", 0x32A5: "\u4E2D", 0x32A6: "\u4E0B",
+	0x32A7: "\u5DE6", 0x32A8: "\u53F3", 0x32A9: "\u533B", 0x32AA: "\u5B97",
+	0x32AB: "\u5B66", 0x32AC: "\u76E3", 0x32AD: "\u4F01", 0x32AE: "\u8CC7",
+	0x32AF: "\u5354", 0x32B0: "\u591C", 0x32B1: "36", 0x32B2: "37",
+	0x32B3: "38", 0x32B4: "39", 0x32B5: "40", 0x32B6: "41",
+	0x32B7: "42", 0x32B8: "43", 0x32B9: "44", 0x32BA: "45",
+	0x32BB: "46", 0x32BC: "47", 0x32BD: "48", 0x32BE: "49",
+	0x32BF: "50", 0x32C0: "1\u6708", 0x32C1: "2\u6708", 0x32C2: "3\u6708",
+	0x32C3: "4\u6708", 0x32C4: "5\u6708", 0x32C5: "6\u6708", 0x32C6: "7\u6708",
+	0x32C7: "8\u6708", 0x32C8: "9\u6708", 0x32C9: "10\u6708", 0x32CA: "11\u6708",
+	0x32CB: "12\u6708", 0x32CC: "Hg", 0x32CD: "erg", 0x32CE: "eV",
+	0x32CF: "LTD", 0x32D0: "\u30A2", 0x32D1: "\u30A4", 0x32D2: "\u30A6",
+	0x32D3: "\u30A8", 0x32D4: "\u30AA", 0x32D5: "\u30AB", 0x32D6: "\u30AD",
+	0x32D7: "\u30AF", 0x32D8: "\u30B1", 0x32D9: "\u30B3", 0x32DA: "\u30B5",
+	0x32DB: "\u30B7", 0x32DC: "\u30B9", 0x32DD: "\u30BB", 0x32DE: "\u30BD",
+	0x32DF: "\u30BF", 0x32E0: "\u30C1", 0x32E1: "\u30C4", 0x32E2: "\u30C6",
+	0x32E3: "\u30C8", 0x32E4: "\u30CA", 0x32E5: "\u30CB", 0x32E6: "\u30CC",
+	0x32E7: "\u30CD", 0x32E8: "\u30CE", 0x32E9: "\u30CF", 0x32EA: "\u30D2",
+	0x32EB: "\u30D5", 0x32EC: "\u30D8", 0x32ED: "\u30DB", 0x32EE: "\u30DE",
+	0x32EF: "\u30DF", 0x32F0: "\u30E0", 0x32F1: "\u30E1", 0x32F2: "\u30E2",
+	0x32F3: "\u30E4", 0x32F4: "\u30E6", 0x32F5: "\u30E8", 0x32F6: "\u30E9",
+	0x32F7: "\u30EA", 0x32F8: "\u30EB", 0x32F9: "\u30EC", 0x32FA: "\u30ED",
+	0x32FB: "\u30EF", 0x32FC: "\u30F0", 0x32FD: "\u30F1", 0x32FE: "\u30F2",
+	0x32FF: "\u4EE4\u548C", 0x3300: "\u30A2\u30CF\u309A\u30FC\u30C8", 0x3301: "\u30A2\u30EB\u30D5\u30A1", 0x3302: "\u30A2\u30F3\u30D8\u309A\u30A2",
+	0x3303: "\u30A2\u30FC\u30EB", 0x3304: "\u30A4\u30CB\u30F3\u30AF\u3099", 0x3305: "\u30A4\u30F3\u30C1", 0x3306: "\u30A6\u30A9\u30F3",
+	0x3307: "\u30A8\u30B9\u30AF\u30FC\u30C8\u3099", 0x3308: "\u30A8\u30FC\u30AB\u30FC", 0x3309: "\u30AA\u30F3\u30B9", 0x330A: "\u30AA\u30FC\u30E0",
+	0x330B: "\u30AB\u30A4\u30EA", 0x330C: "\u30AB\u30E9\u30C3\u30C8", 0x330D: "\u30AB\u30ED\u30EA\u30FC", 0x330E: "\u30AB\u3099\u30ED\u30F3",
+	0x330F: "\u30AB\u3099\u30F3\u30DE", 0x3310: "\u30AD\u3099\u30AB\u3099", 0x3311: "\u30AD\u3099\u30CB\u30FC", 0x3312: "\u30AD\u30E5\u30EA\u30FC",
+	0x3313: "\u30AD\u3099\u30EB\u30BF\u3099\u30FC", 0x3314: "\u30AD\u30ED", 0x3315: "\u30AD\u30ED\u30AF\u3099\u30E9\u30E0", 0x3316: "\u30AD\u30ED\u30E1\u30FC\u30C8\u30EB",
+	0x3317: "\u30AD\u30ED\u30EF\u30C3\u30C8", 0x3318: "\u30AF\u3099\u30E9\u30E0", 0x3319: "\u30AF\u3099\u30E9\u30E0\u30C8\u30F3", 0x331A: "\u30AF\u30EB\u30BB\u3099\u30A4\u30ED",
+	0x331B: "\u30AF\u30ED\u30FC\u30CD", 0x331C: "\u30B1\u30FC\u30B9", 0x331D: "\u30B3\u30EB\u30CA", 0x331E: "\u30B3\u30FC\u30DB\u309A",
+	0x331F: "\u30B5\u30A4\u30AF\u30EB", 0x3320: "\u30B5\u30F3\u30C1\u30FC\u30E0", 0x3321: "\u30B7\u30EA\u30F3\u30AF\u3099", 0x3322: "\u30BB\u30F3\u30C1",
+	0x3323: "\u30BB\u30F3\u30C8", 0x3324: "\u30BF\u3099\u30FC\u30B9", 0x3325: "\u30C6\u3099\u30B7", 0x3326: "\u30C8\u3099\u30EB",
+	0x3327: "\u30C8\u30F3", 0x3328: "\u30CA\u30CE", 0x3329: "\u30CE\u30C3\u30C8", 0x332A: "\u30CF\u30A4\u30C4",
+	0x332B: "\u30CF\u309A\u30FC\u30BB\u30F3\u30C8", 0x332C: "\u30CF\u309A\u30FC\u30C4", 0x332D: "\u30CF\u3099\u30FC\u30EC\u30EB", 0x332E: "\u30D2\u309A\u30A2\u30B9\u30C8\u30EB",
+	0x332F: "\u30D2\u309A\u30AF\u30EB", 0x3330: "\u30D2\u309A\u30B3", 0x3331: "\u30D2\u3099\u30EB", 0x3332: "\u30D5\u30A1\u30E9\u30C3\u30C8\u3099",
+	0x3333: "\u30D5\u30A3\u30FC\u30C8", 0x3334: "\u30D5\u3099\u30C3\u30B7\u30A7\u30EB", 0x3335: "\u30D5\u30E9\u30F3", 0x3336: "\u30D8\u30AF\u30BF\u30FC\u30EB",
+	0x3337: "\u30D8\u309A\u30BD", 0x3338: "\u30D8\u309A\u30CB\u30D2", 0x3339: "\u30D8\u30EB\u30C4", 0x333A: "\u30D8\u309A\u30F3\u30B9",
+	0x333B: "\u30D8\u309A\u30FC\u30B7\u3099", 0x333C: "\u30D8\u3099\u30FC\u30BF", 0x333D: "\u30DB\u309A\u30A4\u30F3\u30C8", 0x333E: "\u30DB\u3099\u30EB\u30C8",
+	0x333F: "\u30DB\u30F3", 0x3340: "\u30DB\u309A\u30F3\u30C8\u3099", 0x3341: "\u30DB\u30FC\u30EB", 0x3342: "\u30DB\u30FC\u30F3",
+	0x3343: "\u30DE\u30A4\u30AF\u30ED", 0x3344: "\u30DE\u30A4\u30EB", 0x3345: "\u30DE\u30C3\u30CF", 0x3346: "\u30DE\u30EB\u30AF",
+	0x3347: "\u30DE\u30F3\u30B7\u30E7\u30F3", 0x3348: "\u30DF\u30AF\u30ED\u30F3", 0x3349: "\u30DF\u30EA", 0x334A: "\u30DF\u30EA\u30CF\u3099\u30FC\u30EB",
+	0x334B: "\u30E1\u30AB\u3099", 0x334C: "\u30E1\u30AB\u3099\u30C8\u30F3", 0x334D: "\u30E1\u30FC\u30C8\u30EB", 0x334E: "\u30E4\u30FC\u30C8\u3099",
+	0x334F: "\u30E4\u30FC\u30EB", 0x3350: "\u30E6\u30A2\u30F3", 0x3351: "\u30EA\u30C3\u30C8\u30EB", 0x3352: "\u30EA\u30E9",
+	0x3353: "\u30EB\u30D2\u309A\u30FC", 0x3354: "\u30EB\u30FC\u30D5\u3099\u30EB", 0x3355: "\u30EC\u30E0", 0x3356: "\u30EC\u30F3\u30C8\u30B1\u3099\u30F3",
+	0x3357: "\u30EF\u30C3\u30C8", 0x3358: "0\u70B9", 0x3359: "1\u70B9", 0x335A: "2\u70B9",
+	0x335B: "3\u70B9", 0x335C: "4\u70B9", 0x335D: "5\u70B9", 0x335E: "6\u70B9",
+	0x335F: "7\u70B9", 0x3360: "8\u70B9", 0x3361: "9\u70B9", 0x3362: "10\u70B9",
+	0x3363: "11\u70B9", 0x3364: "12\u70B9", 0x3365: "13\u70B9", 0x3366: "14\u70B9",
+	0x3367: "15\u70B9", 0x3368: "16\u70B9", 0x3369: "17\u70B9", 0x336A: "18\u70B9",
+	0x336B: "19\u70B9", 0x336C: "20\u70B9", 0x336D: "21\u70B9", 0x336E: "22\u70B9",
+	0x336F: "23\u70B9", 0x3370: "24\u70B9", 0x3371: "hPa", 0x3372: "da",
+	0x3373: "AU", 0x3374: "bar", 0x3375: "oV", 0x3376: "pc",
+	0x3377: "dm", 0x3378: "dm2", 0x3379: "dm3", 0x337A: "IU",
+	0x337B: "\u5E73\u6210", 0x337C: "\u662D\u548C", 0x337D: "\u5927\u6B63", 0x337E: "\u660E\u6CBB",
+	0x337F: "\u682A\u5F0F\u4F1A\u793E", 0x3380: "pA", 0x3381: "nA", 0x3382: "\u03BCA",
+	0x3383: "mA", 0x3384: "kA", 0x3385: "KB", 0x3386: "MB",
+	0x3387: "GB", 0x3388: "cal", 0x3389: "kcal", 0x338A: "pF",
+	0x338B: "nF", 0x338C: "\u03BCF", 0x338D: "\u03BCg", 0x338E: "mg",
+	0x338F: "kg", 0x3390: "Hz", 0x3391: "kHz", 0x3392: "MHz",
+	0x3393: "GHz", 0x3394: "THz", 0x3395: "\u03BCl", 0x3396: "ml",
+	0x3397: "dl", 0x3398: "kl", 0x3399: "fm", 0x339A: "nm",
+	0x339B: "\u03BCm", 0x339C: "mm", 0x339D: "cm", 0x339E: "km",
+	0x339F: "mm2", 0x33A0: "cm2", 0x33A1: "m2", 0x33A2: "km2",
+	0x33A3: "mm3", 0x33A4: "cm3", 0x33A5: "m3", 0x33A6: "km3",
+	0x33A7: "m\u2215s", 0x33A8: "m\u2215s2", 0x33A9: "Pa", 0x33AA: "kPa",
+	0x33AB: "MPa", 0x33AC: "GPa", 0x33AD: "rad", 0x33AE: "rad\u2215s",
+	0x33AF: "rad\u2215s2", 0x33B0: "ps", 0x33B1: "ns", 0x33B2: "\u03BCs",
+	0x33B3: "ms", 0x33B4: "pV", 0x33B5: "nV", 0x33B6: "\u03BCV",
+	0x33B7: "mV", 0x33B8: "kV", 0x33B9: "MV", 0x33BA: "pW",
+	0x33BB: "nW", 0x33BC: "\u03BCW", 0x33BD: "mW", 0x33BE: "kW",
+	0x33BF: "MW", 0x33C0: "k\u03A9", 0x33C1: "M\u03A9", 0x33C2: "a.m.",
+	0x33C3: "Bq", 0x33C4: "cc", 0x33C5: "cd", 0x33C6: "C\u2215kg",
+	0x33C7: "Co.", 0x33C8: "dB", 0x33C9: "Gy", 0x33CA: "ha",
+	0x33CB: "HP", 0x33CC: "in", 0x33CD: "KK", 0x33CE: "KM",
+	0x33CF: "kt", 0x33D0: "lm", 0x33D1: "ln", 0x33D2: "log",
+	0x33D3: "lx", 0x33D4: "mb", 0x33D5: "mil", 0x33D6: "mol",
+	0x33D7: "PH", 0x33D8: "p.m.", 0x33D9: "PPM", 0x33DA: "PR",
+	0x33DB: "sr", 0x33DC: "Sv", 0x33DD: "Wb", 0x33DE: "V\u2215m",
+	0x33DF: "A\u2215m", 0x33E0: "1\u65E5", 0x33E1: "2\u65E5", 0x33E2: "3\u65E5",
+	0x33E3: "4\u65E5", 0x33E4: "5\u65E5", 0x33E5: "6\u65E5", 0x33E6: "7\u65E5",
+	0x33E7: "8\u65E5", 0x33E8: "9\u65E5", 0x33E9: "10\u65E5", 0x33EA: "11\u65E5",
+	0x33EB: "12\u65E5", 0x33EC: "13\u65E5", 0x33ED: "14\u65E5", 0x33EE: "15\u65E5",
+	0x33EF: "16\u65E5", 0x33F0: "17\u65E5", 0x33F1: "18\u65E5", 0x33F2: "19\u65E5",
+	0x33F3: "20\u65E5", 0x33F4: "21\u65E5", 0x33F5: "22\u65E5", 0x33F6: "23\u65E5",
+	0x33F7: "24\u65E5", 0x33F8: "25\u65E5", 0x33F9: "26\u65E5", 0x33FA: "27\u65E5",
+	0x33FB: "28\u65E5", 0x33FC: "29\u65E5", 0x33FD: "30\u65E5", 0x33FE: "31\u65E5",
+	0x33FF: "gal", 0xA69C: "\u044A", 0xA69D: "\u044C", 0xA770: "\uA76F",
+	0xA7F1: "S", 0xA7F2: "C", 0xA7F3: "F", 0xA7F4: "Q",
+	0xA7F8: "\u0126", 0xA7F9: "\u0153", 0xAB5C: "\uA727", 0xAB5D: "\uAB37",
+	0xAB5E: "\u026B", 0xAB5F: "\uAB52", 0xAB69: "\u028D", 0xFB00: "ff",
+	0xFB01: "fi", 0xFB02: "fl", 0xFB03: "ffi", 0xFB04: "ffl",
+	0xFB05: "st", 0xFB06: "st", 0xFB13: "\u0574\u0576", 0xFB14: "\u0574\u0565",
+	0xFB15: "\u0574\u056B", 0xFB16: "\u057E\u0576", 0xFB17: "\u0574\u056D", 0xFB20: "\u05E2",
+	0xFB21: "\u05D0", 0xFB22: "\u05D3", 0xFB23: "\u05D4", 0xFB24: "\u05DB",
+	0xFB25: "\u05DC", 0xFB26: "\u05DD", 0xFB27: "\u05E8", 0xFB28: "\u05EA",
+	0xFB29: "+", 0xFB4F: "\u05D0\u05DC", 0xFB50: "\u0671", 0xFB51: "\u0671",
+	0xFB52: "\u067B", 0xFB53: "\u067B", 0xFB54: "\u067B", 0xFB55: "\u067B",
+	0xFB56: "\u067E", 0xFB57: "\u067E", 0xFB58: "\u067E", 0xFB59: "\u067E",
+	0xFB5A: "\u0680", 0xFB5B: "\u0680", 0xFB5C: "\u0680", 0xFB5D: "\u0680",
+	0xFB5E: "\u067A", 0xFB5F: "\u067A", 0xFB60: "\u067A", 0xFB61: "\u067A",
+	0xFB62: "\u067F", 0xFB63: "\u067F", 0xFB64: "\u067F", 0xFB65: "\u067F",
+	0xFB66: "\u0679", 0xFB67: "\u0679", 0xFB68: "\u0679", 0xFB69: "\u0679",
+	0xFB6A: "\u06A4", 0xFB6B: "\u06A4", 0xFB6C: "\u06A4", 0xFB6D: "\u06A4",
+	0xFB6E: "\u06A6", 0xFB6F: "\u06A6", 0xFB70: "\u06A6", 0xFB71: "\u06A6",
+	0xFB72: "\u0684", 0xFB73: "\u0684", 0xFB74: "\u0684", 0xFB75: "\u0684",
+	0xFB76: "\u0683", 0xFB77: "\u0683", 0xFB78: "\u0683", 0xFB79: "\u0683",
+	0xFB7A: "\u0686", 0xFB7B: "\u0686", 0xFB7C: "\u0686", 0xFB7D: "\u0686",
+	0xFB7E: "\u0687", 0xFB7F: "\u0687", 0xFB80: "\u0687", 0xFB81: "\u0687",
+	0xFB82: "\u068D", 0xFB83: "\u068D", 0xFB84: "\u068C", 0xFB85: "\u068C",
+	0xFB86: "\u068E", 0xFB87: "\u068E", 0xFB88: "\u0688", 0xFB89: "\u0688",
+	0xFB8A: "\u0698", 0xFB8B: "\u0698", 0xFB8C: "\u0691", 0xFB8D: "\u0691",
+	0xFB8E: "\u06A9", 0xFB8F: "\u06A9", 0xFB90: "\u06A9", 0xFB91: "\u06A9",
+	0xFB92: "\u06AF", 0xFB93: "\u06AF", 0xFB94: "\u06AF", 0xFB95: "\u06AF",
+	0xFB96: "\u06B3", 0xFB97: "\u06B3", 0xFB98: "\u06B3", 0xFB99: "\u06B3",
+	0xFB9A: "\u06B1", 0xFB9B: "\u06B1", 0xFB9C: "\u06B1", 0xFB9D: "\u06B1",
+	0xFB9E: "\u06BA", 0xFB9F: "\u06BA", 0xFBA0: "\u06BB", 0xFBA1: "\u06BB",
+	0xFBA2: "\u06BB", 0xFBA3: "\u06BB", 0xFBA4: "\u06D5\u0654", 0xFBA5: "\u06D5\u0654",
+	0xFBA6: "\u06C1", 0xFBA7: "\u06C1", 0xFBA8: "\u06C1", 0xFBA9: "\u06C1",
+	0xFBAA: "\u06BE", 0xFBAB: "\u06BE", 0xFBAC: "\u06BE", 0xFBAD: "\u06BE",
+	0xFBAE: "\u06D2", 0xFBAF: "\u06D2", 0xFBB0: "\u06D2\u0654", 0xFBB1: "\u06D2\u0654",
+	0xFBD3: "\u06AD", 0xFBD4: "\u06AD", 0xFBD5: "\u06AD", 0xFBD6: "\u06AD",
+	0xFBD7: "\u06C7", 0xFBD8: "\u06C7", 0xFBD9: "\u06C6", 0xFBDA: "\u06C6",
+	0xFBDB: "\u06C8", 0xFBDC: "\u06C8", 0xFBDD: "\u06C7\u0674", 0xFBDE: "\u06CB",
+	0xFBDF: "\u06CB", 0xFBE0: "\u06C5", 0xFBE1: "\u06C5", 0xFBE2: "\u06C9",
+	0xFBE3: "\u06C9", 0xFBE4: "\u06D0", 0xFBE5: "\u06D0", 0xFBE6: "\u06D0",
+	0xFBE7: "\u06D0", 0xFBE8: "\u0649", 0xFBE9: "\u0649", 0xFBEA: "\u064A\u0654\u0627",
+	0xFBEB: "\u064A\u0654\u0627", 0xFBEC: "\u064A\u0654\u06D5", 0xFBED: "\u064A\u0654\u06D5", 0xFBEE: "\u064A\u0654\u0648",
+	0xFBEF: "\u064A\u0654\u0648", 0xFBF0: "\u064A\u0654\u06C7", 0xFBF1: "\u064A\u0654\u06C7", 0xFBF2: "\u064A\u0654\u06C6",
+	0xFBF3: "\u064A\u0654\u06C6", 0xFBF4: "\u064A\u0654\u06C8", 0xFBF5: "\u064A\u0654\u06C8", 0xFBF6: "\u064A\u0654\u06D0",
+	0xFBF7: "\u064A\u0654\u06D0", 0xFBF8: "\u064A\u0654\u06D0", 0xFBF9: "\u064A\u0654\u0649", 0xFBFA: "\u064A\u0654\u0649",
+	0xFBFB: "\u064A\u0654\u0649", 0xFBFC: "\u06CC", 0xFBFD: "\u06CC", 0xFBFE: "\u06CC",
+	0xFBFF: "\u06CC", 0xFC00: "\u064A\u0654\u062C", 0xFC01: "\u064A\u0654\u062D", 0xFC02: "\u064A\u0654\u0645",
+	0xFC03: "\u064A\u0654\u0649", 0xFC04: "\u064A\u0654\u064A", 0xFC05: "\u0628\u062C", 0xFC06: "\u0628\u062D",
+	0xFC07: "\u0628\u062E", 0xFC08: "\u0628\u0645", 0xFC09: "\u0628\u0649", 0xFC0A: "\u0628\u064A",
+	0xFC0B: "\u062A\u062C", 0xFC0C: "\u062A\u062D", 0xFC0D: "\u062A\u062E", 0xFC0E: "\u062A\u0645",
+	0xFC0F: "\u062A\u0649", 0xFC10: "\u062A\u064A", 0xFC11: "\u062B\u062C", 0xFC12: "\u062B\u0645",
+	0xFC13: "\u062B\u0649", 0xFC14: "\u062B\u064A", 0xFC15: "\u062C\u062D", 0xFC16: "\u062C\u0645",
+	0xFC17: "\u062D\u062C", 0xFC18: "\u062D\u0645", 0xFC19: "\u062E\u062C", 0xFC1A: "\u062E\u062D",
+	0xFC1B: "\u062E\u0645", 0xFC1C: "\u0633\u062C", 0xFC1D: "\u0633\u062D", 0xFC1E: "\u0633\u062E",
+	0xFC1F: "\u0633\u0645", 0xFC20: "\u0635\u062D", 0xFC21: "\u0635\u0645", 0xFC22: "\u0636\u062C",
+	0xFC23: "\u0636\u062D", 0xFC24: "\u0636\u062E", 0xFC25: "\u0636\u0645", 0xFC26: "\u0637\u062D",
+	0xFC27: "\u0637\u0645", 0xFC28: "\u0638\u0645", 0xFC29: "\u0639\u062C", 0xFC2A: "\u0639\u0645",
+	0xFC2B: "\u063A\u062C", 0xFC2C: "\u063A\u0645", 0xFC2D: "\u0641\u062C", 0xFC2E: "\u0641\u062D",
+	0xFC2F: "\u0641\u062E", 0xFC30: "\u0641\u0645", 0xFC31: "\u0641\u0649", 0xFC32: "\u0641\u064A",
+	0xFC33: "\u0642\u062D", 0xFC34: "\u0642\u0645", 0xFC35: "\u0642\u0649", 0xFC36: "\u0642\u064A",
+	0xFC37: "\u0643\u0627", 0xFC38: "\u0643\u062C", 0xFC39: "\u0643\u062D", 0xFC3A: "\u0643\u062E",
+	0xFC3B: "\u0643\u0644", 0xFC3C: "\u0643\u0645", 0xFC3D: "\u0643\u0649", 0xFC3E: "\u0643\u064A",
+	0xFC3F: "\u0644\u062C", 0xFC40: "\u0644\u062D", 0xFC41: "\u0644\u062E", 0xFC42: "\u0644\u0645",
+	0xFC43: "\u0644\u0649", 0xFC44: "\u0644\u064A", 0xFC45: "\u0645\u062C", 0xFC46: "\u0645\u062D",
+	0xFC47: "\u0645\u062E", 0xFC48: "\u0645\u0645", 0xFC49: "\u0645\u0649", 0xFC4A: "\u0645\u064A",
+	0xFC4B: "\u0646\u062C", 0xFC4C: "\u0646\u062D", 0xFC4D: "\u0646\u062E", 0xFC4E: "\u0646\u0645",
+	0xFC4F: "\u0646\u0649", 0xFC50: "\u0646\u064A", 0xFC51: "\u0647\u062C", 0xFC52: "\u0647\u0645",
+	0xFC53: "\u0647\u0649", 0xFC54: "\u0647\u064A", 0xFC55: "\u064A\u062C", 0xFC56: "\u064A\u062D",
+	0xFC57: "\u064A\u062E", 0xFC58: "\u064A\u0645", 0xFC59: "\u064A\u0649", 0xFC5A: "\u064A\u064A",
+	0xFC5B: "\u0630\u0670", 0xFC5C: "\u0631\u0670", 0xFC5D: "\u0649\u0670", 0xFC5E: " \u064C\u0651",
+	0xFC5F: " \u064D\u0651", 0xFC60: " \u064E\u0651", 0xFC61: " \u064F\u0651", 0xFC62: " \u0650\u0651",
+	0xFC63: " \u0651\u0670", 0xFC64: "\u064A\u0654\u0631", 0xFC65: "\u064A\u0654\u0632", 0xFC66: "\u064A\u0654\u0645",
+	0xFC67: "\u064A\u0654\u0646", 0xFC68: "\u064A\u0654\u0649", 0xFC69: "\u064A\u0654\u064A", 0xFC6A: "\u0628\u0631",
+	0xFC6B: "\u0628\u0632", 0xFC6C: "\u0628\u0645", 0xFC6D: "\u0628\u0646", 0xFC6E: "\u0628\u0649",
+	0xFC6F: "\u0628\u064A", 0xFC70: "\u062A\u0631", 0xFC71: "\u062A\u0632", 0xFC72: "\u062A\u0645",
+	0xFC73: "\u062A\u0646", 0xFC74: "\u062A\u0649", 0xFC75: "\u062A\u064A", 0xFC76: "\u062B\u0631",
+	0xFC77: "\u062B\u0632", 0xFC78: "\u062B\u0645", 0xFC79: "\u062B\u0646", 0xFC7A: "\u062B\u0649",
+	0xFC7B: "\u062B\u064A", 0xFC7C: "\u0641\u0649", 0xFC7D: "\u0641\u064A", 0xFC7E: "\u0642\u0649",
+	0xFC7F: "\u0642\u064A", 0xFC80: "\u0643\u0627", 0xFC81: "\u0643\u0644", 0xFC82: "\u0643\u0645",
+	0xFC83: "\u0643\u0649", 0xFC84: "\u0643\u064A", 0xFC85: "\u0644\u0645", 0xFC86: "\u0644\u0649",
+	0xFC87: "\u0644\u064A", 0xFC88: "\u0645\u0627", 0xFC89: "\u0645\u0645", 0xFC8A: "\u0646\u0631",
+	0xFC8B: "\u0646\u0632", 0xFC8C: "\u0646\u0645", 0xFC8D: "\u0646\u0646", 0xFC8E: "\u0646\u0649",
+	0xFC8F: "\u0646\u064A", 0xFC90: "\u0649\u0670", 0xFC91: "\u064A\u0631", 0xFC92: "\u064A\u0632",
+	0xFC93: "\u064A\u0645", 0xFC94: "\u064A\u0646", 0xFC95: "\u064A\u0649", 0xFC96: "\u064A\u064A",
+	0xFC97: "\u064A\u0654\u062C", 0xFC98: "\u064A\u0654\u062D", 0xFC99: "\u064A\u0654\u062E", 0xFC9A: "\u064A\u0654\u0645",
+	0xFC9B: "\u064A\u0654\u0647", 0xFC9C: "\u0628\u062C", 0xFC9D: "\u0628\u062D", 0xFC9E: "\u0628\u062E",
+	0xFC9F: "\u0628\u0645", 0xFCA0: "\u0628\u0647", 0xFCA1: "\u062A\u062C", 0xFCA2: "\u062A\u062D",
+	0xFCA3: "\u062A\u062E", 0xFCA4: "\u062A\u0645", 0xFCA5: "\u062A\u0647", 0xFCA6: "\u062B\u0645",
+	0xFCA7: "\u062C\u062D", 0xFCA8: "\u062C\u0645", 0xFCA9: "\u062D\u062C", 0xFCAA: "\u062D\u0645",
+	0xFCAB: "\u062E\u062C", 0xFCAC: "\u062E\u0645", 0xFCAD: "\u0633\u062C", 0xFCAE: "\u0633\u062D",
+	0xFCAF: "\u0633\u062E", 0xFCB0: "\u0633\u0645", 0xFCB1: "\u0635\u062D", 0xFCB2: "\u0635\u062E",
+	0xFCB3: "\u0635\u0645", 0xFCB4: "\u0636\u062C", 0xFCB5: "\u0636\u062D", 0xFCB6: "\u0636\u062E",
+	0xFCB7: "\u0636\u0645", 0xFCB8: "\u0637\u062D", 0xFCB9: "\u0638\u0645", 0xFCBA: "\u0639\u062C",
+	0xFCBB: "\u0639\u0645", 0xFCBC: "\u063A\u062C", 0xFCBD: "\u063A\u0645", 0xFCBE: "\u0641\u062C",
+	0xFCBF: "\u0641\u062D", 0xFCC0: "\u0641\u062E", 0xFCC1: "\u0641\u0645", 0xFCC2: "\u0642\u062D",
+	0xFCC3: "\u0642\u0645", 0xFCC4: "\u0643\u062C", 0xFCC5: "\u0643\u062D", 0xFCC6: "\u0643\u062E",
+	0xFCC7: "\u0643\u0644", 0xFCC8: "\u0643\u0645", 0xFCC9: "\u0644\u062C", 0xFCCA: "\u0644\u062D",
+	0xFCCB: "\u0644\u062E", 0xFCCC: "\u0644\u0645", 0xFCCD: "\u0644\u0647", 0xFCCE: "\u0645\u062C",
+	0xFCCF: "\u0645\u062D", 0xFCD0: "\u0645\u062E", 0xFCD1: "\u0645\u0645", 0xFCD2: "\u0646\u062C",
+	0xFCD3: "\u0646\u062D", 0xFCD4: "\u0646\u062E", 0xFCD5: "\u0646\u0645", 0xFCD6: "\u0646\u0647",
+	0xFCD7: "\u0647\u062C", 0xFCD8: "\u0647\u0645", 0xFCD9: "\u0647\u0670", 0xFCDA: "\u064A\u062C",
+	0xFCDB: "\u064A\u062D", 0xFCDC: "\u064A\u062E", 0xFCDD: "\u064A\u0645", 0xFCDE: "\u064A\u0647",
+	0xFCDF: "\u064A\u0654\u0645", 0xFCE0: "\u064A\u0654\u0647", 0xFCE1: "\u0628\u0645", 0xFCE2: "\u0628\u0647",
+	0xFCE3: "\u062A\u0645", 0xFCE4: "\u062A\u0647", 0xFCE5: "\u062B\u0645", 0xFCE6: "\u062B\u0647",
+	0xFCE7: "\u0633\u0645", 0xFCE8: "\u0633\u0647", 0xFCE9: "\u0634\u0645", 0xFCEA: "\u0634\u0647",
+	0xFCEB: "\u0643\u0644", 0xFCEC: "\u0643\u0645", 0xFCED: "\u0644\u0645", 0xFCEE: "\u0646\u0645",
+	0xFCEF: "\u0646\u0647", 0xFCF0: "\u064A\u0645", 0xFCF1: "\u064A\u0647", 0xFCF2: "\u0640\u064E\u0651",
+	0xFCF3: "\u0640\u064F\u0651", 0xFCF4: "\u0640\u0650\u0651", 0xFCF5: "\u0637\u0649", 0xFCF6: "\u0637\u064A",
+	0xFCF7: "\u0639\u0649", 0xFCF8: "\u0639\u064A", 0xFCF9: "\u063A\u0649", 0xFCFA: "\u063A\u064A",
+	0xFCFB: "\u0633\u0649", 0xFCFC: "\u0633\u064A", 0xFCFD: "\u0634\u0649", 0xFCFE: "\u0634\u064A",
+	0xFCFF: "\u062D\u0649", 0xFD00: "\u062D\u064A", 0xFD01: "\u062C\u0649", 0xFD02: "\u062C\u064A",
+	0xFD03: "\u062E\u0649", 0xFD04: "\u062E\u064A", 0xFD05: "\u0635\u0649", 0xFD06: "\u0635\u064A",
+	0xFD07: "\u0636\u0649", 0xFD08: "\u0636\u064A", 0xFD09: "\u0634\u062C", 0xFD0A: "\u0634\u062D",
+	0xFD0B: "\u0634\u062E", 0xFD0C: "\u0634\u0645", 0xFD0D: "\u0634\u0631", 0xFD0E: "\u0633\u0631",
+	0xFD0F: "\u0635\u0631", 0xFD10: "\u0636\u0631", 0xFD11: "\u0637\u0649", 0xFD12: "\u0637\u064A",
+	0xFD13: "\u0639\u0649", 0xFD14: "\u0639\u064A", 0xFD15: "\u063A\u0649", 0xFD16: "\u063A\u064A",
+	0xFD17: "\u0633\u0649", 0xFD18: "\u0633\u064A", 0xFD19: "\u0634\u0649", 0xFD1A: "\u0634\u064A",
+	0xFD1B: "\u062D\u0649", 0xFD1C: "\u062D\u064A", 0xFD1D: "\u062C\u0649", 0xFD1E: "\u062C\u064A",
+	0xFD1F: "\u062E\u0649", 0xFD20: "\u062E\u064A", 0xFD21: "\u0635\u0649", 0xFD22: "\u0635\u064A",
+	0xFD23: "\u0636\u0649", 0xFD24: "\u0636\u064A", 0xFD25: "\u0634\u062C", 0xFD26: "\u0634\u062D",
+	0xFD27: "\u0634\u062E", 0xFD28: "\u0634\u0645", 0xFD29: "\u0634\u0631", 0xFD2A: "\u0633\u0631",
+	0xFD2B: "\u0635\u0631", 0xFD2C: "\u0636\u0631", 0xFD2D: "\u0634\u062C", 0xFD2E: "\u0634\u062D",
+	0xFD2F: "\u0634\u062E", 0xFD30: "\u0634\u0645", 0xFD31: "\u0633\u0647", 0xFD32: "\u0634\u0647",
+	0xFD33: "\u0637\u0645", 0xFD34: "\u0633\u062C", 0xFD35: "\u0633\u062D", 0xFD36: "\u0633\u062E",
+	0xFD37: "\u0634\u062C", 0xFD38: "\u0634\u062D", 0xFD39: "\u0634\u062E", 0xFD3A: "\u0637\u0645",
+	0xFD3B: "\u0638\u0645", 0xFD3C: "\u0627\u064B", 0xFD3D: "\u0627\u064B", 0xFD50: "\u062A\u062C\u0645",
+	0xFD51: "\u062A\u062D\u062C", 0xFD52: "\u062A\u062D\u062C", 0xFD53: "\u062A\u062D\u0645", 0xFD54: "\u062A\u062E\u0645",
+	0xFD55: "\u062A\u0645\u062C", 0xFD56: "\u062A\u0645\u062D", 0xFD57: "\u062A\u0645\u062E", 0xFD58: "\u062C\u0645\u062D",
+	0xFD59: "\u062C\u0645\u062D", 0xFD5A: "\u062D\u0645\u064A", 0xFD5B: "\u062D\u0645\u0649", 0xFD5C: "\u0633\u062D\u062C",
+	0xFD5D: "\u0633\u062C\u062D", 0xFD5E: "\u0633\u062C\u0649", 0xFD5F: "\u0633\u0645\u062D", 0xFD60: "\u0633\u0645\u062D",
+	0xFD61: "\u0633\u0645\u062C", 0xFD62: "\u0633\u0645\u0645", 0xFD63: "\u0633\u0645\u0645", 0xFD64: "\u0635\u062D\u062D",
+	0xFD65: "\u0635\u062D\u062D", 0xFD66: "\u0635\u0645\u0645", 0xFD67: "\u0634\u062D\u0645", 0xFD68: "\u0634\u062D\u0645",
+	0xFD69: "\u0634\u062C\u064A", 0xFD6A: "\u0634\u0645\u062E", 0xFD6B: "\u0634\u0645\u062E", 0xFD6C: "\u0634\u0645\u0645",
+	0xFD6D: "\u0634\u0645\u0645", 0xFD6E: "\u0636\u062D\u0649", 0xFD6F: "\u0636\u062E\u0645", 0xFD70: "\u0636\u062E\u0645",
+	0xFD71: "\u0637\u0645\u062D", 0xFD72: "\u0637\u0645\u062D", 0xFD73: "\u0637\u0645\u0645", 0xFD74: "\u0637\u0645\u064A",
+	0xFD75: "\u0639\u062C\u0645", 0xFD76: "\u0639\u0645\u0645", 0xFD77: "\u0639\u0645\u0645", 0xFD78: "\u0639\u0645\u0649",
+	0xFD79: "\u063A\u0645\u0645", 0xFD7A: "\u063A\u0645\u064A", 0xFD7B: "\u063A\u0645\u0649", 0xFD7C: "\u0641\u062E\u0645",
+	0xFD7D: "\u0641\u062E\u0645", 0xFD7E: "\u0642\u0645\u062D", 0xFD7F: "\u0642\u0645\u0645", 0xFD80: "\u0644\u062D\u0645",
+	0xFD81: "\u0644\u062D\u064A", 0xFD82: "\u0644\u062D\u0649", 0xFD83: "\u0644\u062C\u062C", 0xFD84: "\u0644\u062C\u062C",
+	0xFD85: "\u0644\u062E\u0645", 0xFD86: "\u0644\u062E\u0645", 0xFD87: "\u0644\u0645\u062D", 0xFD88: "\u0644\u0645\u062D",
+	0xFD89: "\u0645\u062D\u062C", 0xFD8A: "\u0645\u062D\u0645", 0xFD8B: "\u0645\u062D\u064A", 0xFD8C: "\u0645\u062C\u062D",
+	0xFD8D: "\u0645\u062C\u0645", 0xFD8E: "\u0645\u062E\u062C", 0xFD8F: "\u0645\u062E\u0645", 0xFD92: "\u0645\u062C\u062E",
+	0xFD93: "\u0647\u0645\u062C", 0xFD94: "\u0647\u0645\u0645", 0xFD95: "\u0646\u062D\u0645", 0xFD96: "\u0646\u062D\u0649",
+	0xFD97: "\u0646\u062C\u0645", 0xFD98: "\u0646\u062C\u0645", 0xFD99: "\u0646\u062C\u0649", 0xFD9A: "\u0646\u0645\u064A",
+	0xFD9B: "\u0646\u0645\u0649", 0xFD9C: "\u064A\u0645\u0645", 0xFD9D: "\u064A\u0645\u0645", 0xFD9E: "\u0628\u062E\u064A",
+	0xFD9F: "\u062A\u062C\u064A", 0xFDA0: "\u062A\u062C\u0649", 0xFDA1: "\u062A\u062E\u064A", 0xFDA2: "\u062A\u062E\u0649",
+	0xFDA3: "\u062A\u0645\u064A", 0xFDA4: "\u062A\u0645\u0649", 0xFDA5: "\u062C\u0645\u064A", 0xFDA6: "\u062C\u062D\u0649",
+	0xFDA7: "\u062C\u0645\u0649", 0xFDA8: "\u0633\u062E\u0649", 0xFDA9: "\u0635\u062D\u064A", 0xFDAA: "\u0634\u062D\u064A",
+	0xFDAB: "\u0636\u062D\u064A", 0xFDAC: "\u0644\u062C\u064A", 0xFDAD: "\u0644\u0645\u064A", 0xFDAE: "\u064A\u062D\u064A",
+	0xFDAF: "\u064A\u062C\u064A", 0xFDB0: "\u064A\u0645\u064A", 0xFDB1: "\u0645\u0645\u064A", 0xFDB2: "\u0642\u0645\u064A",
+	0xFDB3: "\u0646\u062D\u064A", 0xFDB4: "\u0642\u0645\u062D", 0xFDB5: "\u0644\u062D\u0645", 0xFDB6: "\u0639\u0645\u064A",
+	0xFDB7: "\u0643\u0645\u064A", 0xFDB8: "\u0646\u062C\u062D", 0xFDB9: "\u0645\u062E\u064A", 0xFDBA: "\u0644\u062C\u0645",
+	0xFDBB: "\u0643\u0645\u0645", 0xFDBC: "\u0644\u062C\u0645", 0xFDBD: "\u0646\u062C\u062D", 0xFDBE: "\u062C\u062D\u064A",
+	0xFDBF: "\u062D\u062C\u064A", 0xFDC0: "\u0645\u062C\u064A", 0xFDC1: "\u0641\u0645\u064A", 0xFDC2: "\u0628\u062D\u064A",
+	0xFDC3: "\u0643\u0645\u0645", 0xFDC4: "\u0639\u062C\u0645", 0xFDC5: "\u0635\u0645\u0645", 0xFDC6: "\u0633\u062E\u064A",
+	0xFDC7: "\u0646\u062C\u064A", 0xFDF0: "\u0635\u0644\u06D2", 0xFDF1: "\u0642\u0644\u06D2", 0xFDF2: "\u0627\u0644\u0644\u0647",
+	0xFDF3: "\u0627\u0643\u0628\u0631", 0xFDF4: "\u0645\u062D\u0645\u062F", 0xFDF5: "\u0635\u0644\u0639\u0645", 0xFDF6: "\u0631\u0633\u0648\u0644",
+	0xFDF7: "\u0639\u0644\u064A\u0647", 0xFDF8: "\u0648\u0633\u0644\u0645", 0xFDF9: "\u0635\u0644\u0649", 0xFDFA: "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064A\u0647 \u0648\u0633\u0644\u0645",
+	0xFDFB: "\u062C\u0644 \u062C\u0644\u0627\u0644\u0647", 0xFDFC: "\u0631\u06CC\u0627\u0644", 0xFE10: ",", 0xFE11: "\u3001",
+	0xFE12: "\u3002", 0xFE13: ":", 0xFE14: ";", 0xFE15: "!",
+	0xFE16: "?", 0xFE17: "\u3016", 0xFE18: "\u3017", 0xFE19: "...",
+	0xFE30: "..", 0xFE31: "\u2014", 0xFE32: "\u2013", 0xFE33: "_",
+	0xFE34: "_", 0xFE35: "(", 0xFE36: ")", 0xFE37: "{",
+	0xFE38: "}", 0xFE39: "\u3014", 0xFE3A: "\u3015", 0xFE3B: "\u3010",
+	0xFE3C: "\u3011", 0xFE3D: "\u300A", 0xFE3E: "\u300B", 0xFE3F: "\u3008",
+	0xFE40: "\u3009", 0xFE41: "\u300C", 0xFE42: "\u300D", 0xFE43: "\u300E",
+	0xFE44: "\u300F", 0xFE47: "[", 0xFE48: "]", 0xFE49: " \u0305",
+	0xFE4A: " \u0305", 0xFE4B: " \u0305", 0xFE4C: " \u0305", 0xFE4D: "_",
+	0xFE4E: "_", 0xFE4F: "_", 0xFE50: ",", 0xFE51: "\u3001",
+	0xFE52: ".", 0xFE54: ";", 0xFE55: ":", 0xFE56: "?",
+	0xFE57: "!", 0xFE58: "\u2014", 0xFE59: "(", 0xFE5A: ")",
+	0xFE5B: "{", 0xFE5C: "}", 0xFE5D: "\u3014", 0xFE5E: "\u3015",
+	0xFE5F: "#", 0xFE60: "&", 0xFE61: "*", 0xFE62: "+",
+	0xFE63: "-", 0xFE64: "<", 0xFE65: ">", 0xFE66: "=",
+	0xFE68: "\u005C", 0xFE69: "$", 0xFE6A: "%", 0xFE6B: "@",
+	0xFE70: " \u064B", 0xFE71: "\u0640\u064B", 0xFE72: " \u064C", 0xFE74: " \u064D",
+	0xFE76: " \u064E", 0xFE77: "\u0640\u064E", 0xFE78: " \u064F", 0xFE79: "\u0640\u064F",
+	0xFE7A: " \u0650", 0xFE7B: "\u0640\u0650", 0xFE7C: " \u0651", 0xFE7D: "\u0640\u0651",
+	0xFE7E: " \u0652", 0xFE7F: "\u0640\u0652", 0xFE80: "\u0621", 0xFE81: "\u0627\u0653",
+	0xFE82: "\u0627\u0653", 0xFE83: "\u0627\u0654", 0xFE84: "\u0627\u0654", 0xFE85: "\u0648\u0654",
+	0xFE86: "\u0648\u0654", 0xFE87: "\u0627\u0655", 0xFE88: "\u0627\u0655", 0xFE89: "\u064A\u0654",
+	0xFE8A: "\u064A\u0654", 0xFE8B: "\u064A\u0654", 0xFE8C: "\u064A\u0654", 0xFE8D: "\u0627",
+	0xFE8E: "\u0627", 0xFE8F: "\u0628", 0xFE90: "\u0628", 0xFE91: "\u0628",
+	0xFE92: "\u0628", 0xFE93: "\u0629", 0xFE94: "\u0629", 0xFE95: "\u062A",
+	0xFE96: "\u062A", 0xFE97: "\u062A", 0xFE98: "\u062A", 0xFE99: "\u062B",
+	0xFE9A: "\u062B", 0xFE9B: "\u062B", 0xFE9C: "\u062B", 0xFE9D: "\u062C",
+	0xFE9E: "\u062C", 0xFE9F: "\u062C", 0xFEA0: "\u062C", 0xFEA1: "\u062D",
+	0xFEA2: "\u062D", 0xFEA3: "\u062D", 0xFEA4: "\u062D", 0xFEA5: "\u062E",
+	0xFEA6: "\u062E", 0xFEA7: "\u062E", 0xFEA8: "\u062E", 0xFEA9: "\u062F",
+	0xFEAA: "\u062F", 0xFEAB: "\u0630", 0xFEAC: "\u0630", 0xFEAD: "\u0631",
+	0xFEAE: "\u0631", 0xFEAF: "\u0632", 0xFEB0: "\u0632", 0xFEB1: "\u0633",
+	0xFEB2: "\u0633", 0xFEB3: "\u0633", 0xFEB4: "\u0633", 0xFEB5: "\u0634",
+	0xFEB6: "\u0634", 0xFEB7: "\u0634", 0xFEB8: "\u0634", 0xFEB9: "\u0635",
+	0xFEBA: "\u0635", 0xFEBB: "\u0635", 0xFEBC: "\u0635", 0xFEBD: "\u0636",
+	0xFEBE: "\u0636", 0xFEBF: "\u0636", 0xFEC0: "\u0636", 0xFEC1: "\u0637",
+	0xFEC2: "\u0637", 0xFEC3: "\u0637", 0xFEC4: "\u0637", 0xFEC5: "\u0638",
+	0xFEC6: "\u0638", 0xFEC7: "\u0638", 0xFEC8: "\u0638", 0xFEC9: "\u0639",
+	0xFECA: "\u0639", 0xFECB: "\u0639", 0xFECC: "\u0639", 0xFECD: "\u063A",
+	0xFECE: "\u063A", 0xFECF: "\u063A", 0xFED0: "\u063A", 0xFED1: "\u0641",
+	0xFED2: "\u0641", 0xFED3: "\u0641", 0xFED4: "\u0641", 0xFED5: "\u0642",
+	0xFED6: "\u0642", 0xFED7: "\u0642", 0xFED8: "\u0642", 0xFED9: "\u0643",
+	0xFEDA: "\u0643", 0xFEDB: "\u0643", 0xFEDC: "\u0643", 0xFEDD: "\u0644",
+	0xFEDE: "\u0644", 0xFEDF: "\u0644", 0xFEE0: "\u0644", 0xFEE1: "\u0645",
+	0xFEE2: "\u0645", 0xFEE3: "\u0645", 0xFEE4: "\u0645", 0xFEE5: "\u0646",
+	0xFEE6: "\u0646", 0xFEE7: "\u0646", 0xFEE8: "\u0646", 0xFEE9: "\u0647",
+	0xFEEA: "\u0647", 0xFEEB: "\u0647", 0xFEEC: "\u0647", 0xFEED: "\u0648",
+	0xFEEE: "\u0648", 0xFEEF: "\u0649", 0xFEF0: "\u0649", 0xFEF1: "\u064A",
+	0xFEF2: "\u064A", 0xFEF3: "\u064A", 0xFEF4: "\u064A", 0xFEF5: "\u0644\u0627\u0653",
+	0xFEF6: "\u0644\u0627\u0653", 0xFEF7: "\u0644\u0627\u0654", 0xFEF8: "\u0644\u0627\u0654", 0xFEF9: "\u0644\u0627\u0655",
+	0xFEFA: "\u0644\u0627\u0655", 0xFEFB: "\u0644\u0627", 0xFEFC: "\u0644\u0627", 0xFF01: "!",
+	0xFF02: "\u0022", 0xFF03: "#", 0xFF04: "$", 0xFF05: "%",
+	0xFF06: "&", 0xFF07: "'", 0xFF08: "(", 0xFF09: ")",
+	0xFF0A: "*", 0xFF0B: "+", 0xFF0C: ",", 0xFF0D: "-",
+	0xFF0E: ".", 0xFF0F: "/", 0xFF10: "0", 0xFF11: "1",
+	0xFF12: "2", 0xFF13: "3", 0xFF14: "4", 0xFF15: "5",
+	0xFF16: "6", 0xFF17: "7", 0xFF18: "8", 0xFF19: "9",
+	0xFF1A: ":", 0xFF1B: ";", 0xFF1C: "<", 0xFF1D: "=",
+	0xFF1E: ">", 0xFF1F: "?", 0xFF20: "@", 0xFF21: "A",
+	0xFF22: "B", 0xFF23: "C", 0xFF24: "D", 0xFF25: "E",
+	0xFF26: "F", 0xFF27: "G", 0xFF28: "H", 0xFF29: "I",
+	0xFF2A: "J", 0xFF2B: "K", 0xFF2C: "L", 0xFF2D: "M",
+	0xFF2E: "N", 0xFF2F: "O", 0xFF30: "P", 0xFF31: "Q",
+	0xFF32: "R", 0xFF33: "S", 0xFF34: "T", 0xFF35: "U",
+	0xFF36: "V", 0xFF37: "W", 0xFF38: "X", 0xFF39: "Y",
+	0xFF3A: "Z", 0xFF3B: "[", 0xFF3C: "\u005C", 0xFF3D: "]",
+	0xFF3E: "^", 0xFF3F: "_", 0xFF40: "`", 0xFF41: "a",
+	0xFF42: "b", 0xFF43: "c", 0xFF44: "d", 0xFF45: "e",
+	0xFF46: "f", 0xFF47: "g", 0xFF48: "h", 0xFF49: "i",
+	0xFF4A: "j", 0xFF4B: "k", 0xFF4C: "l", 0xFF4D: "m",
+	0xFF4E: "n", 0xFF4F: "o", 0xFF50: "p", 0xFF51: "q",
+	0xFF52: "r", 0xFF53: "s", 0xFF54: "t", 0xFF55: "u",
+	0xFF56: "v", 0xFF57: "w", 0xFF58: "x", 0xFF59: "y",
+	0xFF5A: "z", 0xFF5B: "{", 0xFF5C: "|", 0xFF5D: "}",
+	0xFF5E: "~", 0xFF5F: "\u2985", 0xFF60: "\u2986", 0xFF61: "\u3002",
+	0xFF62: "\u300C", 0xFF63: "\u300D", 0xFF64: "\u3001", 0xFF65: "\u30FB",
+	0xFF66: "\u30F2", 0xFF67: "\u30A1", 0xFF68: "\u30A3", 0xFF69: "\u30A5",
+	0xFF6A: "\u30A7", 0xFF6B: "\u30A9", 0xFF6C: "\u30E3", 0xFF6D: "\u30E5",
+	0xFF6E: "\u30E7", 0xFF6F: "\u30C3", 0xFF70: "\u30FC", 0xFF71: "\u30A2",
+	0xFF72: "\u30A4", 0xFF73: "\u30A6", 0xFF74: "\u30A8", 0xFF75: "\u30AA",
+	0xFF76: "\u30AB", 0xFF77: "\u30AD", 0xFF78: "\u30AF", 0xFF79: "\u30B1",
+	0xFF7A: "\u30B3", 0xFF7B: "\u30B5", 0xFF7C: "\u30B7", 0xFF7D: "\u30B9",
+	0xFF7E: "\u30BB", 0xFF7F: "\u30BD", 0xFF80: "\u30BF", 0xFF81: "\u30C1",
+	0xFF82: "\u30C4", 0xFF83: "\u30C6", 0xFF84: "\u30C8", 0xFF85: "\u30CA",
+	0xFF86: "\u30CB", 0xFF87: "\u30CC", 0xFF88: "\u30CD", 0xFF89: "\u30CE",
+	0xFF8A: "\u30CF", 0xFF8B: "\u30D2", 0xFF8C: "\u30D5", 0xFF8D: "\u30D8",
+	0xFF8E: "\u30DB", 0xFF8F: "\u30DE", 0xFF90: "\u30DF", 0xFF91: "\u30E0",
+	0xFF92: "\u30E1", 0xFF93: "\u30E2", 0xFF94: "\u30E4", 0xFF95: "\u30E6",
+	0xFF96: "\u30E8", 0xFF97: "\u30E9", 0xFF98: "\u30EA", 0xFF99: "\u30EB",
+	0xFF9A: "\u30EC", 0xFF9B: "\u30ED", 0xFF9C: "\u30EF", 0xFF9D: "\u30F3",
+	0xFF9E: "\u3099", 0xFF9F: "\u309A", 0xFFA0: "\u1160", 0xFFA1: "\u1100",
+	0xFFA2: "\u1101", 0xFFA3: "\u11AA", 0xFFA4: "\u1102", 0xFFA5: "\u11AC",
+	0xFFA6: "\u11AD", 0xFFA7: "\u1103", 0xFFA8: "\u1104", 0xFFA9: "\u1105",
+	0xFFAA: "\u11B0", 0xFFAB: "\u11B1", 0xFFAC: "\u11B2", 0xFFAD: "\u11B3",
+	0xFFAE: "\u11B4", 0xFFAF: "\u11B5", 0xFFB0: "\u111A", 0xFFB1: "\u1106",
+	0xFFB2: "\u1107", 0xFFB3: "\u1108", 0xFFB4: "\u1121", 0xFFB5: "\u1109",
+	0xFFB6: "\u110A", 0xFFB7: "\u110B", 0xFFB8: "\u110C", 0xFFB9: "\u110D",
+	0xFFBA: "\u110E", 0xFFBB: "\u110F", 0xFFBC: "\u1110", 0xFFBD: "\u1111",
+	0xFFBE: "\u1112", 0xFFC2: "\u1161", 0xFFC3: "\u1162", 0xFFC4: "\u1163",
+	0xFFC5: "\u1164", 0xFFC6: "\u1165", 0xFFC7: "\u1166", 0xFFCA: "\u1167",
+	0xFFCB: "\u1168", 0xFFCC: "\u1169", 0xFFCD: "\u116A", 0xFFCE: "\u116B",
+	0xFFCF: "\u116C", 0xFFD2: "\u116D", 0xFFD3: "\u116E", 0xFFD4: "\u116F",
+	0xFFD5: "\u1170", 0xFFD6: "\u1171", 0xFFD7: "\u1172", 0xFFDA: "\u1173",
+	0xFFDB: "\u1174", 0xFFDC: "\u1175", 0xFFE0: "\u00A2", 0xFFE1: "\u00A3",
+	0xFFE2: "\u00AC", 0xFFE3: " \u0304", 0xFFE4: "\u00A6", 0xFFE5: "\u00A5",
+	0xFFE6: "\u20A9", 0xFFE8: "\u2502", 0xFFE9: "\u2190", 0xFFEA: "\u2191",
+	0xFFEB: "\u2192", 0xFFEC: "\u2193", 0xFFED: "\u25A0", 0xFFEE: "\u25CB",
+	0x10781: "\u02D0", 0x10782: "\u02D1", 0x10783: "\u00E6", 0x10784: "\u0299",
+	0x10785: "\u0253", 0x10787: "\u02A3", 0x10788: "\uAB66", 0x10789: "\u02A5",
+	0x1078A: "\u02A4", 0x1078B: "\u0256", 0x1078C: "\u0257", 0x1078D: "\u1D91",
+	0x1078E: "\u0258", 0x1078F: "\u025E", 0x10790: "\u02A9", 0x10791: "\u0264",
+	0x10792: "\u0262", 0x10793: "\u0260", 0x10794: "\u029B", 0x10795: "\u0127",
+	0x10796: "\u029C", 0x10797: "\u0267", 0x10798: "\u0284", 0x10799: "\u02AA",
+	0x1079A: "\u02AB", 0x1079B: "\u026C", 0x1079C: "\U0001DF04", 0x1079D: "\uA78E",
+	0x1079E: "\u026E", 0x1079F: "\U0001DF05", 0x107A0: "\u028E", 0x107A1: "\U0001DF06",
+	0x107A2: "\u00F8", 0x107A3: "\u0276", 0x107A4: "\u0277", 0x107A5: "q",
+	0x107A6: "\u027A", 0x107A7: "\U0001DF08", 0x107A8: "\u027D", 0x107A9: "\u027E",
+	0x107AA: "\u0280", 0x107AB: "\u02A8", 0x107AC: "\u02A6", 0x107AD: "\uAB67",
+	0x107AE: "\u02A7", 0x107AF: "\u0288", 0x107B0: "\u2C71", 0x107B2: "\u028F",
+	0x107B3: "\u02A1", 0x107B4: "\u02A2", 0x107B5: "\u0298", 0x107B6: "\u01C0",
+	0x107B7: "\u01C1", 0x107B8: "\u01C2", 0x107B9: "\U0001DF0A", 0x107BA: "\U0001DF1E",
+	0x1CCD6: "A", 0x1CCD7: "B", 0x1CCD8: "C", 0x1CCD9: "D",
+	0x1CCDA: "E", 0x1CCDB: "F", 0x1CCDC: "G", 0x1CCDD: "H",
+	0x1CCDE: "I", 0x1CCDF: "J", 0x1CCE0: "K", 0x1CCE1: "L",
+	0x1CCE2: "M", 0x1CCE3: "N", 0x1CCE4: "O", 0x1CCE5: "P",
+	0x1CCE6: "Q", 0x1CCE7: "R", 0x1CCE8: "S", 0x1CCE9: "T",
+	0x1CCEA: "U", 0x1CCEB: "V", 0x1CCEC: "W", 0x1CCED: "X",
+	0x1CCEE: "Y", 0x1CCEF: "Z", 0x1CCF0: "0", 0x1CCF1: "1",
+	0x1CCF2: "2", 0x1CCF3: "3", 0x1CCF4: "4", 0x1CCF5: "5",
+	0x1CCF6: "6", 0x1CCF7: "7", 0x1CCF8: "8", 0x1CCF9: "9",
+	0x1D400: "A", 0x1D401: "B", 0x1D402: "C", 0x1D403: "D",
+	0x1D404: "E", 0x1D405: "F", 0x1D406: "G", 0x1D407: "H",
+	0x1D408: "I", 0x1D409: "J", 0x1D40A: "K", 0x1D40B: "L",
+	0x1D40C: "M", 0x1D40D: "N", 0x1D40E: "O", 0x1D40F: "P",
+	0x1D410: "Q", 0x1D411: "R", 0x1D412: "S", 0x1D413: "T",
+	0x1D414: "U", 0x1D415: "V", 0x1D416: "W", 0x1D417: "X",
+	0x1D418: "Y", 0x1D419: "Z", 0x1D41A: "a", 0x1D41B: "b",
+	0x1D41C: "c", 0x1D41D: "d", 0x1D41E: "e", 0x1D41F: "f",
+	0x1D420: "g", 0x1D421: "h", 0x1D422: "i", 0x1D423: "j",
+	0x1D424: "k", 0x1D425: "l", 0x1D426: "m", 0x1D427: "n",
+	0x1D428: "o", 0x1D429: "p", 0x1D42A: "q", 0x1D42B: "r",
+	0x1D42C: "s", 0x1D42D: "t", 0x1D42E: "u", 0x1D42F: "v",
+	0x1D430: "w", 0x1D431: "x", 0x1D432: "y", 0x1D433: "z",
+	0x1D434: "A", 0x1D435: "B", 0x1D436: "C", 0x1D437: "D",
+	0x1D438: "E", 0x1D439: "F", 0x1D43A: "G", 0x1D43B: "H",
+	0x1D43C: "I", 0x1D43D: "J", 0x1D43E: "K", 0x1D43F: "L",
+	0x1D440: "M", 0x1D441: "N", 0x1D442: "O", 0x1D443: "P",
+	0x1D444: "Q", 0x1D445: "R", 0x1D446: "S", 0x1D447: "T",
+	0x1D448: "U", 0x1D449: "V", 0x1D44A: "W", 0x1D44B: "X",
+	0x1D44C: "Y", 0x1D44D: "Z", 0x1D44E: "a", 0x1D44F: "b",
+	0x1D450: "c", 0x1D451: "d", 0x1D452: "e", 0x1D453: "f",
+	0x1D454: "g", 0x1D456: "i", 0x1D457: "j", 0x1D458: "k",
+	0x1D459: "l", 0x1D45A: "m", 0x1D45B: "n", 0x1D45C: "o",
+	0x1D45D: "p", 0x1D45E: "q", 0x1D45F: "r", 0x1D460: "s",
+	0x1D461: "t", 0x1D462: "u", 0x1D463: "v", 0x1D464: "w",
+	0x1D465: "x", 0x1D466: "y", 0x1D467: "z", 0x1D468: "A",
+	0x1D469: "B", 0x1D46A: "C", 0x1D46B: "D", 0x1D46C: "E",
+	0x1D46D: "F", 0x1D46E: "G", 0x1D46F: "H", 0x1D470: "I",
+	0x1D471: "J", 0x1D472: "K", 0x1D473: "L", 0x1D474: "M",
+	0x1D475: "N", 0x1D476: "O", 0x1D477: "P", 0x1D478: "Q",
+	0x1D479: "R", 0x1D47A: "S", 0x1D47B: "T", 0x1D47C: "U",
+	0x1D47D: "V", 0x1D47E: "W", 0x1D47F: "X", 0x1D480: "Y",
+	0x1D481: "Z", 0x1D482: "a", 0x1D483: "b", 0x1D484: "c",
+	0x1D485: "d", 0x1D486: "e", 0x1D487: "f", 0x1D488: "g",
+	0x1D489: "h", 0x1D48A: "i", 0x1D48B: "j", 0x1D48C: "k",
+	0x1D48D: "l", 0x1D48E: "m", 0x1D48F: "n", 0x1D490: "o",
+	0x1D491: "p", 0x1D492: "q", 0x1D493: "r", 0x1D494: "s",
+	0x1D495: "t", 0x1D496: "u", 0x1D497: "v", 0x1D498: "w",
+	0x1D499: "x", 0x1D49A: "y", 0x1D49B: "z", 0x1D49C: "A",
+	0x1D49E: "C", 0x1D49F: "D", 0x1D4A2: "G", 0x1D4A5: "J",
+	0x1D4A6: "K", 0x1D4A9: "N", 0x1D4AA: "O", 0x1D4AB: "P",
+	0x1D4AC: "Q", 0x1D4AE: "S", 0x1D4AF: "T", 0x1D4B0: "U",
+	0x1D4B1: "V", 0x1D4B2: "W", 0x1D4B3: "X", 0x1D4B4: "Y",
+	0x1D4B5: "Z", 0x1D4B6: "a", 0x1D4B7: "b", 0x1D4B8: "c",
+	0x1D4B9: "d", 0x1D4BB: "f", 0x1D4BD: "h", 0x1D4BE: "i",
+	0x1D4BF: "j", 0x1D4C0: "k", 0x1D4C1: "l", 0x1D4C2: "m",
+	0x1D4C3: "n", 0x1D4C5: "p", 0x1D4C6: "q", 0x1D4C7: "r",
+	0x1D4C8: "s", 0x1D4C9: "t", 0x1D4CA: "u", 0x1D4CB: "v",
+	0x1D4CC: "w", 0x1D4CD: "x", 0x1D4CE: "y", 0x1D4CF: "z",
+	0x1D4D0: "A", 0x1D4D1: "B", 0x1D4D2: "C", 0x1D4D3: "D",
+	0x1D4D4: "E", 0x1D4D5: "F", 0x1D4D6: "G", 0x1D4D7: "H",
+	0x1D4D8: "I", 0x1D4D9: "J", 0x1D4DA: "K", 0x1D4DB: "L",
+	0x1D4DC: "M", 0x1D4DD: "N", 0x1D4DE: "O", 0x1D4DF: "P",
+	0x1D4E0: "Q", 0x1D4E1: "R", 0x1D4E2: "S", 0x1D4E3: "T",
+	0x1D4E4: "U", 0x1D4E5: "V", 0x1D4E6: "W", 0x1D4E7: "X",
+	0x1D4E8: "Y", 0x1D4E9: "Z", 0x1D4EA: "a", 0x1D4EB: "b",
+	0x1D4EC: "c", 0x1D4ED: "d", 0x1D4EE: "e", 0x1D4EF: "f",
+	0x1D4F0: "g", 0x1D4F1: "h", 0x1D4F2: "i", 0x1D4F3: "j",
+	0x1D4F4: "k", 0x1D4F5: "l", 0x1D4F6: "m", 0x1D4F7: "n",
+	0x1D4F8: "o", 0x1D4F9: "p", 0x1D4FA: "q", 0x1D4FB: "r",
+	0x1D4FC: "s", 0x1D4FD: "t", 0x1D4FE: "u", 0x1D4FF: "v",
+	0x1D500: "w", 0x1D501: "x", 0x1D502: "y", 0x1D503: "z",
+	0x1D504: "A", 0x1D505: "B", 0x1D507: "D", 0x1D508: "E",
+	0x1D509: "F", 0x1D50A: "G", 0x1D50D: "J", 0x1D50E: "K",
+	0x1D50F: "L", 0x1D510: "M", 0x1D511: "N", 0x1D512: "O",
+	0x1D513: "P", 0x1D514: "Q", 0x1D516: "S", 0x1D517: "T",
+	0x1D518: "U", 0x1D519: "V", 0x1D51A: "W", 0x1D51B: "X",
+	0x1D51C: "Y", 0x1D51E: "a", 0x1D51F: "b", 0x1D520: "c",
+	0x1D521: "d", 0x1D522: "e", 0x1D523: "f", 0x1D524: "g",
+	0x1D525: "h", 0x1D526: "i", 0x1D527: "j", 0x1D528: "k",
+	0x1D529: "l", 0x1D52A: "m", 0x1D52B: "n", 0x1D52C: "o",
+	0x1D52D: "p", 0x1D52E: "q", 0x1D52F: "r", 0x1D530: "s",
+	0x1D531: "t", 0x1D532: "u", 0x1D533: "v", 0x1D534: "w",
+	0x1D535: "x", 0x1D536: "y", 0x1D537: "z", 0x1D538: "A",
+	0x1D539: "B", 0x1D53B: "D", 0x1D53C: "E", 0x1D53D: "F",
+	0x1D53E: "G", 0x1D540: "I", 0x1D541: "J", 0x1D542: "K",
+	0x1D543: "L", 0x1D544: "M", 0x1D546: "O", 0x1D54A: "S",
+	0x1D54B: "T", 0x1D54C: "U", 0x1D54D: "V", 0x1D54E: "W",
+	0x1D54F: "X", 0x1D550: "Y", 0x1D552: "a", 0x1D553: "b",
+	0x1D554: "c", 0x1D555: "d", 0x1D556: "e", 0x1D557: "f",
+	0x1D558: "g", 0x1D559: "h", 0x1D55A: "i", 0x1D55B: "j",
+	0x1D55C: "k", 0x1D55D: "l", 0x1D55E: "m", 0x1D55F: "n",
+	0x1D560: "o", 0x1D561: "p", 0x1D562: "q", 0x1D563: "r",
+	0x1D564: "s", 0x1D565: "t", 0x1D566: "u", 0x1D567: "v",
+	0x1D568: "w", 0x1D569: "x", 0x1D56A: "y", 0x1D56B: "z",
+	0x1D56C: "A", 0x1D56D: "B", 0x1D56E: "C", 0x1D56F: "D",
+	0x1D570: "E", 0x1D571: "F", 0x1D572: "G", 0x1D573: "H",
+	0x1D574: "I", 0x1D575: "J", 0x1D576: "K", 0x1D577: "L",
+	0x1D578: "M", 0x1D579: "N", 0x1D57A: "O", 0x1D57B: "P",
+	0x1D57C: "Q", 0x1D57D: "R", 0x1D57E: "S", 0x1D57F: "T",
+	0x1D580: "U", 0x1D581: "V", 0x1D582: "W", 0x1D583: "X",
+	0x1D584: "Y", 0x1D585: "Z", 0x1D586: "a", 0x1D587: "b",
+	0x1D588: "c", 0x1D589: "d", 0x1D58A: "e", 0x1D58B: "f",
+	0x1D58C: "g", 0x1D58D: "h", 0x1D58E: "i", 0x1D58F: "j",
+	0x1D590: "k", 0x1D591: "l", 0x1D592: "m", 0x1D593: "n",
+	0x1D594: "o", 0x1D595: "p", 0x1D596: "q", 0x1D597: "r",
+	0x1D598: "s", 0x1D599: "t", 0x1D59A: "u", 0x1D59B: "v",
+	0x1D59C: "w", 0x1D59D: "x", 0x1D59E: "y", 0x1D59F: "z",
+	0x1D5A0: "A", 0x1D5A1: "B", 0x1D5A2: "C", 0x1D5A3: "D",
+	0x1D5A4: "E", 0x1D5A5: "F", 0x1D5A6: "G", 0x1D5A7: "H",
+	0x1D5A8: "I", 0x1D5A9: "J", 0x1D5AA: "K", 0x1D5AB: "L",
+	0x1D5AC: "M", 0x1D5AD: "N", 0x1D5AE: "O", 0x1D5AF: "P",
+	0x1D5B0: "Q", 0x1D5B1: "R", 0x1D5B2: "S", 0x1D5B3: "T",
+	0x1D5B4: "U", 0x1D5B5: "V", 0x1D5B6: "W", 0x1D5B7: "X",
+	0x1D5B8: "Y", 0x1D5B9: "Z", 0x1D5BA: "a", 0x1D5BB: "b",
+	0x1D5BC: "c", 0x1D5BD: "d", 0x1D5BE: "e", 0x1D5BF: "f",
+	0x1D5C0: "g", 0x1D5C1: "h", 0x1D5C2: "i", 0x1D5C3: "j",
+	0x1D5C4: "k", 0x1D5C5: "l", 0x1D5C6: "m", 0x1D5C7: "n",
+	0x1D5C8: "o", 0x1D5C9: "p", 0x1D5CA: "q", 0x1D5CB: "r",
+	0x1D5CC: "s", 0x1D5CD: "t", 0x1D5CE: "u", 0x1D5CF: "v",
+	0x1D5D0: "w", 0x1D5D1: "x", 0x1D5D2: "y", 0x1D5D3: "z",
+	0x1D5D4: "A", 0x1D5D5: "B", 0x1D5D6: "C", 0x1D5D7: "D",
+	0x1D5D8: "E", 0x1D5D9: "F", 0x1D5DA: "G", 0x1D5DB: "H",
+	0x1D5DC: "I", 0x1D5DD: "J", 0x1D5DE: "K", 0x1D5DF: "L",
+	0x1D5E0: "M", 0x1D5E1: "N", 0x1D5E2: "O", 0x1D5E3: "P",
+	0x1D5E4: "Q", 0x1D5E5: "R", 0x1D5E6: "S", 0x1D5E7: "T",
+	0x1D5E8: "U", 0x1D5E9: "V", 0x1D5EA: "W", 0x1D5EB: "X",
+	0x1D5EC: "Y", 0x1D5ED: "Z", 0x1D5EE: "a", 0x1D5EF: "b",
+	0x1D5F0: "c", 0x1D5F1: "d", 0x1D5F2: "e", 0x1D5F3: "f",
+	0x1D5F4: "g", 0x1D5F5: "h", 0x1D5F6: "i", 0x1D5F7: "j",
+	0x1D5F8: "k", 0x1D5F9: "l", 0x1D5FA: "m", 0x1D5FB: "n",
+	0x1D5FC: "o", 0x1D5FD: "p", 0x1D5FE: "q", 0x1D5FF: "r",
+	0x1D600: "s", 0x1D601: "t", 0x1D602: "u", 0x1D603: "v",
+	0x1D604: "w", 0x1D605: "x", 0x1D606: "y", 0x1D607: "z",
+	0x1D608: "A", 0x1D609: "B", 0x1D60A: "C", 0x1D60B: "D",
+	0x1D60C: "E", 0x1D60D: "F", 0x1D60E: "G", 0x1D60F: "H",
+	0x1D610: "I", 0x1D611: "J", 0x1D612: "K", 0x1D613: "L",
+	0x1D614: "M", 0x1D615: "N", 0x1D616: "O", 0x1D617: "P",
+	0x1D618: "Q", 0x1D619: "R", 0x1D61A: "S", 0x1D61B: "T",
+	0x1D61C: "U", 0x1D61D: "V", 0x1D61E: "W", 0x1D61F: "X",
+	0x1D620: "Y", 0x1D621: "Z", 0x1D622: "a", 0x1D623: "b",
+	0x1D624: "c", 0x1D625: "d", 0x1D626: "e", 0x1D627: "f",
+	0x1D628: "g", 0x1D629: "h", 0x1D62A: "i", 0x1D62B: "j",
+	0x1D62C: "k", 0x1D62D: "l", 0x1D62E: "m", 0x1D62F: "n",
+	0x1D630: "o", 0x1D631: "p", 0x1D632: "q", 0x1D633: "r",
+	0x1D634: "s", 0x1D635: "t", 0x1D636: "u", 0x1D637: "v",
+	0x1D638: "w", 0x1D639: "x", 0x1D63A: "y", 0x1D63B: "z",
+	0x1D63C: "A", 0x1D63D: "B", 0x1D63E: "C", 0x1D63F: "D",
+	0x1D640: "E", 0x1D641: "F", 0x1D642: "G", 0x1D643: "H",
+	0x1D644: "I", 0x1D645: "J", 0x1D646: "K", 0x1D647: "L",
+	0x1D648: "M", 0x1D649: "N", 0x1D64A: "O", 0x1D64B: "P",
+	0x1D64C: "Q", 0x1D64D: "R", 0x1D64E: "S", 0x1D64F: "T",
+	0x1D650: "U", 0x1D651: "V", 0x1D652: "W", 0x1D653: "X",
+	0x1D654: "Y", 0x1D655: "Z", 0x1D656: "a", 0x1D657: "b",
+	0x1D658: "c", 0x1D659: "d", 0x1D65A: "e", 0x1D65B: "f",
+	0x1D65C: "g", 0x1D65D: "h", 0x1D65E: "i", 0x1D65F: "j",
+	0x1D660: "k", 0x1D661: "l", 0x1D662: "m", 0x1D663: "n",
+	0x1D664: "o", 0x1D665: "p", 0x1D666: "q", 0x1D667: "r",
+	0x1D668: "s", 0x1D669: "t", 0x1D66A: "u", 0x1D66B: "v",
+	0x1D66C: "w", 0x1D66D: "x", 0x1D66E: "y", 0x1D66F: "z",
+	0x1D670: "A", 0x1D671: "B", 0x1D672: "C", 0x1D673: "D",
+	0x1D674: "E", 0x1D675: "F", 0x1D676: "G", 0x1D677: "H",
+	0x1D678: "I", 0x1D679: "J", 0x1D67A: "K", 0x1D67B: "L",
+	0x1D67C: "M", 0x1D67D: "N", 0x1D67E: "O", 0x1D67F: "P",
+	0x1D680: "Q", 0x1D681: "R", 0x1D682: "S", 0x1D683: "T",
+	0x1D684: "U", 0x1D685: "V", 0x1D686: "W", 0x1D687: "X",
+	0x1D688: "Y", 0x1D689: "Z", 0x1D68A: "a", 0x1D68B: "b",
+	0x1D68C: "c", 0x1D68D: "d", 0x1D68E: "e", 0x1D68F: "f",
+	0x1D690: "g", 0x1D691: "h", 0x1D692: "i", 0x1D693: "j",
+	0x1D694: "k", 0x1D695: "l", 0x1D696: "m", 0x1D697: "n",
+	0x1D698: "o", 0x1D699: "p", 0x1D69A: "q", 0x1D69B: "r",
+	0x1D69C: "s", 0x1D69D: "t", 0x1D69E: "u", 0x1D69F: "v",
+	0x1D6A0: "w", 0x1D6A1: "x", 0x1D6A2: "y", 0x1D6A3: "z",
+	0x1D6A4: "\u0131", 0x1D6A5: "\u0237", 0x1D6A8: "\u0391", 0x1D6A9: "\u0392",
+	0x1D6AA: "\u0393", 0x1D6AB: "\u0394", 0x1D6AC: "\u0395", 0x1D6AD: "\u0396",
+	0x1D6AE: "\u0397", 0x1D6AF: "\u0398", 0x1D6B0: "\u0399", 0x1D6B1: "\u039A",
+	0x1D6B2: "\u039B", 0x1D6B3: "\u039C", 0x1D6B4: "\u039D", 0x1D6B5: "\u039E",
+	0x1D6B6: "\u039F", 0x1D6B7: "\u03A0", 0x1D6B8: "\u03A1", 0x1D6B9: "\u0398",
+	0x1D6BA: "\u03A3", 0x1D6BB: "\u03A4", 0x1D6BC: "\u03A5", 0x1D6BD: "\u03A6",
+	0x1D6BE: "\u03A7", 0x1D6BF: "\u03A8", 0x1D6C0: "\u03A9", 0x1D6C1: "\u2207",
+	0x1D6C2: "\u03B1", 0x1D6C3: "\u03B2", 0x1D6C4: "\u03B3", 0x1D6C5: "\u03B4",
+	0x1D6C6: "\u03B5", 0x1D6C7: "\u03B6", 0x1D6C8: "\u03B7", 0x1D6C9: "\u03B8",
+	0x1D6CA: "\u03B9", 0x1D6CB: "\u03BA", 0x1D6CC: "\u03BB", 0x1D6CD: "\u03BC",
+	0x1D6CE: "\u03BD", 0x1D6CF: "\u03BE", 0x1D6D0: "\u03BF", 0x1D6D1: "\u03C0",
+	0x1D6D2: "\u03C1", 0x1D6D3: "\u03C2", 0x1D6D4: "\u03C3", 0x1D6D5: "\u03C4",
+	0x1D6D6: "\u03C5", 0x1D6D7: "\u03C6", 0x1D6D8: "\u03C7", 0x1D6D9: "\u03C8",
+	0x1D6DA: "\u03C9", 0x1D6DB: "\u2202", 0x1D6DC: "\u03B5", 0x1D6DD: "\u03B8",
+	0x1D6DE: "\u03BA", 0x1D6DF: "\u03C6", 0x1D6E0: "\u03C1", 0x1D6E1: "\u03C0",
+	0x1D6E2: "\u0391", 0x1D6E3: "\u0392", 0x1D6E4: "\u0393", 0x1D6E5: "\u0394",
+	0x1D6E6: "\u0395", 0x1D6E7: "\u0396", 0x1D6E8: "\u0397", 0x1D6E9: "\u0398",
+	0x1D6EA: "\u0399", 0x1D6EB: "\u039A", 0x1D6EC: "\u039B", 0x1D6ED: "\u039C",
+	0x1D6EE: "\u039D", 0x1D6EF: "\u039E", 0x1D6F0: "\u039F", 0x1D6F1: "\u03A0",
+	0x1D6F2: "\u03A1", 0x1D6F3: "\u0398", 0x1D6F4: "\u03A3", 0x1D6F5: "\u03A4",
+	0x1D6F6: "\u03A5", 0x1D6F7: "\u03A6", 0x1D6F8: "\u03A7", 0x1D6F9: "\u03A8",
+	0x1D6FA: "\u03A9", 0x1D6FB: "\u2207", 0x1D6FC: "\u03B1", 0x1D6FD: "\u03B2",
+	0x1D6FE: "\u03B3", 0x1D6FF: "\u03B4", 0x1D700: "\u03B5", 0x1D701: "\u03B6",
+	0x1D702: "\u03B7", 0x1D703: "\u03B8", 0x1D704: "\u03B9", 0x1D705: "\u03BA",
+	0x1D706: "\u03BB", 0x1D707: "\u03BC", 0x1D708: "\u03BD", 0x1D709: "\u03BE",
+	0x1D70A: "\u03BF", 0x1D70B: "\u03C0", 0x1D70C: "\u03C1", 0x1D70D: "\u03C2",
+	0x1D70E: "\u03C3", 0x1D70F: "\u03C4", 0x1D710: "\u03C5", 0x1D711: "\u03C6",
+	0x1D712: "\u03C7", 0x1D713: "\u03C8", 0x1D714: "\u03C9", 0x1D715: "\u2202",
+	0x1D716: "\u03B5", 0x1D717: "\u03B8", 0x1D718: "\u03BA", 0x1D719: "\u03C6",
+	0x1D71A: "\u03C1", 0x1D71B: "\u03C0", 0x1D71C: "\u0391", 0x1D71D: "\u0392",
+	0x1D71E: "\u0393", 0x1D71F: "\u0394", 0x1D720: "\u0395", 0x1D721: "\u0396",
+	0x1D722: "\u0397", 0x1D723: "\u0398", 0x1D724: "\u0399", 0x1D725: "\u039A",
+	0x1D726: "\u039B", 0x1D727: "\u039C", 0x1D728: "\u039D", 0x1D729: "\u039E",
+	0x1D72A: "\u039F", 0x1D72B: "\u03A0", 0x1D72C: "\u03A1", 0x1D72D: "\u0398",
+	0x1D72E: "\u03A3", 0x1D72F: "\u03A4", 0x1D730: "\u03A5", 0x1D731: "\u03A6",
+	0x1D732: "\u03A7", 0x1D733: "\u03A8", 0x1D734: "\u03A9", 0x1D735: "\u2207",
+	0x1D736: "\u03B1", 0x1D737: "\u03B2", 0x1D738: "\u03B3", 0x1D739: "\u03B4",
+	0x1D73A: "\u03B5", 0x1D73B: "\u03B6", 0x1D73C: "\u03B7", 0x1D73D: "\u03B8",
+	0x1D73E: "\u03B9", 0x1D73F: "\u03BA", 0x1D740: "\u03BB", 0x1D741: "\u03BC",
+	0x1D742: "\u03BD", 0x1D743: "\u03BE", 0x1D744: "\u03BF", 0x1D745: "\u03C0",
+	0x1D746: "\u03C1", 0x1D747: "\u03C2", 0x1D748: "\u03C3", 0x1D749: "\u03C4",
+	0x1D74A: "\u03C5", 0x1D74B: "\u03C6", 0x1D74C: "\u03C7", 0x1D74D: "\u03C8",
+	0x1D74E: "\u03C9", 0x1D74F: "\u2202", 0x1D750: "\u03B5", 0x1D751: "\u03B8",
+	0x1D752: "\u03BA", 0x1D753: "\u03C6", 0x1D754: "\u03C1", 0x1D755: "\u03C0",
+	0x1D756: "\u0391", 0x1D757: "\u0392", 0x1D758: "\u0393", 0x1D759: "\u0394",
+	0x1D75A: "\u0395", 0x1D75B: "\u0396", 0x1D75C: "\u0397", 0x1D75D: "\u0398",
+	0x1D75E: "\u0399", 0x1D75F: "\u039A", 0x1D760: "\u039B", 0x1D761: "\u039C",
+	0x1D762: "\u039D", 0x1D763: "\u039E", 0x1D764: "\u039F", 0x1D765: "\u03A0",
+	0x1D766: "\u03A1", 0x1D767: "\u0398", 0x1D768: "\u03A3", 0x1D769: "\u03A4",
+	0x1D76A: "\u03A5", 0x1D76B: "\u03A6", 0x1D76C: "\u03A7", 0x1D76D: "\u03A8",
+	0x1D76E: "\u03A9", 0x1D76F: "\u2207", 0x1D770: "\u03B1", 0x1D771: "\u03B2",
+	0x1D772: "\u03B3", 0x1D773: "\u03B4", 0x1D774: "\u03B5", 0x1D775: "\u03B6",
+	0x1D776: "\u03B7", 0x1D777: "\u03B8", 0x1D778: "\u03B9", 0x1D779: "\u03BA",
+	0x1D77A: "\u03BB", 0x1D77B: "\u03BC", 0x1D77C: "\u03BD", 0x1D77D: "\u03BE",
+	0x1D77E: "\u03BF", 0x1D77F: "\u03C0", 0x1D780: "\u03C1", 0x1D781: "\u03C2",
+	0x1D782: "\u03C3", 0x1D783: "\u03C4", 0x1D784: "\u03C5", 0x1D785: "\u03C6",
+	0x1D786: "\u03C7", 0x1D787: "\u03C8", 0x1D788: "\u03C9", 0x1D789: "\u2202",
+	0x1D78A: "\u03B5", 0x1D78B: "\u03B8", 0x1D78C: "\u03BA", 0x1D78D: "\u03C6",
+	0x1D78E: "\u03C1", 0x1D78F: "\u03C0", 0x1D790: "\u0391", 0x1D791: "\u0392",
+	0x1D792: "\u0393", 0x1D793: "\u0394", 0x1D794: "\u0395", 0x1D795: "\u0396",
+	0x1D796: "\u0397", 0x1D797: "\u0398", 0x1D798: "\u0399", 0x1D799: "\u039A",
+	0x1D79A: "\u039B", 0x1D79B: "\u039C", 0x1D79C: "\u039D", 0x1D79D: "\u039E",
+	0x1D79E: "\u039F", 0x1D79F: "\u03A0", 0x1D7A0: "\u03A1", 0x1D7A1: "\u0398",
+	0x1D7A2: "\u03A3", 0x1D7A3: "\u03A4", 0x1D7A4: "\u03A5", 0x1D7A5: "\u03A6",
+	0x1D7A6: "\u03A7", 0x1D7A7: "\u03A8", 0x1D7A8: "\u03A9", 0x1D7A9: "\u2207",
+	0x1D7AA: "\u03B1", 0x1D7AB: "\u03B2", 0x1D7AC: "\u03B3", 0x1D7AD: "\u03B4",
+	0x1D7AE: "\u03B5", 0x1D7AF: "\u03B6", 0x1D7B0: "\u03B7", 0x1D7B1: "\u03B8",
+	0x1D7B2: "\u03B9", 0x1D7B3: "\u03BA", 0x1D7B4: "\u03BB", 0x1D7B5: "\u03BC",
+	0x1D7B6: "\u03BD", 0x1D7B7: "\u03BE", 0x1D7B8: "\u03BF", 0x1D7B9: "\u03C0",
+	0x1D7BA: "\u03C1", 0x1D7BB: "\u03C2", 0x1D7BC: "\u03C3", 0x1D7BD: "\u03C4",
+	0x1D7BE: "\u03C5", 0x1D7BF: "\u03C6", 0x1D7C0: "\u03C7", 0x1D7C1: "\u03C8",
+	0x1D7C2: "\u03C9", 0x1D7C3: "\u2202", 0x1D7C4: "\u03B5", 0x1D7C5: "\u03B8",
+	0x1D7C6: "\u03BA", 0x1D7C7: "\u03C6", 0x1D7C8: "\u03C1", 0x1D7C9: "\u03C0",
+	0x1D7CA: "\u03DC", 0x1D7CB: "\u03DD", 0x1D7CE: "0", 0x1D7CF: "1",
+	0x1D7D0: "2", 0x1D7D1: "3", 0x1D7D2: "4", 0x1D7D3: "5",
+	0x1D7D4: "6", 0x1D7D5: "7", 0x1D7D6: "8", 0x1D7D7: "9",
+	0x1D7D8: "0", 0x1D7D9: "1", 0x1D7DA: "2", 0x1D7DB: "3",
+	0x1D7DC: "4", 0x1D7DD: "5", 0x1D7DE: "6", 0x1D7DF: "7",
+	0x1D7E0: "8", 0x1D7E1: "9", 0x1D7E2: "0", 0x1D7E3: "1",
+	0x1D7E4: "2", 0x1D7E5: "3", 0x1D7E6: "4", 0x1D7E7: "5",
+	0x1D7E8: "6", 0x1D7E9: "7", 0x1D7EA: "8", 0x1D7EB: "9",
+	0x1D7EC: "0", 0x1D7ED: "1", 0x1D7EE: "2", 0x1D7EF: "3",
+	0x1D7F0: "4", 0x1D7F1: "5", 0x1D7F2: "6", 0x1D7F3: "7",
+	0x1D7F4: "8", 0x1D7F5: "9", 0x1D7F6: "0", 0x1D7F7: "1",
+	0x1D7F8: "2", 0x1D7F9: "3", 0x1D7FA: "4", 0x1D7FB: "5",
+	0x1D7FC: "6", 0x1D7FD: "7", 0x1D7FE: "8", 0x1D7FF: "9",
+	0x1E030: "\u0430", 0x1E031: "\u0431", 0x1E032: "\u0432", 0x1E033: "\u0433",
+	0x1E034: "\u0434", 0x1E035: "\u0435", 0x1E036: "\u0436", 0x1E037: "\u0437",
+	0x1E038: "\u0438", 0x1E039: "\u043A", 0x1E03A: "\u043B", 0x1E03B: "\u043C",
+	0x1E03C: "\u043E", 0x1E03D: "\u043F", 0x1E03E: "\u0440", 0x1E03F: "\u0441",
+	0x1E040: "\u0442", 0x1E041: "\u0443", 0x1E042: "\u0444", 0x1E043: "\u0445",
+	0x1E044: "\u0446", 0x1E045: "\u0447", 0x1E046: "\u0448", 0x1E047: "\u044B",
+	0x1E048: "\u044D", 0x1E049: "\u044E", 0x1E04A: "\uA689", 0x1E04B: "\u04D9",
+	0x1E04C: "\u0456", 0x1E04D: "\u0458", 0x1E04E: "\u04E9", 0x1E04F: "\u04AF",
+	0x1E050: "\u04CF", 0x1E051: "\u0430", 0x1E052: "\u0431", 0x1E053: "\u0432",
+	0x1E054: "\u0433", 0x1E055: "\u0434", 0x1E056: "\u0435", 0x1E057: "\u0436",
+	0x1E058: "\u0437", 0x1E059: "\u0438", 0x1E05A: "\u043A", 0x1E05B: "\u043B",
+	0x1E05C: "\u043E", 0x1E05D: "\u043F", 0x1E05E: "\u0441", 0x1E05F: "\u0443",
+	0x1E060: "\u0444", 0x1E061: "\u0445", 0x1E062: "\u0446", 0x1E063: "\u0447",
+	0x1E064: "\u0448", 0x1E065: "\u044A", 0x1E066: "\u044B", 0x1E067: "\u0491",
+	0x1E068: "\u0456", 0x1E069: "\u0455", 0x1E06A: "\u045F", 0x1E06B: "\u04AB",
+	0x1E06C: "\uA651", 0x1E06D: "\u04B1", 0x1EE00: "\u0627", 0x1EE01: "\u0628",
+	0x1EE02: "\u062C", 0x1EE03: "\u062F", 0x1EE05: "\u0648", 0x1EE06: "\u0632",
+	0x1EE07: "\u062D", 0x1EE08: "\u0637", 0x1EE09: "\u064A", 0x1EE0A: "\u0643",
+	0x1EE0B: "\u0644", 0x1EE0C: "\u0645", 0x1EE0D: "\u0646", 0x1EE0E: "\u0633",
+	0x1EE0F: "\u0639", 0x1EE10: "\u0641", 0x1EE11: "\u0635", 0x1EE12: "\u0642",
+	0x1EE13: "\u0631", 0x1EE14: "\u0634", 0x1EE15: "\u062A", 0x1EE16: "\u062B",
+	0x1EE17: "\u062E", 0x1EE18: "\u0630", 0x1EE19: "\u0636", 0x1EE1A: "\u0638",
+	0x1EE1B: "\u063A", 0x1EE1C: "\u066E", 0x1EE1D: "\u06BA", 0x1EE1E: "\u06A1",
+	0x1EE1F: "\u066F", 0x1EE21: "\u0628", 0x1EE22: "\u062C", 0x1EE24: "\u0647",
+	0x1EE27: "\u062D", 0x1EE29: "\u064A", 0x1EE2A: "\u0643", 0x1EE2B: "\u0644",
+	0x1EE2C: "\u0645", 0x1EE2D: "\u0646", 0x1EE2E: "\u0633", 0x1EE2F: "\u0639",
+	0x1EE30: "\u0641", 0x1EE31: "\u0635", 0x1EE32: "\u0642", 0x1EE34: "\u0634",
+	0x1EE35: "\u062A", 0x1EE36: "\u062B", 0x1EE37: "\u062E", 0x1EE39: "\u0636",
+	0x1EE3B: "\u063A", 0x1EE42: "\u062C", 0x1EE47: "\u062D", 0x1EE49: "\u064A",
+	0x1EE4B: "\u0644", 0x1EE4D: "\u0646", 0x1EE4E: "\u0633", 0x1EE4F: "\u0639",
+	0x1EE51: "\u0635", 0x1EE52: "\u0642", 0x1EE54: "\u0634", 0x1EE57: "\u062E",
+	0x1EE59: "\u0636", 0x1EE5B: "\u063A", 0x1EE5D: "\u06BA", 0x1EE5F: "\u066F",
+	0x1EE61: "\u0628", 0x1EE62: "\u062C", 0x1EE64: "\u0647", 0x1EE67: "\u062D",
+	0x1EE68: "\u0637", 0x1EE69: "\u064A", 0x1EE6A: "\u0643", 0x1EE6C: "\u0645",
+	0x1EE6D: "\u0646", 0x1EE6E: "\u0633", 0x1EE6F: "\u0639", 0x1EE70: "\u0641",
+	0x1EE71: "\u0635", 0x1EE72: "\u0642", 0x1EE74: "\u0634", 0x1EE75: "\u062A",
+	0x1EE76: "\u062B", 0x1EE77: "\u062E", 0x1EE79: "\u0636", 0x1EE7A: "\u0638",
+	0x1EE7B: "\u063A", 0x1EE7C: "\u066E", 0x1EE7E: "\u06A1", 0x1EE80: "\u0627",
+	0x1EE81: "\u0628", 0x1EE82: "\u062C", 0x1EE83: "\u062F", 0x1EE84: "\u0647",
+	0x1EE85: "\u0648", 0x1EE86: "\u0632", 0x1EE87: "\u062D", 0x1EE88: "\u0637",
+	0x1EE89: "\u064A", 0x1EE8B: "\u0644", 0x1EE8C: "\u0645", 0x1EE8D: "\u0646",
+	0x1EE8E: "\u0633", 0x1EE8F: "\u0639", 0x1EE90: "\u0641", 0x1EE91: "\u0635",
+	0x1EE92: "\u0642", 0x1EE93: "\u0631", 0x1EE94: "\u0634", 0x1EE95: "\u062A",
+	0x1EE96: "\u062B", 0x1EE97: "\u062E", 0x1EE98: "\u0630", 0x1EE99: "\u0636",
+	0x1EE9A: "\u0638", 0x1EE9B: "\u063A", 0x1EEA1: "\u0628", 0x1EEA2: "\u062C",
+	0x1EEA3: "\u062F", 0x1EEA5: "\u0648", 0x1EEA6: "\u0632", 0x1EEA7: "\u062D",
+	0x1EEA8: "\u0637", 0x1EEA9: "\u064A", 0x1EEAB: "\u0644", 0x1EEAC: "\u0645",
+	0x1EEAD: "\u0646", 0x1EEAE: "\u0633", 0x1EEAF: "\u0639", 0x1EEB0: "\u0641",
+	0x1EEB1: "\u0635", 0x1EEB2: "\u0642", 0x1EEB3: "\u0631", 0x1EEB4: "\u0634",
+	0x1EEB5: "\u062A", 0x1EEB6: "\u062B", 0x1EEB7: "\u062E", 0x1EEB8: "\u0630",
+	0x1EEB9: "\u0636", 0x1EEBA: "\u0638", 0x1EEBB: "\u063A", 0x1F100: "0.",
+	0x1F101: "0,", 0x1F102: "1,", 0x1F103: "2,", 0x1F104: "3,",
+	0x1F105: "4,", 0x1F106: "5,", 0x1F107: "6,", 0x1F108: "7,",
+	0x1F109: "8,", 0x1F10A: "9,", 0x1F110: "(A)", 0x1F111: "(B)",
+	0x1F112: "(C)", 0x1F113: "(D)", 0x1F114: "(E)", 0x1F115: "(F)",
+	0x1F116: "(G)", 0x1F117: "(H)", 0x1F118: "(I)", 0x1F119: "(J)",
+	0x1F11A: "(K)", 0x1F11B: "(L)", 0x1F11C: "(M)", 0x1F11D: "(N)",
+	0x1F11E: "(O)", 0x1F11F: "(P)", 0x1F120: "(Q)", 0x1F121: "(R)",
+	0x1F122: "(S)", 0x1F123: "(T)", 0x1F124: "(U)", 0x1F125: "(V)",
+	0x1F126: "(W)", 0x1F127: "(X)", 0x1F128: "(Y)", 0x1F129: "(Z)",
+	0x1F12A: "\u3014S\u3015", 0x1F12B: "C", 0x1F12C: "R", 0x1F12D: "CD",
+	0x1F12E: "WZ", 0x1F130: "A", 0x1F131: "B", 0x1F132: "C",
+	0x1F133: "D", 0x1F134: "E", 0x1F135: "F", 0x1F136: "G",
+	0x1F137: "H", 0x1F138: "I", 0x1F139: "J", 0x1F13A: "K",
+	0x1F13B: "L", 0x1F13C: "M", 0x1F13D: "N", 0x1F13E: "O",
+	0x1F13F: "P", 0x1F140: "Q", 0x1F141: "R", 0x1F142: "S",
+	0x1F143: "T", 0x1F144: "U", 0x1F145: "V", 0x1F146: "W",
+	0x1F147: "X", 0x1F148: "Y", 0x1F149: "Z", 0x1F14A: "HV",
+	0x1F14B: "MV", 0x1F14C: "SD", 0x1F14D: "SS", 0x1F14E: "PPV",
+	0x1F14F: "WC", 0x1F16A: "MC", 0x1F16B: "MD", 0x1F16C: "MR",
+	0x1F190: "DJ", 0x1F200: "\u307B\u304B", 0x1F201: "\u30B3\u30B3", 0x1F202: "\u30B5",
+	0x1F210: "\u624B", 0x1F211: "\u5B57", 0x1F212: "\u53CC", 0x1F213: "\u30C6\u3099",
+	0x1F214: "\u4E8C", 0x1F215: "\u591A", 0x1F216: "\u89E3", 0x1F217: "\u5929",
+	0x1F218: "\u4EA4", 0x1F219: "\u6620", 0x1F21A: "\u7121", 0x1F21B: "\u6599",
+	0x1F21C: "\u524D", 0x1F21D: "\u5F8C", 0x1F21E: "\u518D", 0x1F21F: "\u65B0",
+	0x1F220: "\u521D", 0x1F221: "\u7D42", 0x1F222: "\u751F", 0x1F223: "\u8CA9",
+	0x1F224: "\u58F0", 0x1F225: "\u5439", 0x1F226: "\u6F14", 0x1F227: "\u6295",
+	0x1F228: "\u6355", 0x1F229: "\u4E00", 0x1F22A: "\u4E09", 0x1F22B: "\u904A",
+	0x1F22C: "\u5DE6", 0x1F22D: "\u4E2D", 0x1F22E: "\u53F3", 0x1F22F: "\u6307",
+	0x1F230: "\u8D70", 0x1F231: "\u6253", 0x1F232: "\u7981", 0x1F233: "\u7A7A",
+	0x1F234: "\u5408", 0x1F235: "\u6E80", 0x1F236: "\u6709", 0x1F237: "\u6708",
+	0x1F238: "\u7533", 0x1F239: "\u5272", 0x1F23A: "\u55B6", 0x1F23B: "\u914D",
+	0x1F240: "\u3014\u672C\u3015", 0x1F241: "\u3014\u4E09\u3015", 0x1F242: "\u3014\u4E8C\u3015", 0x1F243: "\u3014\u5B89\u3015",
+	0x1F244: "\u3014\u70B9\u3015", 0x1F245: "\u3014\u6253\u3015", 0x1F246: "\u3014\u76D7\u3015", 0x1F247: "\u3014\u52DD\u3015",
+	0x1F248: "\u3014\u6557\u3015", 0x1F250: "\u5F97", 0x1F251: "\u53EF", 0x1FBF0: "0",
+	0x1FBF1: "1", 0x1FBF2: "2", 0x1FBF3: "3", 0x1FBF4: "4",
+	0x1FBF5: "5", 0x1FBF6: "6", 0x1FBF7: "7", 0x1FBF8: "8",
+	0x1FBF9: "9",
+}
+
+// compositions holds the primary composites of the pairs of characters they canonically decompose to.
+var compositions = map[[2]rune]rune{
+	{0x0041, 0x0300}: 0x00C0, {0x0041, 0x0301}: 0x00C1, {0x0041, 0x0302}: 0x00C2,
+	{0x0041, 0x0303}: 0x00C3, {0x0041, 0x0308}: 0x00C4, {0x0041, 0x030A}: 0x00C5,
+	{0x0043, 0x0327}: 0x00C7, {0x0045, 0x0300}: 0x00C8, {0x0045, 0x0301}: 0x00C9,
+	{0x0045, 0x0302}: 0x00CA, {0x0045, 0x0308}: 0x00CB, {0x0049, 0x0300}: 0x00CC,
+	{0x0049, 0x0301}: 0x00CD, {0x0049, 0x0302}: 0x00CE, {0x0049, 0x0308}: 0x00CF,
+	{0x004E, 0x0303}: 0x00D1, {0x004F, 0x0300}: 0x00D2, {0x004F, 0x0301}: 0x00D3,
+	{0x004F, 0x0302}: 0x00D4, {0x004F, 0x0303}: 0x00D5, {0x004F, 0x0308}: 0x00D6,
+	{0x0055, 0x0300}: 0x00D9, {0x0055, 0x0301}: 0x00DA, {0x0055, 0x0302}: 0x00DB,
+	{0x0055, 0x0308}: 0x00DC, {0x0059, 0x0301}: 0x00DD, {0x0061, 0x0300}: 0x00E0,
+	{0x0061, 0x0301}: 0x00E1, {0x0061, 0x0302}: 0x00E2, {0x0061, 0x0303}: 0x00E3,
+	{0x0061, 0x0308}: 0x00E4, {0x0061, 0x030A}: 0x00E5, {0x0063, 0x0327}: 0x00E7,
+	{0x0065, 0x0300}: 0x00E8, {0x0065, 0x0301}: 0x00E9, {0x0065, 0x0302}: 0x00EA,
+	{0x0065, 0x0308}: 0x00EB, {0x0069, 0x0300}: 0x00EC, {0x0069, 0x0301}: 0x00ED,
+	{0x0069, 0x0302}: 0x00EE, {0x0069, 0x0308}: 0x00EF, {0x006E, 0x0303}: 0x00F1,
+	{0x006F, 0x0300}: 0x00F2, {0x006F, 0x0301}: 0x00F3, {0x006F, 0x0302}: 0x00F4,
+	{0x006F, 0x0303}: 0x00F5, {0x006F, 0x0308}: 0x00F6, {0x0075, 0x0300}: 0x00F9,
+	{0x0075, 0x0301}: 0x00FA, {0x0075, 0x0302}: 0x00FB, {0x0075, 0x0308}: 0x00FC,
+	{0x0079, 0x0301}: 0x00FD, {0x0079, 0x0308}: 0x00FF, {0x0041, 0x0304}: 0x0100,
+	{0x0061, 0x0304}: 0x0101, {0x0041, 0x0306}: 0x0102, {0x0061, 0x0306}: 0x0103,
+	{0x0041, 0x0328}: 0x0104, {0x0061, 0x0328}: 0x0105, {0x0043, 0x0301}: 0x0106,
+	{0x0063, 0x0301}: 0x0107, {0x0043, 0x0302}: 0x0108, {0x0063, 0x0302}: 0x0109,
+	{0x0043, 0x0307}: 0x010A, {0x0063, 0x0307}: 0x010B, {0x0043, 0x030C}: 0x010C,
+	{0x0063, 0x030C}: 0x010D, {0x0044, 0x030C}: 0x010E, {0x0064, 0x030C}: 0x010F,
+	{0x0045, 0x0304}: 0x0112, {0x0065, 0x0304}: 0x0113, {0x0045, 0x0306}: 0x0114,
+	{0x0065, 0x0306}: 0x0115, {0x0045, 0x0307}: 0x0116, {0x0065, 0x0307}: 0x0117,
+	{0x0045, 0x0328}: 0x0118, {0x0065, 0x0328}: 0x0119, {0x0045, 0x030C}: 0x011A,
+	{0x0065, 0x030C}: 0x011B, {0x0047, 0x0302}: 0x011C, {0x0067, 0x0302}: 0x011D,
+	{0x0047, 0x0306}: 0x011E, {0x0067, 0x0306}: 0x011F, {0x0047, 0x0307}: 0x0120,
+	{0x0067, 0x0307}: 0x0121, {0x0047, 0x0327}: 0x0122, {0x0067, 0x0327}: 0x0123,
+	{0x0048, 0x0302}: 0x0124, {0x0068, 0x0302}: 0x0125, {0x0049, 0x0303}: 0x0128,
+	{0x0069, 0x0303}: 0x0129, {0x0049, 0x0304}: 0x012A, {0x0069, 0x0304}: 0x012B,
+	{0x0049, 0x0306}: 0x012C, {0x0069, 0x0306}: 0x012D, {0x0049, 0x0328}: 0x012E,
+	{0x0069, 0x0328}: 0x012F, {0x0049, 0x0307}: 0x0130, {0x004A, 0x0302}: 0x0134,
+	{0x006A, 0x0302}: 0x0135, {0x004B, 0x0327}: 0x0136, {0x006B, 0x0327}: 0x0137,
+	{0x004C, 0x0301}: 0x0139, {0x006C, 0x0301}: 0x013A, {0x004C, 0x0327}: 0x013B,
+	{0x006C, 0x0327}: 0x013C, {0x004C, 0x030C}: 0x013D, {0x006C, 0x030C}: 0x013E,
+	{0x004E, 0x0301}: 0x0143, {0x006E, 0x0301}: 0x0144, {0x004E, 0x0327}: 0x0145,
+	{0x006E, 0x0327}: 0x0146, {0x004E, 0x030C}: 0x0147, {0x006E, 0x030C}: 0x0148,
+	{0x004F, 0x0304}: 0x014C, {0x006F, 0x0304}: 0x014D, {0x004F, 0x0306}: 0x014E,
+	{0x006F, 0x0306}: 0x014F, {0x004F, 0x030B}: 0x0150, {0x006F, 0x030B}: 0x0151,
+	{0x0052, 0x0301}: 0x0154, {0x0072, 0x0301}: 0x0155, {0x0052, 0x0327}: 0x0156,
+	{0x0072, 0x0327}: 0x0157, {0x0052, 0x030C}: 0x0158, {0x0072, 0x030C}: 0x0159,
+	{0x0053, 0x0301}: 0x015A, {0x0073, 0x0301}: 0x015B, {0x0053, 0x0302}: 0x015C,
+	{0x0073, 0x0302}: 0x015D, {0x0053, 0x0327}: 0x015E, {0x0073, 0x0327}: 0x015F,
+	{0x0053, 0x030C}: 0x0160, {0x0073, 0x030C}: 0x0161, {0x0054, 0x0327}: 0x0162,
+	{0x0074, 0x0327}: 0x0163, {0x0054, 0x030C}: 0x0164, {0x0074, 0x030C}: 0x0165,
+	{0x0055, 0x0303}: 0x0168, {0x0075, 0x0303}: 0x0169, {0x0055, 0x0304}: 0x016A,
+	{0x0075, 0x0304}: 0x016B, {0x0055, 0x0306}: 0x016C, {0x0075, 0x0306}: 0x016D,
+	{0x0055, 0x030A}: 0x016E, {0x0075, 0x030A}: 0x016F, {0x0055, 0x030B}: 0x0170,
+	{0x0075, 0x030B}: 0x0171, {0x0055, 0x0328}: 0x0172, {0x0075, 0x0328}: 0x0173,
+	{0x0057, 0x0302}: 0x0174, {0x0077, 0x0302}: 0x0175, {0x0059, 0x0302}: 0x0176,
+	{0x0079, 0x0302}: 0x0177, {0x0059, 0x0308}: 0x0178, {0x005A, 0x0301}: 0x0179,
+	{0x007A, 0x0301}: 0x017A, {0x005A, 0x0307}: 0x017B, {0x007A, 0x0307}: 0x017C,
+	{0x005A, 0x030C}: 0x017D, {0x007A, 0x030C}: 0x017E, {0x004F, 0x031B}: 0x01A0,
+	{0x006F, 0x031B}: 0x01A1, {0x0055, 0x031B}: 0x01AF, {0x0075, 0x031B}: 0x01B0,
+	{0x0041, 0x030C}: 0x01CD, {0x0061, 0x030C}: 0x01CE, {0x0049, 0x030C}: 0x01CF,
+	{0x0069, 0x030C}: 0x01D0, {0x004F, 0x030C}: 0x01D1, {0x006F, 0x030C}: 0x01D2,
+	{0x0055, 0x030C}: 0x01D3, {0x0075, 0x030C}: 0x01D4, {0x00DC, 0x0304}: 0x01D5,
+	{0x00FC, 0x0304}: 0x01D6, {0x00DC, 0x0301}: 0x01D7, {0x00FC, 0x0301}: 0x01D8,
+	{0x00DC, 0x030C}: 0x01D9, {0x00FC, 0x030C}: 0x01DA, {0x00DC, 0x0300}: 0x01DB,
+	{0x00FC, 0x0300}: 0x01DC, {0x00C4, 0x0304}: 0x01DE, {0x00E4, 0x0304}: 0x01DF,
+	{0x0226, 0x0304}: 0x01E0, {0x0227, 0x0304}: 0x01E1, {0x00C6, 0x0304}: 0x01E2,
+	{0x00E6, 0x0304}: 0x01E3, {0x0047, 0x030C}: 0x01E6, {0x0067, 0x030C}: 0x01E7,
+	{0x004B, 0x030C}: 0x01E8, {0x006B, 0x030C}: 0x01E9, {0x004F, 0x0328}: 0x01EA,
+	{0x006F, 0x0328}: 0x01EB, {0x01EA, 0x0304}: 0x01EC, {0x01EB, 0x0304}: 0x01ED,
+	{0x01B7, 0x030C}: 0x01EE, {0x0292, 0x030C}: 0x01EF, {0x006A, 0x030C}: 0x01F0,
+	{0x0047, 0x0301}: 0x01F4, {0x0067, 0x0301}: 0x01F5, {0x004E, 0x0300}: 0x01F8,
+	{0x006E, 0x0300}: 0x01F9, {0x00C5, 0x0301}: 0x01FA, {0x00E5, 0x0301}: 0x01FB,
+	{0x00C6, 0x0301}: 0x01FC, {0x00E6, 0x0301}: 0x01FD, {0x00D8, 0x0301}: 0x01FE,
+	{0x00F8, 0x0301}: 0x01FF, {0x0041, 0x030F}: 0x0200, {0x0061, 0x030F}: 0x0201,
+	{0x0041, 0x0311}: 0x0202, {0x0061, 0x0311}: 0x0203, {0x0045, 0x030F}: 0x0204,
+	{0x0065, 0x030F}: 0x0205, {0x0045, 0x0311}: 0x0206, {0x0065, 0x0311}: 0x0207,
+	{0x0049, 0x030F}: 0x0208, {0x0069, 0x030F}: 0x0209, {0x0049, 0x0311}: 0x020A,
+	{0x0069, 0x0311}: 0x020B, {0x004F, 0x030F}: 0x020C, {0x006F, 0x030F}: 0x020D,
+	{0x004F, 0x0311}: 0x020E, {0x006F, 0x0311}: 0x020F, {0x0052, 0x030F}: 0x0210,
+	{0x0072, 0x030F}: 0x0211, {0x0052, 0x0311}: 0x0212, {0x0072, 0x0311}: 0x0213,
+	{0x0055, 0x030F}: 0x0214, {0x0075, 0x030F}: 0x0215, {0x0055, 0x0311}: 0x0216,
+	{0x0075, 0x0311}: 0x0217, {0x0053, 0x0326}: 0x0218, {0x0073, 0x0326}: 0x0219,
+	{0x0054, 0x0326}: 0x021A, {0x0074, 0x0326}: 0x021B, {0x0048, 0x030C}: 0x021E,
+	{0x0068, 0x030C}: 0x021F, {0x0041, 0x0307}: 0x0226, {0x0061, 0x0307}: 0x0227,
+	{0x0045, 0x0327}: 0x0228, {0x0065, 0x0327}: 0x0229, {0x00D6, 0x0304}: 0x022A,
+	{0x00F6, 0x0304}: 0x022B, {0x00D5, 0x0304}: 0x022C, {0x00F5, 0x0304}: 0x022D,
+	{0x004F, 0x0307}: 0x022E, {0x006F, 0x0307}: 0x022F, {0x022E, 0x0304}: 0x0230,
+	{0x022F, 0x0304}: 0x0231, {0x0059, 0x0304}: 0x0232, {0x0079, 0x0304}: 0x0233,
+	{0x00A8, 0x0301}: 0x0385, {0x0391, 0x0301}: 0x0386, {0x0395, 0x0301}: 0x0388,
+	{0x0397, 0x0301}: 0x0389, {0x0399, 0x0301}: 0x038A, {0x039F, 0x0301}: 0x038C,
+	{0x03A5, 0x0301}: 0x038E, {0x03A9, 0x0301}: 0x038F, {0x03CA, 0x0301}: 0x0390,
+	{0x0399, 0x0308}: 0x03AA, {0x03A5, 0x0308}: 0x03AB, {0x03B1, 0x0301}: 0x03AC,
+	{0x03B5, 0x0301}: 0x03AD, {0x03B7, 0x0301}: 0x03AE, {0x03B9, 0x0301}: 0x03AF,
+	{0x03CB, 0x0301}: 0x03B0, {0x03B9, 0x0308}: 0x03CA, {0x03C5, 0x0308}: 0x03CB,
+	{0x03BF, 0x0301}: 0x03CC, {0x03C5, 0x0301}: 0x03CD, {0x03C9, 0x0301}: 0x03CE,
+	{0x03D2, 0x0301}: 0x03D3, {0x03D2, 0x0308}: 0x03D4, {0x0415, 0x0300}: 0x0400,
+	{0x0415, 0x0308}: 0x0401, {0x0413, 0x0301}: 0x0403, {0x0406, 0x0308}: 0x0407,
+	{0x041A, 0x0301}: 0x040C, {0x0418, 0x0300}: 0x040D, {0x0423, 0x0306}: 0x040E,
+	{0x0418, 0x0306}: 0x0419, {0x0438, 0x0306}: 0x0439, {0x0435, 0x0300}: 0x0450,
+	{0x0435, 0x0308}: 0x0451, {0x0433, 0x0301}: 0x0453, {0x0456, 0x0308}: 0x0457,
+	{0x043A, 0x0301}: 0x045C, {0x0438, 0x0300}: 0x045D, {0x0443, 0x0306}: 0x045E,
+	{0x0474, 0x030F}: 0x0476, {0x0475, 0x030F}: 0x0477, {0x0416, 0x0306}: 0x04C1,
+	{0x0436, 0x0306}: 0x04C2, {0x0410, 0x0306}: 0x04D0, {0x0430, 0x0306}: 0x04D1,
+	{0x0410, 0x0308}: 0x04D2, {0x0430, 0x0308}: 0x04D3, {0x0415, 0x0306}: 0x04D6,
+	{0x0435, 0x0306}: 0x04D7, {0x04D8, 0x0308}: 0x04DA, {0x04D9, 0x0308}: 0x04DB,
+	{0x0416, 0x0308}: 0x04DC, {0x0436, 0x0308}: 0x04DD, {0x0417, 0x0308}: 0x04DE,
+	{0x0437, 0x0308}: 0x04DF, {0x0418, 0x0304}: 0x04E2, {0x0438, 0x0304}: 0x04E3,
+	{0x0418, 0x0308}: 0x04E4, {0x0438, 0x0308}: 0x04E5, {0x041E, 0x0308}: 0x04E6,
+	{0x043E, 0x0308}: 0x04E7, {0x04E8, 0x0308}: 0x04EA, {0x04E9, 0x0308}: 0x04EB,
+	{0x042D, 0x0308}: 0x04EC, {0x044D, 0x0308}: 0x04ED, {0x0423, 0x0304}: 0x04EE,
+	{0x0443, 0x0304}: 0x04EF, {0x0423, 0x0308}: 0x04F0, {0x0443, 0x0308}: 0x04F1,
+	{0x0423, 0x030B}: 0x04F2, {0x0443, 0x030B}: 0x04F3, {0x0427, 0x0308}: 0x04F4,
+	{0x0447, 0x0308}: 0x04F5, {0x042B, 0x0308}: 0x04F8, {0x044B, 0x0308}: 0x04F9,
+	{0x0627, 0x0653}: 0x0622, {0x0627, 0x0654}: 0x0623, {0x0648, 0x0654}: 0x0624,
+	{0x0627, 0x0655}: 0x0625, {0x064A, 0x0654}: 0x0626, {0x06D5, 0x0654}: 0x06C0,
+	{0x06C1, 0x0654}: 0x06C2, {0x06D2, 0x0654}: 0x06D3, {0x0928, 0x093C}: 0x0929,
+	{0x0930, 0x093C}: 0x0931, {0x0933, 0x093C}: 0x0934, {0x09C7, 0x09BE}: 0x09CB,
+	{0x09C7, 0x09D7}: 0x09CC, {0x0B47, 0x0B56}: 0x0B48, {0x0B47, 0x0B3E}: 0x0B4B,
+	{0x0B47, 0x0B57}: 0x0B4C, {0x0B92, 0x0BD7}: 0x0B94, {0x0BC6, 0x0BBE}: 0x0BCA,
+	{0x0BC7, 0x0BBE}: 0x0BCB, {0x0BC6, 0x0BD7}: 0x0BCC, {0x0C46, 0x0C56}: 0x0C48,
+	{0x0CBF, 0x0CD5}: 0x0CC0, {0x0CC6, 0x0CD5}: 0x0CC7, {0x0CC6, 0x0CD6}: 0x0CC8,
+	{0x0CC6, 0x0CC2}: 0x0CCA, {0x0CCA, 0x0CD5}: 0x0CCB, {0x0D46, 0x0D3E}: 0x0D4A,
+	{0x0D47, 0x0D3E}: 0x0D4B, {0x0D46, 0x0D57}: 0x0D4C, {0x0DD9, 0x0DCA}: 0x0DDA,
+	{0x0DD9, 0x0DCF}: 0x0DDC, {0x0DDC, 0x0DCA}: 0x0DDD, {0x0DD9, 0x0DDF}: 0x0DDE,
+	{0x1025, 0x102E}: 0x1026, {0x1B05, 0x1B35}: 0x1B06, {0x1B07, 0x1B35}: 0x1B08,
+	{0x1B09, 0x1B35}: 0x1B0A, {0x1B0B, 0x1B35}: 0x1B0C, {0x1B0D, 0x1B35}: 0x1B0E,
+	{0x1B11, 0x1B35}: 0x1B12, {0x1B3A, 0x1B35}: 0x1B3B, {0x1B3C, 0x1B35}: 0x1B3D,
+	{0x1B3E, 0x1B35}: 0x1B40, {0x1B3F, 0x1B35}: 0x1B41, {0x1B42, 0x1B35}: 0x1B43,
+	{0x0041, 0x0325}: 0x1E00, {0x0061, 0x0325}: 0x1E01, {0x0042, 0x0307}: 0x1E02,
+	{0x0062, 0x0307}: 0x1E03, {0x0042, 0x0323}: 0x1E04, {0x0062, 0x0323}: 0x1E05,
+	{0x0042, 0x0331}: 0x1E06, {0x0062, 0x0331}: 0x1E07, {0x00C7, 0x0301}: 0x1E08,
+	{0x00E7, 0x0301}: 0x1E09, {0x0044, 0x0307}: 0x1E0A, {0x0064, 0x0307}: 0x1E0B,
+	{0x0044, 0x0323}: 0x1E0C, {0x0064, 0x0323}: 0x1E0D, {0x0044, 0x0331}: 0x1E0E,
+	{0x0064, 0x0331}: 0x1E0F, {0x0044, 0x0327}: 0x1E10, {0x0064, 0x0327}: 0x1E11,
+	{0x0044, 0x032D}: 0x1E12, {0x0064, 0x032D}: 0x1E13, {0x0112, 0x0300}: 0x1E14,
+	{0x0113, 0x0300}: 0x1E15, {0x0112, 0x0301}: 0x1E16, {0x0113, 0x0301}: 0x1E17,
+	{0x0045, 0x032D}: 0x1E18, {0x0065, 0x032D}: 0x1E19, {0x0045, 0x0330}: 0x1E1A,
+	{0x0065, 0x0330}: 0x1E1B, {0x0228, 0x0306}: 0x1E1C, {0x0229, 0x0306}: 0x1E1D,
+	{0x0046, 0x0307}: 0x1E1E, {0x0066, 0x0307}: 0x1E1F, {0x0047, 0x0304}: 0x1E20,
+	{0x0067, 0x0304}: 0x1E21, {0x0048, 0x0307}: 0x1E22, {0x0068, 0x0307}: 0x1E23,
+	{0x0048, 0x0323}: 0x1E24, {0x0068, 0x0323}: 0x1E25, {0x0048, 0x0308}: 0x1E26,
+	{0x0068, 0x0308}: 0x1E27, {0x0048, 0x0327}: 0x1E28, {0x0068, 0x0327}: 0x1E29,
+	{0x0048, 0x032E}: 0x1E2A, {0x0068, 0x032E}: 0x1E2B, {0x0049, 0x0330}: 0x1E2C,
+	{0x0069, 0x0330}: 0x1E2D, {0x00CF, 0x0301}: 0x1E2E, {0x00EF, 0x0301}: 0x1E2F,
+	{0x004B, 0x0301}: 0x1E30, {0x006B, 0x0301}: 0x1E31, {0x004B, 0x0323}: 0x1E32,
+	{0x006B, 0x0323}: 0x1E33, {0x004B, 0x0331}: 0x1E34, {0x006B, 0x0331}: 0x1E35,
+	{0x004C, 0x0323}: 0x1E36, {0x006C, 0x0323}: 0x1E37, {0x1E36, 0x0304}: 0x1E38,
+	{0x1E37, 0x0304}: 0x1E39, {0x004C, 0x0331}: 0x1E3A, {0x006C, 0x0331}: 0x1E3B,
+	{0x004C, 0x032D}: 0x1E3C, {0x006C, 0x032D}: 0x1E3D, {0x004D, 0x0301}: 0x1E3E,
+	{0x006D, 0x0301}: 0x1E3F, {0x004D, 0x0307}: 0x1E40, {0x006D, 0x0307}: 0x1E41,
+	{0x004D, 0x0323}: 0x1E42, {0x006D, 0x0323}: 0x1E43, {0x004E, 0x0307}: 0x1E44,
+	{0x006E, 0x0307}: 0x1E45, {0x004E, 0x0323}: 0x1E46, {0x006E, 0x0323}: 0x1E47,
+	{0x004E, 0x0331}: 0x1E48, {0x006E, 0x0331}: 0x1E49, {0x004E, 0x032D}: 0x1E4A,
+	{0x006E, 0x032D}: 0x1E4B, {0x00D5, 0x0301}: 0x1E4C, {0x00F5, 0x0301}: 0x1E4D,
+	{0x00D5, 0x0308}: 0x1E4E, {0x00F5, 0x0308}: 0x1E4F, {0x014C, 0x0300}: 0x1E50,
+	{0x014D, 0x0300}: 0x1E51, {0x014C, 0x0301}: 0x1E52, {0x014D, 0x0301}: 0x1E53,
+	{0x0050, 0x0301}: 0x1E54, {0x0070, 0x0301}: 0x1E55, {0x0050, 0x0307}: 0x1E56,
+	{0x0070, 0x0307}: 0x1E57, {0x0052, 0x0307}: 0x1E58, {0x0072, 0x0307}: 0x1E59,
+	{0x0052, 0x0323}: 0x1E5A, {0x0072, 0x0323}: 0x1E5B, {0x1E5A, 0x0304}: 0x1E5C,
+	{0x1E5B, 0x0304}: 0x1E5D, {0x0052, 0x0331}: 0x1E5E, {0x0072, 0x0331}: 0x1E5F,
+	{0x0053, 0x0307}: 0x1E60, {0x0073, 0x0307}: 0x1E61, {0x0053, 0x0323}: 0x1E62,
+	{0x0073, 0x0323}: 0x1E63, {0x015A, 0x0307}: 0x1E64, {0x015B, 0x0307}: 0x1E65,
+	{0x0160, 0x0307}: 0x1E66, {0x0161, 0x0307}: 0x1E67, {0x1E62, 0x0307}: 0x1E68,
+	{0x1E63, 0x0307}: 0x1E69, {0x0054, 0x0307}: 0x1E6A, {0x0074, 0x0307}: 0x1E6B,
+	{0x0054, 0x0323}: 0x1E6C, {0x0074, 0x0323}: 0x1E6D, {0x0054, 0x0331}: 0x1E6E,
+	{0x0074, 0x0331}: 0x1E6F, {0x0054, 0x032D}: 0x1E70, {0x0074, 0x032D}: 0x1E71,
+	{0x0055, 0x0324}: 0x1E72, {0x0075, 0x0324}: 0x1E73, {0x0055, 0x0330}: 0x1E74,
+	{0x0075, 0x0330}: 0x1E75, {0x0055, 0x032D}: 0x1E76, {0x0075, 0x032D}: 0x1E77,
+	{0x0168, 0x0301}: 0x1E78, {0x0169, 0x0301}: 0x1E79, {0x016A, 0x0308}: 0x1E7A,
+	{0x016B, 0x0308}: 0x1E7B, {0x0056, 0x0303}: 0x1E7C, {0x0076, 0x0303}: 0x1E7D,
+	{0x0056, 0x0323}: 0x1E7E, {0x0076, 0x0323}: 0x1E7F, {0x0057, 0x0300}: 0x1E80,
+	{0x0077, 0x0300}: 0x1E81, {0x0057, 0x0301}: 0x1E82, {0x0077, 0x0301}: 0x1E83,
+	{0x0057, 0x0308}: 0x1E84, {0x0077, 0x0308}: 0x1E85, {0x0057, 0x0307}: 0x1E86,
+	{0x0077, 0x0307}: 0x1E87, {0x0057, 0x0323}: 0x1E88, {0x0077, 0x0323}: 0x1E89,
+	{0x0058, 0x0307}: 0x1E8A, {0x0078, 0x0307}: 0x1E8B, {0x0058, 0x0308}: 0x1E8C,
+	{0x0078, 0x0308}: 0x1E8D, {0x0059, 0x0307}: 0x1E8E, {0x0079, 0x0307}: 0x1E8F,
+	{0x005A, 0x0302}: 0x1E90, {0x007A, 0x0302}: 0x1E91, {0x005A, 0x0323}: 0x1E92,
+	{0x007A, 0x0323}: 0x1E93, {0x005A, 0x0331}: 0x1E94, {0x007A, 0x0331}: 0x1E95,
+	{0x0068, 0x0331}: 0x1E96, {0x0074, 0x0308}: 0x1E97, {0x0077, 0x030A}: 0x1E98,
+	{0x0079, 0x030A}: 0x1E99, {0x017F, 0x0307}: 0x1E9B, {0x0041, 0x0323}: 0x1EA0,
+	{0x0061, 0x0323}: 0x1EA1, {0x0041, 0x0309}: 0x1EA2, {0x0061, 0x0309}: 0x1EA3,
+	{0x00C2, 0x0301}: 0x1EA4, {0x00E2, 0x0301}: 0x1EA5, {0x00C2, 0x0300}: 0x1EA6,
+	{0x00E2, 0x0300}: 0x1EA7, {0x00C2, 0x0309}: 0x1EA8, {0x00E2, 0x0309}: 0x1EA9,
+	{0x00C2, 0x0303}: 0x1EAA, {0x00E2, 0x0303}: 0x1EAB, {0x1EA0, 0x0302}: 0x1EAC,
+	{0x1EA1, 0x0302}: 0x1EAD, {0x0102, 0x0301}: 0x1EAE, {0x0103, 0x0301}: 0x1EAF,
+	{0x0102, 0x0300}: 0x1EB0, {0x0103, 0x0300}: 0x1EB1, {0x0102, 0x0309}: 0x1EB2,
+	{0x0103, 0x0309}: 0x1EB3, {0x0102, 0x0303}: 0x1EB4, {0x0103, 0x0303}: 0x1EB5,
+	{0x1EA0, 0x0306}: 0x1EB6, {0x1EA1, 0x0306}: 0x1EB7, {0x0045, 0x0323}: 0x1EB8,
+	{0x0065, 0x0323}: 0x1EB9, {0x0045, 0x0309}: 0x1EBA, {0x0065, 0x0309}: 0x1EBB,
+	{0x0045, 0x0303}: 0x1EBC, {0x0065, 0x0303}: 0x1EBD, {0x00CA, 0x0301}: 0x1EBE,
+	{0x00EA, 0x0301}: 0x1EBF, {0x00CA, 0x0300}: 0x1EC0, {0x00EA, 0x0300}: 0x1EC1,
+	{0x00CA, 0x0309}: 0x1EC2, {0x00EA, 0x0309}: 0x1EC3, {0x00CA, 0x0303}: 0x1EC4,
+	{0x00EA, 0x0303}: 0x1EC5, {0x1EB8, 0x0302}: 0x1EC6, {0x1EB9, 0x0302}: 0x1EC7,
+	{0x0049, 0x0309}: 0x1EC8, {0x0069, 0x0309}: 0x1EC9, {0x0049, 0x0323}: 0x1ECA,
+	{0x0069, 0x0323}: 0x1ECB, {0x004F, 0x0323}: 0x1ECC, {0x006F, 0x0323}: 0x1ECD,
+	{0x004F, 0x0309}: 0x1ECE, {0x006F, 0x0309}: 0x1ECF, {0x00D4, 0x0301}: 0x1ED0,
+	{0x00F4, 0x0301}: 0x1ED1, {0x00D4, 0x0300}: 0x1ED2, {0x00F4, 0x0300}: 0x1ED3,
+	{0x00D4, 0x0309}: 0x1ED4, {0x00F4, 0x0309}: 0x1ED5, {0x00D4, 0x0303}: 0x1ED6,
+	{0x00F4, 0x0303}: 0x1ED7, {0x1ECC, 0x0302}: 0x1ED8, {0x1ECD, 0x0302}: 0x1ED9,
+	{0x01A0, 0x0301}: 0x1EDA, {0x01A1, 0x0301}: 0x1EDB, {0x01A0, 0x0300}: 0x1EDC,
+	{0x01A1, 0x0300}: 0x1EDD, {0x01A0, 0x0309}: 0x1EDE, {0x01A1, 0x0309}: 0x1EDF,
+	{0x01A0, 0x0303}: 0x1EE0, {0x01A1, 0x0303}: 0x1EE1, {0x01A0, 0x0323}: 0x1EE2,
+	{0x01A1, 0x0323}: 0x1EE3, {0x0055, 0x0323}: 0x1EE4, {0x0075, 0x0323}: 0x1EE5,
+	{0x0055, 0x0309}: 0x1EE6, {0x0075, 0x0309}: 0x1EE7, {0x01AF, 0x0301}: 0x1EE8,
+	{0x01B0, 0x0301}: 0x1EE9, {0x01AF, 0x0300}: 0x1EEA, {0x01B0, 0x0300}: 0x1EEB,
+	{0x01AF, 0x0309}: 0x1EEC, {0x01B0, 0x0309}: 0x1EED, {0x01AF, 0x0303}: 0x1EEE,
+	{0x01B0, 0x0303}: 0x1EEF, {0x01AF, 0x0323}: 0x1EF0, {0x01B0, 0x0323}: 0x1EF1,
+	{0x0059, 0x0300}: 0x1EF2, {0x0079, 0x0300}: 0x1EF3, {0x0059, 0x0323}: 0x1EF4,
+	{0x0079, 0x0323}: 0x1EF5, {0x0059, 0x0309}: 0x1EF6, {0x0079, 0x0309}: 0x1EF7,
+	{0x0059, 0x0303}: 0x1EF8, {0x0079, 0x0303}: 0x1EF9, {0x03B1, 0x0313}: 0x1F00,
+	{0x03B1, 0x0314}: 0x1F01, {0x1F00, 0x0300}: 0x1F02, {0x1F01, 0x0300}: 0x1F03,
+	{0x1F00, 0x0301}: 0x1F04, {0x1F01, 0x0301}: 0x1F05, {0x1F00, 0x0342}: 0x1F06,
+	{0x1F01, 0x0342}: 0x1F07, {0x0391, 0x0313}: 0x1F08, {0x0391, 0x0314}: 0x1F09,
+	{0x1F08, 0x0300}: 0x1F0A, {0x1F09, 0x0300}: 0x1F0B, {0x1F08, 0x0301}: 0x1F0C,
+	{0x1F09, 0x0301}: 0x1F0D, {0x1F08, 0x0342}: 0x1F0E, {0x1F09, 0x0342}: 0x1F0F,
+	{0x03B5, 0x0313}: 0x1F10, {0x03B5, 0x0314}: 0x1F11, {0x1F10, 0x0300}: 0x1F12,
+	{0x1F11, 0x0300}: 0x1F13, {0x1F10, 0x0301}: 0x1F14, {0x1F11, 0x0301}: 0x1F15,
+	{0x0395, 0x0313}: 0x1F18, {0x0395, 0x0314}: 0x1F19, {0x1F18, 0x0300}: 0x1F1A,
+	{0x1F19, 0x0300}: 0x1F1B, {0x1F18, 0x0301}: 0x1F1C, {0x1F19, 0x0301}: 0x1F1D,
+	{0x03B7, 0x0313}: 0x1F20, {0x03B7, 0x0314}: 0x1F21, {0x1F20, 0x0300}: 0x1F22,
+	{0x1F21, 0x0300}: 0x1F23, {0x1F20, 0x0301}: 0x1F24, {0x1F21, 0x0301}: 0x1F25,
+	{0x1F20, 0x0342}: 0x1F26, {0x1F21, 0x0342}: 0x1F27, {0x0397, 0x0313}: 0x1F28,
+	{0x0397, 0x0314}: 0x1F29, {0x1F28, 0x0300}: 0x1F2A, {0x1F29, 0x0300}: 0x1F2B,
+	{0x1F28, 0x0301}: 0x1F2C, {0x1F29, 0x0301}: 0x1F2D, {0x1F28, 0x0342}: 0x1F2E,
+	{0x1F29, 0x0342}: 0x1F2F, {0x03B9, 0x0313}: 0x1F30, {0x03B9, 0x0314}: 0x1F31,
+	{0x1F30, 0x0300}: 0x1F32, {0x1F31, 0x0300}: 0x1F33, {0x1F30, 0x0301}: 0x1F34,
+	{0x1F31, 0x0301}: 0x1F35, {0x1F30, 0x0342}: 0x1F36, {0x1F31, 0x0342}: 0x1F37,
+	{0x0399, 0x0313}: 0x1F38, {0x0399, 0x0314}: 0x1F39, {0x1F38, 0x0300}: 0x1F3A,
+	{0x1F39, 0x0300}: 0x1F3B, {0x1F38, 0x0301}: 0x1F3C, {0x1F39, 0x0301}: 0x1F3D,
+	{0x1F38, 0x0342}: 0x1F3E, {0x1F39, 0x0342}: 0x1F3F, {0x03BF, 0x0313}: 0x1F40,
+	{0x03BF, 0x0314}: 0x1F41, {0x1F40, 0x0300}: 0x1F42, {0x1F41, 0x0300}: 0x1F43,
+	{0x1F40, 0x0301}: 0x1F44, {0x1F41, 0x0301}: 0x1F45, {0x039F, 0x0313}: 0x1F48,
+	{0x039F, 0x0314}: 0x1F49, {0x1F48, 0x0300}: 0x1F4A, {0x1F49, 0x0300}: 0x1F4B,
+	{0x1F48, 0x0301}: 0x1F4C, {0x1F49, 0x0301}: 0x1F4D, {0x03C5, 0x0313}: 0x1F50,
+	{0x03C5, 0x0314}: 0x1F51, {0x1F50, 0x0300}: 0x1F52, {0x1F51, 0x0300}: 0x1F53,
+	{0x1F50, 0x0301}: 0x1F54, {0x1F51, 0x0301}: 0x1F55, {0x1F50, 0x0342}: 0x1F56,
+	{0x1F51, 0x0342}: 0x1F57, {0x03A5, 0x0314}: 0x1F59, {0x1F59, 0x0300}: 0x1F5B,
+	{0x1F59, 0x0301}: 0x1F5D, {0x1F59, 0x0342}: 0x1F5F, {0x03C9, 0x0313}: 0x1F60,
+	{0x03C9, 0x0314}: 0x1F61, {0x1F60, 0x0300}: 0x1F62, {0x1F61, 0x0300}: 0x1F63,
+	{0x1F60, 0x0301}: 0x1F64, {0x1F61, 0x0301}: 0x1F65, {0x1F60, 0x0342}: 0x1F66,
+	{0x1F61, 0x0342}: 0x1F67, {0x03A9, 0x0313}: 0x1F68, {0x03A9, 0x0314}: 0x1F69,
+	{0x1F68, 0x0300}: 0x1F6A, {0x1F69, 0x0300}: 0x1F6B, {0x1F68, 0x0301}: 0x1F6C,
+	{0x1F69, 0x0301}: 0x1F6D, {0x1F68, 0x0342}: 0x1F6E, {0x1F69, 0x0342}: 0x1F6F,
+	{0x03B1, 0x0300}: 0x1F70, {0x03B5, 0x0300}: 0x1F72, {0x03B7, 0x0300}: 0x1F74,
+	{0x03B9, 0x0300}: 0x1F76, {0x03BF, 0x0300}: 0x1F78, {0x03C5, 0x0300}: 0x1F7A,
+	{0x03C9, 0x0300}: 0x1F7C, {0x1F00, 0x0345}: 0x1F80, {0x1F01, 0x0345}: 0x1F81,
+	{0x1F02, 0x0345}: 0x1F82, {0x1F03, 0x0345}: 0x1F83, {0x1F04, 0x0345}: 0x1F84,
+	{0x1F05, 0x0345}: 0x1F85, {0x1F06, 0x0345}: 0x1F86, {0x1F07, 0x0345}: 0x1F87,
+	{0x1F08, 0x0345}: 0x1F88, {0x1F09, 0x0345}: 0x1F89, {0x1F0A, 0x0345}: 0x1F8A,
+	{0x1F0B, 0x0345}: 0x1F8B, {0x1F0C, 0x0345}: 0x1F8C, {0x1F0D, 0x0345}: 0x1F8D,
+	{0x1F0E, 0x0345}: 0x1F8E, {0x1F0F, 0x0345}: 0x1F8F, {0x1F20, 0x0345}: 0x1F90,
+	{0x1F21, 0x0345}: 0x1F91, {0x1F22, 0x0345}: 0x1F92, {0x1F23, 0x0345}: 0x1F93,
+	{0x1F24, 0x0345}: 0x1F94, {0x1F25, 0x0345}: 0x1F95, {0x1F26, 0x0345}: 0x1F96,
+	{0x1F27, 0x0345}: 0x1F97, {0x1F28, 0x0345}: 0x1F98, {0x1F29, 0x0345}: 0x1F99,
+	{0x1F2A, 0x0345}: 0x1F9A, {0x1F2B, 0x0345}: 0x1F9B, {0x1F2C, 0x0345}: 0x1F9C,
+	{0x1F2D, 0x0345}: 0x1F9D, {0x1F2E, 0x0345}: 0x1F9E, {0x1F2F, 0x0345}: 0x1F9F,
+	{0x1F60, 0x0345}: 0x1FA0, {0x1F61, 0x0345}: 0x1FA1, {0x1F62, 0x0345}: 0x1FA2,
+	{0x1F63, 0x0345}: 0x1FA3, {0x1F64, 0x0345}: 0x1FA4, {0x1F65, 0x0345}: 0x1FA5,
+	{0x1F66, 0x0345}: 0x1FA6, {0x1F67, 0x0345}: 0x1FA7, {0x1F68, 0x0345}: 0x1FA8,
+	{0x1F69, 0x0345}: 0x1FA9, {0x1F6A, 0x0345}: 0x1FAA, {0x1F6B, 0x0345}: 0x1FAB,
+	{0x1F6C, 0x0345}: 0x1FAC, {0x1F6D, 0x0345}: 0x1FAD, {0x1F6E, 0x0345}: 0x1FAE,
+	{0x1F6F, 0x0345}: 0x1FAF, {0x03B1, 0x0306}: 0x1FB0, {0x03B1, 0x0304}: 0x1FB1,
+	{0x1F70, 0x0345}: 0x1FB2, {0x03B1, 0x0345}: 0x1FB3, {0x03AC, 0x0345}: 0x1FB4,
+	{0x03B1, 0x0342}: 0x1FB6, {0x1FB6, 0x0345}: 0x1FB7, {0x0391, 0x0306}: 0x1FB8,
+	{0x0391, 0x0304}: 0x1FB9, {0x0391, 0x0300}: 0x1FBA, {0x0391, 0x0345}: 0x1FBC,
+	{0x00A8, 0x0342}: 0x1FC1, {0x1F74, 0x0345}: 0x1FC2, {0x03B7, 0x0345}: 0x1FC3,
+	{0x03AE, 0x0345}: 0x1FC4, {0x03B7, 0x0342}: 0x1FC6, {0x1FC6, 0x0345}: 0x1FC7,
+	{0x0395, 0x0300}: 0x1FC8, {0x0397, 0x0300}: 0x1FCA, {0x0397, 0x0345}: 0x1FCC,
+	{0x1FBF, 0x0300}: 0x1FCD, {0x1FBF, 0x0301}: 0x1FCE, {0x1FBF, 0x0342}: 0x1FCF,
+	{0x03B9, 0x0306}: 0x1FD0, {0x03B9, 0x0304}: 0x1FD1, {0x03CA, 0x0300}: 0x1FD2,
+	{0x03B9, 0x0342}: 0x1FD6, {0x03CA, 0x0342}: 0x1FD7, {0x0399, 0x0306}: 0x1FD8,
+	{0x0399, 0x0304}: 0x1FD9, {0x0399, 0x0300}: 0x1FDA, {0x1FFE, 0x0300}: 0x1FDD,
+	{0x1FFE, 0x0301}: 0x1FDE, {0x1FFE, 0x0342}: 0x1FDF, {0x03C5, 0x0306}: 0x1FE0,
+	{0x03C5, 0x0304}: 0x1FE1, {0x03CB, 0x0300}: 0x1FE2, {0x03C1, 0x0313}: 0x1FE4,
+	{0x03C1, 0x0314}: 0x1FE5, {0x03C5, 0x0342}: 0x1FE6, {0x03CB, 0x0342}: 0x1FE7,
+	{0x03A5, 0x0306}: 0x1FE8, {0x03A5, 0x0304}: 0x1FE9, {0x03A5, 0x0300}: 0x1FEA,
+	{0x03A1, 0x0314}: 0x1FEC, {0x00A8, 0x0300}: 0x1FED, {0x1F7C, 0x0345}: 0x1FF2,
+	{0x03C9, 0x0345}: 0x1FF3, {0x03CE, 0x0345}: 0x1FF4, {0x03C9, 0x0342}: 0x1FF6,
+	{0x1FF6, 0x0345}: 0x1FF7, {0x039F, 0x0300}: 0x1FF8, {0x03A9, 0x0300}: 0x1FFA,
+	{0x03A9, 0x0345}: 0x1FFC, {0x2190, 0x0338}: 0x219A, {0x2192, 0x0338}: 0x219B,
+	{0x2194, 0x0338}: 0x21AE, {0x21D0, 0x0338}: 0x21CD, {0x21D4, 0x0338}: 0x21CE,
+	{0x21D2, 0x0338}: 0x21CF, {0x2203, 0x0338}: 0x2204, {0x2208, 0x0338}: 0x2209,
+	{0x220B, 0x0338}: 0x220C, {0x2223, 0x0338}: 0x2224, {0x2225, 0x0338}: 0x2226,
+	{0x223C, 0x0338}: 0x2241, {0x2243, 0x0338}: 0x2244, {0x2245, 0x0338}: 0x2247,
+	{0x2248, 0x0338}: 0x2249, {0x003D, 0x0338}: 0x2260, {0x2261, 0x0338}: 0x2262,
+	{0x224D, 0x0338}: 0x226D, {0x003C, 0x0338}: 0x226E, {0x003E, 0x0338}: 0x226F,
+	{0x2264, 0x0338}: 0x2270, {0x2265, 0x0338}: 0x2271, {0x2272, 0x0338}: 0x2274,
+	{0x2273, 0x0338}: 0x2275, {0x2276, 0x0338}: 0x2278, {0x2277, 0x0338}: 0x2279,
+	{0x227A, 0x0338}: 0x2280, {0x227B, 0x0338}: 0x2281, {0x2282, 0x0338}: 0x2284,
+	{0x2283, 0x0338}: 0x2285, {0x2286, 0x0338}: 0x2288, {0x2287, 0x0338}: 0x2289,
+	{0x22A2, 0x0338}: 0x22AC, {0x22A8, 0x0338}: 0x22AD, {0x22A9, 0x0338}: 0x22AE,
+	{0x22AB, 0x0338}: 0x22AF, {0x227C, 0x0338}: 0x22E0, {0x227D, 0x0338}: 0x22E1,
+	{0x2291, 0x0338}: 0x22E2, {0x2292, 0x0338}: 0x22E3, {0x22B2, 0x0338}: 0x22EA,
+	{0x22B3, 0x0338}: 0x22EB, {0x22B4, 0x0338}: 0x22EC, {0x22B5, 0x0338}: 0x22ED,
+	{0x304B, 0x3099}: 0x304C, {0x304D, 0x3099}: 0x304E, {0x304F, 0x3099}: 0x3050,
+	{0x3051, 0x3099}: 0x3052, {0x3053, 0x3099}: 0x3054, {0x3055, 0x3099}: 0x3056,
+	{0x3057, 0x3099}: 0x3058, {0x3059, 0x3099}: 0x305A, {0x305B, 0x3099}: 0x305C,
+	{0x305D, 0x3099}: 0x305E, {0x305F, 0x3099}: 0x3060, {0x3061, 0x3099}: 0x3062,
+	{0x3064, 0x3099}: 0x3065, {0x3066, 0x3099}: 0x3067, {0x3068, 0x3099}: 0x3069,
+	{0x306F, 0x3099}: 0x3070, {0x306F, 0x309A}: 0x3071, {0x3072, 0x3099}: 0x3073,
+	{0x3072, 0x309A}: 0x3074, {0x3075, 0x3099}: 0x3076, {0x3075, 0x309A}: 0x3077,
+	{0x3078, 0x3099}: 0x3079, {0x3078, 0x309A}: 0x307A, {0x307B, 0x3099}: 0x307C,
+	{0x307B, 0x309A}: 0x307D, {0x3046, 0x3099}: 0x3094, {0x309D, 0x3099}: 0x309E,
+	{0x30AB, 0x3099}: 0x30AC, {0x30AD, 0x3099}: 0x30AE, {0x30AF, 0x3099}: 0x30B0,
+	{0x30B1, 0x3099}: 0x30B2, {0x30B3, 0x3099}: 0x30B4, {0x30B5, 0x3099}: 0x30B6,
+	{0x30B7, 0x3099}: 0x30B8, {0x30B9, 0x3099}: 0x30BA, {0x30BB, 0x3099}: 0x30BC,
+	{0x30BD, 0x3099}: 0x30BE, {0x30BF, 0x3099}: 0x30C0, {0x30C1, 0x3099}: 0x30C2,
+	{0x30C4, 0x3099}: 0x30C5, {0x30C6, 0x3099}: 0x30C7, {0x30C8, 0x3099}: 0x30C9,
+	{0x30CF, 0x3099}: 0x30D0, {0x30CF, 0x309A}: 0x30D1, {0x30D2, 0x3099}: 0x30D3,
+	{0x30D2, 0x309A}: 0x30D4, {0x30D5, 0x3099}: 0x30D6, {0x30D5, 0x309A}: 0x30D7,
+	{0x30D8, 0x3099}: 0x30D9, {0x30D8, 0x309A}: 0x30DA, {0x30DB, 0x3099}: 0x30DC,
+	{0x30DB, 0x309A}: 0x30DD, {0x30A6, 0x3099}: 0x30F4, {0x30EF, 0x3099}: 0x30F7,
+	{0x30F0, 0x3099}: 0x30F8, {0x30F1, 0x3099}: 0x30F9, {0x30F2, 0x3099}: 0x30FA,
+	{0x30FD, 0x3099}: 0x30FE, {0x105D2, 0x0307}: 0x105C9, {0x105DA, 0x0307}: 0x105E4,
+	{0x11099, 0x110BA}: 0x1109A, {0x1109B, 0x110BA}: 0x1109C, {0x110A5, 0x110BA}: 0x110AB,
+	{0x11131, 0x11127}: 0x1112E, {0x11132, 0x11127}: 0x1112F, {0x11347, 0x1133E}: 0x1134B,
+	{0x11347, 0x11357}: 0x1134C, {0x11382, 0x113C9}: 0x11383, {0x11384, 0x113BB}: 0x11385,
+	{0x1138B, 0x113C2}: 0x1138E, {0x11390, 0x113C9}: 0x11391, {0x113C2, 0x113C2}: 0x113C5,
+	{0x113C2, 0x113B8}: 0x113C7, {0x113C2, 0x113C9}: 0x113C8, {0x114B9, 0x114BA}: 0x114BB,
+	{0x114B9, 0x114B0}: 0x114BC, {0x114B9, 0x114BD}: 0x114BE, {0x115B8, 0x115AF}: 0x115BA,
+	{0x115B9, 0x115AF}: 0x115BB, {0x11935, 0x11930}: 0x11938, {0x1611E, 0x1611E}: 0x16121,
+	{0x1611E, 0x16129}: 0x16122, {0x1611E, 0x1611F}: 0x16123, {0x16129, 0x1611F}: 0x16124,
+	{0x1611E, 0x16120}: 0x16125, {0x16121, 0x1611F}: 0x16126, {0x16122, 0x1611F}: 0x16127,
+	{0x16121, 0x16120}: 0x16128, {0x16D67, 0x16D67}: 0x16D68, {0x16D63, 0x16D67}: 0x16D69,
+	{0x16D69, 0x16D67}: 0x16D6A,
+}
@@ -0,0 +1,263 @@
+//go:build ignore
+
+// This program generates normTables.go from the Unicode Character Database, by default the one
+// of the version of the unicode package so that normalization agrees with it. It is run by go generate.
+// The -ucd flag reads the database files from another location, such as a local directory:
+//
+//	go run normTablesGen.go -ucd ./ucd
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ucd    = flag.String("ucd", "https://www.unicode.org/Public/"+unicode.Version+"/ucd", "URL or directory of the Unicode Character Database")
+	output = flag.String("output", "normTables.go", "file to write the tables to")
+)
+
+// character holds the normalization properties of a character in UnicodeData.txt.
+type character struct {
+	class         int
+	decomposition []rune
+	compatibility bool
+}
+
+func main() {
+	flag.Parse()
+	characters := parseUnicodeData(open("UnicodeData.txt"))
+	version, exclusions := parseCompositionExclusions(open("CompositionExclusions.txt"))
+	source, err := format.Source(generate(version, characters, exclusions))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open returns the content of a file of the Unicode Character Database.
+func open(name string) io.Reader {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		response, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			log.Fatalf("%s/%s: %s", *ucd, name, response.Status)
+		}
+		content, err := io.ReadAll(response.Body)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return bytes.NewReader(content)
+	}
+	content, err := os.ReadFile(filepath.Join(*ucd, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return bytes.NewReader(content)
+}
+
+// parseUnicodeData returns the combining classes and decomposition mappings of UnicodeData.txt.
+// The ranges of characters, such as the Hangul syllables, have none.
+func parseUnicodeData(r io.Reader) map[rune]character {
+	characters := map[rune]character{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) < 6 {
+			continue
+		}
+		var c character
+		c.class, _ = strconv.Atoi(fields[3])
+		mapping := fields[5]
+		if strings.HasPrefix(mapping, "<") {
+			c.compatibility = true
+			mapping = mapping[strings.Index(mapping, ">")+1:]
+		}
+		for _, code := range strings.Fields(mapping) {
+			c.decomposition = append(c.decomposition, parseRune(code))
+		}
+		if c.class != 0 || c.decomposition != nil {
+			characters[parseRune(fields[0])] = c
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return characters
+}
+
+// parseCompositionExclusions returns the version of the database and the characters listed
+// in CompositionExclusions.txt.
+func parseCompositionExclusions(r io.Reader) (string, map[rune]bool) {
+	version := ""
+	versionPattern := regexp.MustCompile(`CompositionExclusions-(\d+\.\d+\.\d+)\.txt`)
+	exclusions := map[rune]bool{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := versionPattern.FindStringSubmatch(line); match != nil && version == "" {
+			version = match[1]
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			exclusions[parseRune(line)] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if version == "" {
+		log.Fatal("CompositionExclusions.txt: version not found")
+	}
+	return version, exclusions
+}
+
+func parseRune(code string) rune {
+	r, err := strconv.ParseUint(code, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// decompose returns the full decomposition of a character, in canonical order.
+func decompose(characters map[rune]character, r rune, compatibility bool) []rune {
+	decomposed := expand(characters, r, compatibility)
+	// canonical ordering, sorting the runs of non starters by combining class
+	for i := 1; i < len(decomposed); i++ {
+		for j := i; j > 0; j-- {
+			previous, current := characters[decomposed[j-1]].class, characters[decomposed[j]].class
+			if current == 0 || previous <= current {
+				break
+			}
+			decomposed[j-1], decomposed[j] = decomposed[j], decomposed[j-1]
+		}
+	}
+	return decomposed
+}
+
+// expand applies the decomposition mappings of a character recursively.
+func expand(characters map[rune]character, r rune, compatibility bool) []rune {
+	c := characters[r]
+	if c.decomposition == nil || c.compatibility && !compatibility {
+		return []rune{r}
+	}
+	expanded := []rune{}
+	for _, d := range c.decomposition {
+		expanded = append(expanded, expand(characters, d, compatibility)...)
+	}
+	return expanded
+}
+
+// generate returns the source of normTables.go.
+func generate(version string, characters map[rune]character, exclusions map[rune]bool) []byte {
+	runes := make([]rune, 0, len(characters))
+	for r := range characters {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	var classes [][3]int
+	var canonical, compatibility, compositions []string
+	for _, r := range runes {
+		c := characters[r]
+		if c.class != 0 {
+			if n := len(classes); n > 0 && classes[n-1][1] == int(r)-1 && classes[n-1][2] == c.class {
+				classes[n-1][1] = int(r)
+			} else {
+				classes = append(classes, [3]int{int(r), int(r), c.class})
+			}
+		}
+		if c.decomposition == nil {
+			continue
+		}
+		nfd := string(decompose(characters, r, false))
+		nfkd := string(decompose(characters, r, true))
+		if nfd != string(r) {
+			canonical = append(canonical, fmt.Sprintf("0x%04X: \"%s\"", r, escape(nfd)))
+		}
+		if nfkd != nfd {
+			compatibility = append(compatibility, fmt.Sprintf("0x%04X: \"%s\"", r, escape(nfkd)))
+		}
+		// the primary composites, excluding the singletons and the non starter decompositions
+		if !c.compatibility && len(c.decomposition) == 2 && !exclusions[r] && c.class == 0 &&
+			characters[c.decomposition[0]].class == 0 {
+			compositions = append(compositions, fmt.Sprintf("{0x%04X, 0x%04X}: 0x%04X", c.decomposition[0], c.decomposition[1], r))
+		}
+	}
+
+	var buff bytes.Buffer
+	fmt.Fprintf(&buff, "// Code generated by normTablesGen.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", version)
+	buff.WriteString("package stringUtils\n\n")
+	buff.WriteString("// unicodeVersion is the version of the Unicode Character Database the normalization tables come from.\n")
+	fmt.Fprintf(&buff, "const unicodeVersion = %q\n\n", version)
+	buff.WriteString("// combiningClasses holds the ranges of characters having a non zero canonical combining class, sorted.\n")
+	buff.WriteString("var combiningClasses = []combiningClassRange{\n")
+	items := make([]string, len(classes))
+	for i, class := range classes {
+		items[i] = fmt.Sprintf("{0x%04X, 0x%04X, %d}", class[0], class[1], class[2])
+	}
+	writeItems(&buff, items, 4)
+	buff.WriteString("}\n\n")
+	buff.WriteString("// canonicalDecompositions holds the full canonical decompositions of the characters, Hangul syllables excepted.\n")
+	buff.WriteString("var canonicalDecompositions = map[rune]string{\n")
+	writeItems(&buff, canonical, 4)
+	buff.WriteString("}\n\n")
+	buff.WriteString("// compatibilityDecompositions holds the full compatibility decompositions of the characters which differ\n")
+	buff.WriteString("// from their canonical decompositions.\n")
+	buff.WriteString("var compatibilityDecompositions = map[rune]string{\n")
+	writeItems(&buff, compatibility, 4)
+	buff.WriteString("}\n\n")
+	buff.WriteString("// compositions holds the primary composites of the pairs of characters they canonically decompose to.\n")
+	buff.WriteString("var compositions = map[[2]rune]rune{\n")
+	writeItems(&buff, compositions, 3)
+	buff.WriteString("}\n")
+	return buff.Bytes()
+}
+
+// writeItems writes the items of a composite literal, perLine items on each line.
+func writeItems(buff *bytes.Buffer, items []string, perLine int) {
+	for i := 0; i < len(items); i += perLine {
+		end := i + perLine
+		if end > len(items) {
+			end = len(items)
+		}
+		buff.WriteString("\t" + strings.Join(items[i:end], ", ") + ",\n")
+	}
+}
+
+// escape returns a string literal content, the characters which are not printable ASCII being escaped.
+func escape(str string) string {
+	var buff strings.Builder
+	for _, r := range str {
+		switch {
+		case r >= 0x20 && r < 0x7F && r != '"' && r != '\\':
+			buff.WriteRune(r)
+		case r < 0x10000:
+			fmt.Fprintf(&buff, "\\u%04X", r)
+		default:
+			fmt.Fprintf(&buff, "\\U%08X", r)
+		}
+	}
+	return buff.String()
+}
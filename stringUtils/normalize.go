@@ -0,0 +1,258 @@
+package stringUtils
+
+// The normalization tables are generated from the Unicode Character Database of the version of the unicode
+// package, so that normalization agrees with the unicode functions. Generate them again when it changes.
+//go:generate go run normTablesGen.go
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizationForm is a Unicode normalization form, as defined by Unicode Standard Annex #15.
+type NormalizationForm int
+
+const (
+	// NFC is the canonical decomposition followed by the canonical composition, "e\u0301" becoming "\u00E9".
+	NFC NormalizationForm = iota
+	// NFD is the canonical decomposition, "\u00E9" becoming "e\u0301".
+	NFD
+	// NFKC is the compatibility decomposition followed by the canonical composition, "ﬁ" becoming "fi".
+	NFKC
+	// NFKD is the compatibility decomposition.
+	NFKD
+)
+
+// combiningClassRange is a range of characters having the same canonical combining class.
+type combiningClassRange struct {
+	first rune
+	last  rune
+	class uint8
+}
+
+// The constants of the algorithmic decomposition of the Hangul syllables.
+const (
+	hangulBase  = 0xAC00
+	hangulCount = 11172
+	jamoLBase   = 0x1100
+	jamoLCount  = 19
+	jamoVBase   = 0x1161
+	jamoVCount  = 21
+	jamoTBase   = 0x11A7
+	jamoTCount  = 28
+)
+
+// diacriticalMarks holds the combining diacritical marks blocks, the marks removed by StripAccents.
+var diacriticalMarks = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x036F, 1},
+		{0x1AB0, 0x1AFF, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x20D0, 0x20FF, 1},
+		{0xFE20, 0xFE2F, 1},
+	},
+}
+
+// combiningClass returns the canonical combining class of a character.
+func combiningClass(r rune) uint8 {
+	if r < 0x300 {
+		return 0
+	}
+	i := sort.Search(len(combiningClasses), func(i int) bool {
+		return combiningClasses[i].last >= r
+	})
+	if i < len(combiningClasses) && combiningClasses[i].first <= r {
+		return combiningClasses[i].class
+	}
+	return 0
+}
+
+// decompose returns the canonical or compatibility decomposition of a string, in canonical order.
+func decompose(str string, compatibility bool) []rune {
+	runes := make([]rune, 0, len(str))
+	for _, r := range str {
+		if r < 0xA0 {
+			runes = append(runes, r)
+			continue
+		}
+		if r >= hangulBase && r < hangulBase+hangulCount {
+			s := r - hangulBase
+			runes = append(runes, jamoLBase+s/(jamoVCount*jamoTCount), jamoVBase+s%(jamoVCount*jamoTCount)/jamoTCount)
+			if t := s % jamoTCount; t != 0 {
+				runes = append(runes, jamoTBase+t)
+			}
+			continue
+		}
+		if compatibility {
+			if decomposition, ok := compatibilityDecompositions[r]; ok {
+				runes = append(runes, []rune(decomposition)...)
+				continue
+			}
+		}
+		if decomposition, ok := canonicalDecompositions[r]; ok {
+			runes = append(runes, []rune(decomposition)...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+	// sort each run of combining marks by combining class, keeping the order of the marks of the same class
+	for i := 1; i < len(runes); i++ {
+		class := combiningClass(runes[i])
+		for j := i; class != 0 && j > 0 && combiningClass(runes[j-1]) > class; j-- {
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+	return runes
+}
+
+// composePair returns the primary composite of two characters, or false if there is none.
+func composePair(a rune, b rune) (rune, bool) {
+	if a >= jamoLBase && a < jamoLBase+jamoLCount && b >= jamoVBase && b < jamoVBase+jamoVCount {
+		return hangulBase + ((a-jamoLBase)*jamoVCount+b-jamoVBase)*jamoTCount, true
+	}
+	if a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%jamoTCount == 0 &&
+		b > jamoTBase && b < jamoTBase+jamoTCount {
+		return a + b - jamoTBase, true
+	}
+	composite, ok := compositions[[2]rune{a, b}]
+	return composite, ok
+}
+
+// compose applies the canonical composition to decomposed characters in canonical order.
+func compose(runes []rune) []rune {
+	composed := make([]rune, 0, len(runes))
+	starter := -1
+	// lastClass is the combining class of the last character not composed with the starter
+	var lastClass uint8
+	for _, r := range runes {
+		class := combiningClass(r)
+		// a character is blocked from the starter by the characters between them having a greater or equal class
+		if starter >= 0 && (starter == len(composed)-1 || (class != 0 && lastClass < class)) {
+			if composite, ok := composePair(composed[starter], r); ok {
+				composed[starter] = composite
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(composed)
+		}
+		lastClass = class
+		composed = append(composed, r)
+	}
+	return composed
+}
+
+// isASCII checks if a string only holds ASCII characters, which all forms leave unchanged.
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize returns a string in a Unicode normalization form. Canonically equivalent strings, such as
+// "\u00E9" and "e\u0301", have the same NFC and NFD forms, and compatibility equivalent strings, such as
+// "ﬁ" and "fi", have the same NFKC and NFKD forms. The invalid UTF-8 bytes are kept as they are.
+//
+//	Normalize("e\u0301", NFC) = "\u00E9"
+//	Normalize("\u00E9", NFD)  = "e\u0301"
+//	Normalize("ﬁ²", NFKC)     = "fi2"
+func Normalize(str string, form NormalizationForm) string {
+	if isASCII(str) {
+		return str
+	}
+	return mapValidUTF8(str, func(valid string) string {
+		runes := decompose(valid, form == NFKC || form == NFKD)
+		if form == NFC || form == NFKC {
+			runes = compose(runes)
+		}
+		return string(runes)
+	})
+}
+
+// mapValidUTF8 applies a function to each run of valid UTF-8 of a string, keeping the invalid bytes
+// between them as they are, like foldString does.
+func mapValidUTF8(str string, f func(valid string) string) string {
+	if utf8.ValidString(str) {
+		return f(str)
+	}
+	var buff strings.Builder
+	buff.Grow(len(str))
+	start := 0
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if r == utf8.RuneError && size == 1 {
+			buff.WriteString(f(str[start:i]))
+			buff.WriteByte(str[i])
+			start = i + 1
+		}
+		i += size
+	}
+	buff.WriteString(f(str[start:]))
+	return buff.String()
+}
+
+// IsNormalized checks if a string is in a Unicode normalization form.
+func IsNormalized(str string, form NormalizationForm) bool {
+	return Normalize(str, form) == str
+}
+
+// StripAccents removes the accents, and more generally the combining diacritical marks, of a string.
+// The characters are decomposed, their diacritical marks removed and the remaining characters composed again.
+// As in Apache StringUtils, "Ł" and "ł", which have no decomposition, become "L" and "l".
+//
+//	StripAccents("Crème Brûlée") = "Creme Brulee"
+//	StripAccents("Łódź")         = "Lodz"
+func StripAccents(str string) string {
+	if isASCII(str) {
+		return str
+	}
+	return stripAccents(str, false)
+}
+
+// stripAccents removes the diacritical marks of a string, applying the compatibility decomposition if asked.
+func stripAccents(str string, compatibility bool) string {
+	return mapValidUTF8(str, func(valid string) string {
+		return stripValidAccents(valid, compatibility)
+	})
+}
+
+// stripValidAccents removes the diacritical marks of a valid UTF-8 string, applying the compatibility
+// decomposition if asked.
+func stripValidAccents(str string, compatibility bool) string {
+	runes := decompose(str, compatibility)
+	stripped := runes[:0]
+	for _, r := range runes {
+		switch {
+		case unicode.Is(diacriticalMarks, r):
+		case r == 'Ł':
+			stripped = append(stripped, 'L')
+		case r == 'ł':
+			stripped = append(stripped, 'l')
+		default:
+			stripped = append(stripped, r)
+		}
+	}
+	return string(compose(stripped))
+}
+
+// EqualsIgnoreCaseAndAccents checks if two strings are equal ignoring case and accents. The strings are
+// compared in their compatibility normalization form, "ﬁ" being equal to "fi".
+//
+//	EqualsIgnoreCaseAndAccents("Crème Brûlée", "creme brulee") = true
+func EqualsIgnoreCaseAndAccents(str1 string, str2 string) bool {
+	return EqualsIgnoreCase(stripAccents(str1, true), stripAccents(str2, true))
+}
+
+// ContainsIgnoreCaseAndAccents checks if a string contains the searched string ignoring case and accents.
+// The strings are compared in their compatibility normalization form, "ﬁ" being equal to "fi".
+//
+//	ContainsIgnoreCaseAndAccents("Dessert: Crème Brûlée", "BRULEE") = true
+func ContainsIgnoreCaseAndAccents(str string, search string) bool {
+	start, _ := IndexIgnoreCase(stripAccents(str, true), stripAccents(search, true))
+	return start >= 0
+}
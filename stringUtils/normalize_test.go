@@ -0,0 +1,91 @@
+package stringUtils
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	if Normalize("", NFC) != "" || Normalize("abc", NFKD) != "abc" {
+		t.Errorf("fail test Normalize 1")
+	}
+	if Normalize("e\u0301", NFC) != "é" || Normalize("é", NFD) != "e\u0301" {
+		t.Errorf("fail test Normalize 2")
+	}
+	if Normalize("ﬁ²", NFKC) != "fi2" || Normalize("ﬁ²", NFC) != "ﬁ²" || Normalize("ｱ", NFKD) != "ア" {
+		t.Errorf("fail test Normalize 3")
+	}
+	// combining marks are put in canonical order before composing
+	if Normalize("q\u0307\u0323", NFC) != "q\u0323\u0307" || Normalize("s\u0307\u0323", NFC) != "ṩ" {
+		t.Errorf("fail test Normalize 4")
+	}
+	if Normalize("ṩ", NFD) != "s\u0323\u0307" {
+		t.Errorf("fail test Normalize 5")
+	}
+	if Normalize("한국", NFD) != "\u1112\u1161\u11AB\u1100\u116E\u11A8" || Normalize("\u1112\u1161\u11AB", NFC) != "한" {
+		t.Errorf("fail test Normalize 6")
+	}
+	// singletons and composition exclusions are not composed again
+	if Normalize("\u212B", NFC) != "Å" || Normalize("\u0958", NFC) != "\u0915\u093C" {
+		t.Errorf("fail test Normalize 7")
+	}
+	// a mark is blocked by the marks of the same class before it, not by the ones of a lower class
+	if Normalize("a\u0301\u0301", NFC) != "\u00E1\u0301" || Normalize("a\u0327\u0301", NFC) != "\u00E1\u0327" {
+		t.Errorf("fail test Normalize 8")
+	}
+	if Normalize("\u0301a", NFC) != "\u0301a" {
+		t.Errorf("fail test Normalize 9")
+	}
+	// the invalid bytes are kept as they are
+	if Normalize("e\xff\u00e9", NFC) != "e\xff\u00e9" || Normalize("e\xff\u00e9", NFD) != "e\xffe\u0301" || Normalize("e\xff\u0301", NFC) != "e\xff\u0301" {
+		t.Errorf("fail test Normalize 10")
+	}
+}
+
+func TestIsNormalized(t *testing.T) {
+	if !IsNormalized("", NFD) || !IsNormalized("é", NFC) || IsNormalized("é", NFD) {
+		t.Errorf("fail test IsNormalized 1")
+	}
+	if !IsNormalized("ﬁ", NFC) || IsNormalized("ﬁ", NFKC) {
+		t.Errorf("fail test IsNormalized 2")
+	}
+	if !IsNormalized("\xff", NFC) || !IsNormalized("\xe9t\xe9", NFKD) || IsNormalized("\xffe\u0301", NFC) {
+		t.Errorf("fail test IsNormalized 3")
+	}
+}
+
+func TestStripAccents(t *testing.T) {
+	if StripAccents("") != "" || StripAccents("control") != "control" {
+		t.Errorf("fail test StripAccents 1")
+	}
+	if StripAccents("Crème Brûlée") != "Creme Brulee" || StripAccents("e\u0301te\u0301") != "ete" {
+		t.Errorf("fail test StripAccents 2")
+	}
+	if StripAccents("Łódź Ångström") != "Lodz Angstrom" {
+		t.Errorf("fail test StripAccents 3")
+	}
+	if StripAccents("\u0928\u092E\u0938\u094D\u0924\u0947 \uD55C\uAD6D \uFB01") != "\u0928\u092E\u0938\u094D\u0924\u0947 \uD55C\uAD6D \uFB01" {
+		t.Errorf("fail test StripAccents 4")
+	}
+	if StripAccents("\xffCr\u00e8me\xff") != "\xffCreme\xff" {
+		t.Errorf("fail test StripAccents 5")
+	}
+}
+
+func TestEqualsIgnoreCaseAndAccents(t *testing.T) {
+	if !EqualsIgnoreCaseAndAccents("", "") || EqualsIgnoreCaseAndAccents("a", "b") {
+		t.Errorf("fail test EqualsIgnoreCaseAndAccents 1")
+	}
+	if !EqualsIgnoreCaseAndAccents("Crème Brûlée", "creme brulee") || !EqualsIgnoreCaseAndAccents("CRE\u0300ME", "crème") {
+		t.Errorf("fail test EqualsIgnoreCaseAndAccents 2")
+	}
+	if !EqualsIgnoreCaseAndAccents("ﬁancé", "FIANCE") || !EqualsIgnoreCaseAndAccents("Straße", "STRASSE") {
+		t.Errorf("fail test EqualsIgnoreCaseAndAccents 3")
+	}
+}
+
+func TestContainsIgnoreCaseAndAccents(t *testing.T) {
+	if !ContainsIgnoreCaseAndAccents("Dessert: Crème Brûlée", "BRULEE") || !ContainsIgnoreCaseAndAccents("abc", "") {
+		t.Errorf("fail test ContainsIgnoreCaseAndAccents 1")
+	}
+	if ContainsIgnoreCaseAndAccents("Crème", "cremes") || !ContainsIgnoreCaseAndAccents("ｃｒèｍｅ", "CREME") {
+		t.Errorf("fail test ContainsIgnoreCaseAndAccents 2")
+	}
+}
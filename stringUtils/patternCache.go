@@ -0,0 +1,104 @@
+package stringUtils
+
+import (
+	"container/list"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// DefaultPatternCacheSize is the number of compiled patterns kept by the cache used by the pattern functions.
+const DefaultPatternCacheSize = 256
+
+// defaultPatternCache is the cache used by CompilePattern and the pattern functions.
+var defaultPatternCache = NewPatternCache(DefaultPatternCacheSize)
+
+// matchPatternCache is the cache of the patterns anchored by MatchesPattern, by their original pattern.
+var matchPatternCache = NewPatternCache(DefaultPatternCacheSize)
+
+// PatternCache is a bounded cache of compiled regular expressions, evicting the least recently used ones.
+// It is safe for concurrent use.
+type PatternCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	// order holds the cached patterns, the most recently used first
+	order *list.List
+}
+
+// patternEntry is a compiled regular expression held by a PatternCache.
+type patternEntry struct {
+	pattern string
+	regexp  *regexp.Regexp
+}
+
+// NewPatternCache creates a PatternCache holding at most capacity compiled patterns.
+// A zero or negative capacity disables caching.
+func NewPatternCache(capacity int) *PatternCache {
+	return &PatternCache{capacity: capacity, entries: make(map[string]*list.Element), order: list.New()}
+}
+
+// Compile returns the compiled regular expression of a pattern, compiling and caching it if needed.
+// Patterns that fail to compile are not cached.
+func (c *PatternCache) Compile(pattern string) (*regexp.Regexp, error) {
+	return c.compile(pattern, func() (*regexp.Regexp, error) {
+		return regexp.Compile(pattern)
+	})
+}
+
+// compile returns the regular expression cached for a key, calling compile and caching its result if needed.
+func (c *PatternCache) compile(pattern string, compile func() (*regexp.Regexp, error)) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if element, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*patternEntry).regexp, nil
+	}
+	c.mu.Unlock()
+
+	// compile outside of the lock, another goroutine may cache the same pattern meanwhile
+	re, err := compile()
+	if err != nil || c.capacity <= 0 {
+		return re, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(element)
+		return element.Value.(*patternEntry).regexp, nil
+	}
+	c.entries[pattern] = c.order.PushFront(&patternEntry{pattern, re})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*patternEntry).pattern)
+	}
+	return re, nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be compiled.
+func (c *PatternCache) MustCompile(pattern string) *regexp.Regexp {
+	re, err := c.Compile(pattern)
+	if err != nil {
+		panic(`stringUtils: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return re
+}
+
+// Len returns the number of compiled patterns held by the cache.
+func (c *PatternCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// CompilePattern returns the compiled regular expression of a pattern, using the cache
+// shared by the pattern functions of this package.
+func CompilePattern(pattern string) (*regexp.Regexp, error) {
+	return defaultPatternCache.Compile(pattern)
+}
+
+// MustCompilePattern is like CompilePattern but panics if the pattern cannot be compiled.
+func MustCompilePattern(pattern string) *regexp.Regexp {
+	return defaultPatternCache.MustCompile(pattern)
+}
@@ -0,0 +1,72 @@
+package stringUtils
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestPatternCache(t *testing.T) {
+	cache := NewPatternCache(2)
+	a, err := cache.Compile("a+")
+	if err != nil || !a.MatchString("aa") {
+		t.Errorf("fail test PatternCache 1")
+	}
+	if again, _ := cache.Compile("a+"); again != a || cache.Len() != 1 {
+		t.Errorf("fail test PatternCache 2")
+	}
+	cache.Compile("b+")
+	cache.Compile("a+")
+	cache.Compile("c+")
+	// b+ is the least recently used pattern
+	if again, _ := cache.Compile("a+"); again != a || cache.Len() != 2 {
+		t.Errorf("fail test PatternCache 3")
+	}
+	if _, err := cache.Compile("a("); err == nil || cache.Len() != 2 {
+		t.Errorf("fail test PatternCache 4")
+	}
+	uncached := NewPatternCache(0)
+	if re, err := uncached.Compile("a+"); err != nil || re == nil || uncached.Len() != 0 {
+		t.Errorf("fail test PatternCache 5")
+	}
+}
+
+func TestPatternCacheMustCompile(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("fail test PatternCacheMustCompile 1")
+		}
+	}()
+	NewPatternCache(1).MustCompile("a(")
+}
+
+func TestPatternCacheConcurrency(t *testing.T) {
+	cache := NewPatternCache(10)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				pattern := "x" + strconv.Itoa((i+j)%15)
+				if re := cache.MustCompile(pattern); re.String() != pattern {
+					t.Errorf("fail test PatternCacheConcurrency 1")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if cache.Len() != 10 {
+		t.Errorf("fail test PatternCacheConcurrency 2")
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	re, err := CompilePattern(`\d+`)
+	if err != nil || !re.MatchString("42") {
+		t.Errorf("fail test CompilePattern 1")
+	}
+	if again := MustCompilePattern(`\d+`); again != re {
+		t.Errorf("fail test CompilePattern 2")
+	}
+}
@@ -0,0 +1,281 @@
+package stringUtils
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrReplaceLoop is returned by ReplaceEachRepeatedly when the replacements never stop matching,
+// the output of one replacement being the input of another.
+var ErrReplaceLoop = errors.New("stringUtils: endless loop in repeated replacements")
+
+// MultiReplacer replaces a list of strings with replacements in a single pass, using an Aho-Corasick automaton.
+// It is safe for concurrent use and worth building once for many replacements over large texts.
+//
+// As in Apache StringUtils.replaceEach, the matches are searched from left to right, and when several
+// search strings match at the same position, the first one in the search list wins.
+// Matches do not overlap, and empty search strings are ignored.
+type MultiReplacer struct {
+	replacements []string
+	ignoreCase   bool
+	// classes maps the bytes to the columns of the transition table, 0 being the bytes found in no search string
+	classes    [256]int32
+	numClasses int
+	// delta is the transition table of the automaton, numClasses columns per state
+	delta []int32
+	// depth is the length of the prefix of a search string represented by a state
+	depth []int
+	// match is the index of the longest search string ending at a state, or -1
+	match []int
+	// matchLen is the length of the search string given by match
+	matchLen []int
+	// fail is the failure link of a state, the state of its longest proper suffix
+	fail []int
+	// startByte is the first byte of all the search strings if they share it, or -1
+	startByte int
+}
+
+// NewMultiReplacer creates a MultiReplacer replacing each string of searchList with the string at the same
+// index in replacementList. It panics if the lists do not have the same length.
+func NewMultiReplacer(searchList []string, replacementList []string) *MultiReplacer {
+	return newMultiReplacer(searchList, replacementList, false)
+}
+
+// NewMultiReplacerIgnoreCase creates a MultiReplacer replacing each string of searchList, ignoring case,
+// with the string at the same index in replacementList. It panics if the lists do not have the same length.
+func NewMultiReplacerIgnoreCase(searchList []string, replacementList []string) *MultiReplacer {
+	return newMultiReplacer(searchList, replacementList, true)
+}
+
+func newMultiReplacer(searchList []string, replacementList []string, ignoreCase bool) *MultiReplacer {
+	if len(searchList) != len(replacementList) {
+		panic("search and replacement lists must have the same length")
+	}
+	m := &MultiReplacer{replacements: replacementList, ignoreCase: ignoreCase, startByte: -1}
+	patterns := make([]string, len(searchList))
+	for i, search := range searchList {
+		patterns[i] = search
+		if ignoreCase {
+			patterns[i], _ = foldString(search)
+		}
+		for j := 0; j < len(patterns[i]); j++ {
+			if m.classes[patterns[i][j]] == 0 {
+				m.numClasses++
+				m.classes[patterns[i][j]] = int32(m.numClasses)
+			}
+		}
+	}
+	m.numClasses++
+	m.build(patterns)
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		if m.startByte >= 0 && m.startByte != int(pattern[0]) {
+			m.startByte = -1
+			break
+		}
+		m.startByte = int(pattern[0])
+	}
+	return m
+}
+
+// build builds the trie of the patterns and turns it into a deterministic automaton.
+func (m *MultiReplacer) build(patterns []string) {
+	m.addState(0)
+	for i, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		state := 0
+		for j := 0; j < len(pattern); j++ {
+			next := int(m.delta[state*m.numClasses+int(m.classes[pattern[j]])])
+			if next == 0 {
+				next = m.addState(j + 1)
+				m.delta[state*m.numClasses+int(m.classes[pattern[j]])] = int32(next)
+			}
+			state = next
+		}
+		// the first of duplicate search strings wins
+		if m.match[state] < 0 {
+			m.match[state] = i
+			m.matchLen[state] = len(pattern)
+		}
+	}
+	// breadth first traversal computing the failure links and filling the missing transitions
+	fail := make([]int, len(m.depth))
+	m.fail = fail
+	queue := []int{}
+	for c := 0; c < m.numClasses; c++ {
+		if next := int(m.delta[c]); next != 0 {
+			queue = append(queue, next)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		if m.match[state] < 0 && m.match[fail[state]] >= 0 {
+			m.match[state] = m.match[fail[state]]
+			m.matchLen[state] = m.matchLen[fail[state]]
+		}
+		for c := 0; c < m.numClasses; c++ {
+			next := int(m.delta[state*m.numClasses+c])
+			fallback := m.delta[fail[state]*m.numClasses+c]
+			if next == 0 {
+				m.delta[state*m.numClasses+c] = fallback
+				continue
+			}
+			fail[next] = int(fallback)
+			queue = append(queue, next)
+		}
+	}
+}
+
+// addState adds a state to the automaton and returns it.
+func (m *MultiReplacer) addState(depth int) int {
+	m.delta = append(m.delta, make([]int32, m.numClasses)...)
+	m.depth = append(m.depth, depth)
+	m.match = append(m.match, -1)
+	m.matchLen = append(m.matchLen, 0)
+	return len(m.depth) - 1
+}
+
+// find finds the leftmost match starting at or after from, the first search string winning among
+// the ones starting at the same position. Only the matches accepted by accept are considered, all of
+// them if accept is nil. It returns the bounds and the index of the match, or -1.
+func (m *MultiReplacer) find(text string, from int, accept func(start, end int) bool) (int, int, int) {
+	bestStart, bestEnd, bestIndex := -1, -1, -1
+	state := 0
+	for i := from; i < len(text); i++ {
+		if state == 0 && bestStart < 0 && m.startByte >= 0 {
+			// skip right to the next possible match
+			next := strings.IndexByte(text[i:], byte(m.startByte))
+			if next < 0 {
+				break
+			}
+			i += next
+		}
+		state = int(m.delta[state*m.numClasses+int(m.classes[text[i]])])
+		// the shorter search strings ending here are only needed when the longest one is rejected
+		for s := state; m.match[s] >= 0; s = m.fail[s] {
+			index, start := m.match[s], i+1-m.matchLen[s]
+			if accept != nil && !accept(start, i+1) {
+				continue
+			}
+			if bestStart < 0 || start < bestStart || (start == bestStart && index < bestIndex) {
+				bestStart, bestEnd, bestIndex = start, i+1, index
+			}
+			break
+		}
+		// the matches ending further start after the current prefix
+		if bestStart >= 0 && i+1-m.depth[state] > bestStart {
+			break
+		}
+	}
+	return bestStart, bestEnd, bestIndex
+}
+
+// replace replaces the matches found in a string, reporting whether there were any.
+func (m *MultiReplacer) replace(str string) (string, bool) {
+	text := foldedString{folded: str}
+	if m.ignoreCase {
+		text = newFoldedString(str)
+	}
+	var accept func(start, end int) bool
+	if m.ignoreCase {
+		// reject the matches covering only part of the folding of a character
+		accept = func(start, end int) bool {
+			return text.isBoundary(start) && text.isBoundary(end)
+		}
+	}
+	var buff strings.Builder
+	replaced := false
+	last := 0
+	for pos := 0; pos < len(text.folded); {
+		start, end, index := m.find(text.folded, pos, accept)
+		if index < 0 {
+			break
+		}
+		pos = end
+		start, end = text.offset(start), text.offset(end)
+		if !replaced {
+			buff.Grow(len(str))
+			replaced = true
+		}
+		buff.WriteString(str[last:start])
+		buff.WriteString(m.replacements[index])
+		last = end
+	}
+	if !replaced {
+		return str, false
+	}
+	buff.WriteString(str[last:])
+	return buff.String(), true
+}
+
+// Replace replaces all the matches found in a string in a single pass.
+//
+//	NewMultiReplacer([]string{"a", "b"}, []string{"b", "a"}).Replace("abba") = "baab"
+func (m *MultiReplacer) Replace(str string) string {
+	result, _ := m.replace(str)
+	return result
+}
+
+// ReplaceRepeatedly replaces all the matches found in a string until there are none left.
+// It returns an empty string and ErrReplaceLoop when the replacements come back to a string they already
+// gave, such as when swapping "a" and "b", or when they make the string grow endlessly, such as when
+// replacing "a" with "aa", which is assumed once the string is 16 times longer than at first, and at least 64KB.
+//
+//	NewMultiReplacer([]string{"a", "b"}, []string{"b", "c"}).ReplaceRepeatedly("ab") = "cc", nil
+func (m *MultiReplacer) ReplaceRepeatedly(str string) (string, error) {
+	maxLength := maxInt(16*len(str), 64<<10)
+	seen := map[string]bool{str: true}
+	for {
+		result, replaced := m.replace(str)
+		if !replaced {
+			return result, nil
+		}
+		if seen[result] || len(result) > maxLength {
+			return "", ErrReplaceLoop
+		}
+		seen[result] = true
+		str = result
+	}
+}
+
+// ReplaceEach replaces each string of searchList with the string at the same index in replacementList,
+// in a single pass. The matches are searched from left to right, the first search string winning among
+// the ones matching at the same position. It panics if the lists do not have the same length.
+//
+//	ReplaceEach("abcde", []string{"ab", "d"}, []string{"w", "t"})  = "wcte"
+//	ReplaceEach("abcde", []string{"ab", "d"}, []string{"d", "t"})  = "dcte"
+//	ReplaceEach("aaa", []string{"a", "aa"}, []string{"1", "2"})    = "111"
+func ReplaceEach(str string, searchList []string, replacementList []string) string {
+	return NewMultiReplacer(searchList, replacementList).Replace(str)
+}
+
+// ReplaceEachIgnoreCase replaces each string of searchList, ignoring case, with the string at the same index
+// in replacementList, in a single pass. It panics if the lists do not have the same length.
+//
+//	ReplaceEachIgnoreCase("Hello WORLD", []string{"hello", "world"}, []string{"bye", "all"}) = "bye all"
+func ReplaceEachIgnoreCase(str string, searchList []string, replacementList []string) string {
+	return NewMultiReplacerIgnoreCase(searchList, replacementList).Replace(str)
+}
+
+// ReplaceEachRepeatedly replaces each string of searchList with the string at the same index
+// in replacementList, again and again until nothing matches. It returns an empty string and ErrReplaceLoop
+// when the replacements never end, as detected by MultiReplacer.ReplaceRepeatedly. It panics if the lists do not have the same length.
+//
+//	ReplaceEachRepeatedly("abcde", []string{"ab", "d"}, []string{"d", "t"})  = "tcte", nil
+//	ReplaceEachRepeatedly("abcde", []string{"ab", "d"}, []string{"d", "ab"}) = "", ErrReplaceLoop
+func ReplaceEachRepeatedly(str string, searchList []string, replacementList []string) (string, error) {
+	return NewMultiReplacer(searchList, replacementList).ReplaceRepeatedly(str)
+}
+
+// ReplaceEachRepeatedlyIgnoreCase replaces each string of searchList, ignoring case, with the string at the
+// same index in replacementList, again and again until nothing matches. It returns an empty string and
+// ErrReplaceLoop when the replacements never end, as detected by MultiReplacer.ReplaceRepeatedly. It panics if
+// the lists do not have the same length.
+func ReplaceEachRepeatedlyIgnoreCase(str string, searchList []string, replacementList []string) (string, error) {
+	return NewMultiReplacerIgnoreCase(searchList, replacementList).ReplaceRepeatedly(str)
+}
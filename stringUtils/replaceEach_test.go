@@ -0,0 +1,147 @@
+package stringUtils
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReplaceEach(t *testing.T) {
+	if ReplaceEach("", []string{"a"}, []string{"b"}) != "" || ReplaceEach("aba", []string{}, []string{}) != "aba" {
+		t.Errorf("fail test ReplaceEach 1")
+	}
+	if ReplaceEach("aba", []string{"a"}, []string{""}) != "b" || ReplaceEach("aba", []string{"a", "b"}, []string{"c", "d"}) != "cdc" {
+		t.Errorf("fail test ReplaceEach 2")
+	}
+	if ReplaceEach("abcde", []string{"ab", "d"}, []string{"w", "t"}) != "wcte" || ReplaceEach("abcde", []string{"ab", "d"}, []string{"d", "t"}) != "dcte" {
+		t.Errorf("fail test ReplaceEach 3")
+	}
+	if ReplaceEach("aaa", []string{"a", "aa"}, []string{"1", "2"}) != "111" || ReplaceEach("aaa", []string{"aa", "a"}, []string{"2", "1"}) != "21" {
+		t.Errorf("fail test ReplaceEach 4")
+	}
+	if ReplaceEach("abcdef", []string{"bcd", "abcdef"}, []string{"1", "2"}) != "2" || ReplaceEach("abcdeg", []string{"bcd", "abcdef"}, []string{"1", "2"}) != "a1eg" {
+		t.Errorf("fail test ReplaceEach 5")
+	}
+	if ReplaceEach("xabx", []string{"", "ab", "ab"}, []string{"1", "2", "3"}) != "x2x" {
+		t.Errorf("fail test ReplaceEach 6")
+	}
+	if ReplaceEach("Ça coûte 5€", []string{"ç", "û", "€"}, []string{"c", "u", "EUR"}) != "Ça coute 5EUR" {
+		t.Errorf("fail test ReplaceEach 7")
+	}
+	if ReplaceEach("she sells sea shells", []string{"he", "she", "hers", "his"}, []string{"1", "2", "3", "4"}) != "2 sells sea 2lls" {
+		t.Errorf("fail test ReplaceEach 8")
+	}
+}
+
+func TestReplaceEachPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("fail test ReplaceEachPanics 1")
+		}
+	}()
+	ReplaceEach("abc", []string{"a", "b"}, []string{"c"})
+}
+
+func TestReplaceEachIgnoreCase(t *testing.T) {
+	if ReplaceEachIgnoreCase("Hello WORLD", []string{"hello", "world"}, []string{"bye", "all"}) != "bye all" {
+		t.Errorf("fail test ReplaceEachIgnoreCase 1")
+	}
+	if ReplaceEachIgnoreCase("ÉTÉ été", []string{"été"}, []string{"summer"}) != "summer summer" {
+		t.Errorf("fail test ReplaceEachIgnoreCase 2")
+	}
+	// the Kelvin sign is longer than the letter k in UTF-8
+	if ReplaceEachIgnoreCase("5K or 5k", []string{"k"}, []string{" kelvin"}) != "5 kelvin or 5 kelvin" {
+		t.Errorf("fail test ReplaceEachIgnoreCase 3")
+	}
+	if ReplaceEachIgnoreCase("abc", []string{"B"}, []string{"x"}) != "axc" || ReplaceEachIgnoreCase("abc", []string{"d"}, []string{"x"}) != "abc" {
+		t.Errorf("fail test ReplaceEachIgnoreCase 4")
+	}
+	// the other search strings are tried when a match covers only part of the folding of ß
+	if ReplaceEachIgnoreCase("ß", []string{"s", "ss"}, []string{"x", "y"}) != "y" || ReplaceEachIgnoreCase("Maße", []string{"s", "ss"}, []string{"x", "y"}) != "Maye" {
+		t.Errorf("fail test ReplaceEachIgnoreCase 5")
+	}
+}
+
+func TestReplaceEachRepeatedly(t *testing.T) {
+	if s, err := ReplaceEachRepeatedly("abcde", []string{"ab", "d"}, []string{"d", "t"}); err != nil || s != "tcte" {
+		t.Errorf("fail test ReplaceEachRepeatedly 1")
+	}
+	if s, err := ReplaceEachRepeatedly("abcde", []string{"ab", "d"}, []string{"d", "ab"}); err != ErrReplaceLoop || s != "" {
+		t.Errorf("fail test ReplaceEachRepeatedly 2")
+	}
+	if _, err := ReplaceEachRepeatedly("a", []string{"a"}, []string{"aa"}); err != ErrReplaceLoop {
+		t.Errorf("fail test ReplaceEachRepeatedly 3")
+	}
+	if s, err := ReplaceEachRepeatedly("aaaaaaaabbbbbbbb", []string{"ab"}, []string{""}); err != nil || s != "" {
+		t.Errorf("fail test ReplaceEachRepeatedly 4")
+	}
+	if s, err := ReplaceEachRepeatedlyIgnoreCase("ABC", []string{"a", "b"}, []string{"b", "c"}); err != nil || s != "ccC" {
+		t.Errorf("fail test ReplaceEachRepeatedly 5")
+	}
+	// long chains of passes which do not shorten the string still end
+	if s, err := ReplaceEachRepeatedly("aaaab", []string{"ab"}, []string{"ba"}); err != nil || s != "baaaa" {
+		t.Errorf("fail test ReplaceEachRepeatedly 6")
+	}
+	if s, err := ReplaceEachRepeatedly("aaaaaaab", []string{"ab", "x"}, []string{"ba", "y"}); err != nil || s != "baaaaaaa" {
+		t.Errorf("fail test ReplaceEachRepeatedly 7")
+	}
+	if s, err := ReplaceEachRepeatedly(strings.Repeat("a", 20)+"b", []string{"ab"}, []string{"bxa"}); err != nil || s != "b"+strings.Repeat("xa", 20) {
+		t.Errorf("fail test ReplaceEachRepeatedly 8")
+	}
+}
+
+func benchmarkReplaceData() ([]string, []string, string) {
+	searchList := make([]string, 300)
+	replacementList := make([]string, 300)
+	for i := range searchList {
+		searchList[i] = "{{key" + strconv.Itoa(i) + "}}"
+		replacementList[i] = "value" + strconv.Itoa(i)
+	}
+	var text strings.Builder
+	for i := 0; i < 20000; i++ {
+		text.WriteString("some text around {{key" + strconv.Itoa(i%400) + "}} and more ")
+	}
+	return searchList, replacementList, text.String()
+}
+
+func BenchmarkMultiReplacer(b *testing.B) {
+	searchList, replacementList, text := benchmarkReplaceData()
+	replacer := NewMultiReplacer(searchList, replacementList)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		replacer.Replace(text)
+	}
+}
+
+func BenchmarkMultiReplacerIgnoreCase(b *testing.B) {
+	searchList, replacementList, text := benchmarkReplaceData()
+	replacer := NewMultiReplacerIgnoreCase(searchList, replacementList)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		replacer.Replace(text)
+	}
+}
+
+func BenchmarkStringsReplacer(b *testing.B) {
+	searchList, replacementList, text := benchmarkReplaceData()
+	pairs := make([]string, 0, 2*len(searchList))
+	for i := range searchList {
+		pairs = append(pairs, searchList[i], replacementList[i])
+	}
+	replacer := strings.NewReplacer(pairs...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		replacer.Replace(text)
+	}
+}
+
+func BenchmarkChainedStringsReplace(b *testing.B) {
+	searchList, replacementList, text := benchmarkReplaceData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := text
+		for j := range searchList {
+			result = strings.Replace(result, searchList[j], replacementList[j], -1)
+		}
+	}
+}
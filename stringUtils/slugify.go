@@ -0,0 +1,113 @@
+package stringUtils
+
+import (
+	"strings"
+)
+
+// ReservedFileNames are the device names which cannot be used as file names on Windows,
+// to be used as the ReservedWords of the SlugOptions when the slugs are file names.
+var ReservedFileNames = []string{
+	"con", "prn", "aux", "nul",
+	"com1", "com2", "com3", "com4", "com5", "com6", "com7", "com8", "com9",
+	"lpt1", "lpt2", "lpt3", "lpt4", "lpt5", "lpt6", "lpt7", "lpt8", "lpt9",
+}
+
+// SlugOptions configures how SlugifyWithOptions builds a slug.
+type SlugOptions struct {
+	// Separator replaces the spaces and punctuation between the words, "-" by default.
+	Separator string
+	// MaxLength is the maximum length of the slug, without limit when 0. As with the maxWidth of
+	// Abbreviate, a MaxLength of 1 to 3 is too short and leaves the slug whole. Unlike Abbreviate,
+	// a longer slug gets no "..." but is cut on the last separator fitting in the length, or within
+	// the first word when it is longer than the length.
+	MaxLength int
+	// KeepCase keeps the case of the letters, which are lower cased otherwise.
+	KeepCase bool
+	// ReservedWords are the slugs which cannot be used, such as "new" or ReservedFileNames.
+	// They are compared ignoring case, and a reserved slug is followed by the separator and "1",
+	// or by "1" alone when MaxLength leaves no room for the separator.
+	ReservedWords []string
+}
+
+// Slugify converts a string to a slug for URLs and file names: the string is transliterated to ASCII
+// and lower cased, its apostrophes are removed, and its other characters which are neither letters nor
+// digits are replaced by "-", without repeating it nor putting it at the start or the end.
+//
+//	Slugify("")                       = ""
+//	Slugify("Hello, World!")          = "hello-world"
+//	Slugify("  Don't   panic  ")      = "dont-panic"
+//	Slugify("Crème Brûlée à l'été")   = "creme-brulee-a-lete"
+//	Slugify("Привет, мир")            = "privet-mir"
+func Slugify(str string) string {
+	return SlugifyWithOptions(str, SlugOptions{})
+}
+
+// SlugifyWithOptions converts a string to a slug as Slugify does, as configured by the options.
+//
+//	SlugifyWithOptions("Hello, World!", SlugOptions{Separator: "_", KeepCase: true}) = "Hello_World"
+//	SlugifyWithOptions("The quick brown fox", SlugOptions{MaxLength: 12})           = "the-quick"
+//	SlugifyWithOptions("The quick brown fox", SlugOptions{MaxLength: 3})            = "the-quick-brown-fox"
+//	SlugifyWithOptions("Con", SlugOptions{ReservedWords: ReservedFileNames})        = "con-1"
+func SlugifyWithOptions(str string, options SlugOptions) string {
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+	str = Transliterate(str)
+	if !options.KeepCase {
+		str = strings.ToLower(str)
+	}
+	words := []string{}
+	var word strings.Builder
+	for i := 0; i <= len(str); i++ {
+		if i < len(str) && isSlugCharacter(str[i]) {
+			word.WriteByte(str[i])
+		} else if i < len(str) && str[i] == '\'' {
+			// "don't" gives "dont" rather than "don-t"
+			continue
+		} else if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	slug := strings.Join(words, options.Separator)
+	if options.MaxLength >= 4 && len(slug) > options.MaxLength {
+		slug = truncateSlug(words, options.Separator, options.MaxLength)
+	}
+	for _, reserved := range options.ReservedWords {
+		if strings.EqualFold(slug, reserved) {
+			suffix := options.Separator + "1"
+			if options.MaxLength >= 4 && len(slug)+len(suffix) > options.MaxLength {
+				if len(suffix) >= options.MaxLength {
+					// keep some of the slug rather than starting with the separator
+					suffix = "1"
+				}
+				slug = truncateSlug([]string{slug}, options.Separator, options.MaxLength-len(suffix))
+			}
+			return slug + suffix
+		}
+	}
+	return slug
+}
+
+// truncateSlug joins the words with the separator up to a length, cutting the first word if it is too long.
+func truncateSlug(words []string, separator string, maxLength int) string {
+	if len(words) == 0 || len(words[0]) >= maxLength {
+		if len(words) == 0 || maxLength <= 0 {
+			return ""
+		}
+		return words[0][:maxLength]
+	}
+	slug := words[0]
+	for _, word := range words[1:] {
+		if len(slug)+len(separator)+len(word) > maxLength {
+			break
+		}
+		slug += separator + word
+	}
+	return slug
+}
+
+// isSlugCharacter tells whether an ASCII character is a letter or a digit.
+func isSlugCharacter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
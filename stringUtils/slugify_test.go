@@ -0,0 +1,52 @@
+package stringUtils
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	if Slugify("") != "" || Slugify("!!!") != "" || Slugify("Hello, World!") != "hello-world" {
+		t.Errorf("fail test Slugify 1")
+	}
+	if Slugify("  Don't   panic  ") != "dont-panic" || Slugify("Crème Brûlée à l'été") != "creme-brulee-a-lete" {
+		t.Errorf("fail test Slugify 2")
+	}
+	if Slugify("Привет, мир") != "privet-mir" || Slugify("Καλημέρα κόσμε") != "kalimera-kosme" || Slugify("東京タワー") != "tawa" {
+		t.Errorf("fail test Slugify 3")
+	}
+}
+
+func TestSlugifyWithOptions(t *testing.T) {
+	if SlugifyWithOptions("Hello, World!", SlugOptions{Separator: "_", KeepCase: true}) != "Hello_World" {
+		t.Errorf("fail test SlugifyWithOptions 1")
+	}
+	if SlugifyWithOptions("The quick brown fox", SlugOptions{MaxLength: 12}) != "the-quick" ||
+		SlugifyWithOptions("The quick brown fox", SlugOptions{MaxLength: 3}) != "the-quick-brown-fox" {
+		t.Errorf("fail test SlugifyWithOptions 2")
+	}
+	if SlugifyWithOptions("Supercalifragilistic", SlugOptions{MaxLength: 5}) != "super" ||
+		SlugifyWithOptions("a b", SlugOptions{MaxLength: 5}) != "a-b" {
+		t.Errorf("fail test SlugifyWithOptions 3")
+	}
+	if SlugifyWithOptions("Con", SlugOptions{ReservedWords: ReservedFileNames}) != "con-1" ||
+		SlugifyWithOptions("Console", SlugOptions{ReservedWords: ReservedFileNames}) != "console" {
+		t.Errorf("fail test SlugifyWithOptions 4")
+	}
+	if SlugifyWithOptions("New", SlugOptions{Separator: "_", ReservedWords: []string{"new", "edit"}}) != "new_1" {
+		t.Errorf("fail test SlugifyWithOptions 5")
+	}
+	// a MaxLength too short for Abbreviate leaves the slug whole
+	for maxLength := 1; maxLength <= 3; maxLength++ {
+		if SlugifyWithOptions("Supercalifragilistic", SlugOptions{MaxLength: maxLength}) != "supercalifragilistic" ||
+			SlugifyWithOptions("Con", SlugOptions{MaxLength: maxLength, ReservedWords: ReservedFileNames}) != "con-1" {
+			t.Errorf("fail test SlugifyWithOptions 6")
+		}
+	}
+	if SlugifyWithOptions("Supercalifragilistic", SlugOptions{MaxLength: 4}) != "supe" {
+		t.Errorf("fail test SlugifyWithOptions 7")
+	}
+	// the reserved slug suffix never leaves the slug starting with the separator
+	if SlugifyWithOptions("Con", SlugOptions{MaxLength: 4, Separator: "---", ReservedWords: ReservedFileNames}) != "con1" ||
+		SlugifyWithOptions("Con", SlugOptions{MaxLength: 4, Separator: "--", ReservedWords: ReservedFileNames}) != "c--1" ||
+		SlugifyWithOptions("Con", SlugOptions{MaxLength: 4, ReservedWords: ReservedFileNames}) != "co-1" {
+		t.Errorf("fail test SlugifyWithOptions 8")
+	}
+}
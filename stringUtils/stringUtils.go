@@ -3,6 +3,7 @@ package stringUtils
 
 import (
 	"regexp"
+	"regexp/syntax"
 	"strconv"
 	"strings"
 	"unicode"
@@ -15,8 +16,15 @@ func Abbreviate(str string, maxWidth int) string {
 }
 
 // AbbreviateWithOffset abbreviates a string using ellipses at a specific offset.
+// Both offset and maxWidth are expressed in characters (runes), not bytes.
 func AbbreviateWithOffset(str string, offset int, maxWidth int) string {
-	size := len(str)
+	return internalAbbreviate(str, runeBoundaries(str), offset, maxWidth)
+}
+
+// internalAbbreviate abbreviates a string whose characters start at the given byte offsets,
+// the last offset being the length of the string.
+func internalAbbreviate(str string, bounds []int, offset int, maxWidth int) string {
+	size := len(bounds) - 1
 	if str == "" || maxWidth < 4 || size <= maxWidth {
 		return str
 	}
@@ -28,15 +36,15 @@ func AbbreviateWithOffset(str string, offset int, maxWidth int) string {
 	}
 	abbrevMarker := "..."
 	if offset <= 4 {
-		return str[0:maxWidth-3] + abbrevMarker
+		return str[0:bounds[maxWidth-3]] + abbrevMarker
 	}
 	if maxWidth < 7 {
 		return str
 	}
 	if offset+maxWidth-3 < size {
-		return abbrevMarker + Abbreviate(str[offset:], maxWidth-3)
+		return abbrevMarker + str[bounds[offset]:bounds[offset+maxWidth-6]] + abbrevMarker
 	}
-	return abbrevMarker + str[size-(maxWidth-3):]
+	return abbrevMarker + str[bounds[size-(maxWidth-3)]:]
 }
 
 func internalAppendIfMissing(str string, suffix string, ignoreCase bool, suffixes ...string) string {
@@ -80,10 +88,37 @@ func AppendIfMissingIgnoreCase(str string, suffix string, suffixes ...string) st
 
 // Capitalize capitalizes a string changing the first letter to title case. No other letters are changed.
 func Capitalize(str string) string {
-	for i, c := range str {
-		return string(unicode.ToUpper(c)) + str[i+1:]
+	c, size := utf8.DecodeRuneInString(str)
+	if size == 0 || c == utf8.RuneError && size == 1 {
+		// an empty string or an invalid first byte, kept as it is
+		return str
 	}
-	return ""
+	return string(unicode.ToUpper(c)) + str[size:]
+}
+
+// CapitalizeWithLocale changes the first character of a string to title case following the rules of a locale,
+// such as "tr" or "nl". The other characters are not changed.
+//
+//	CapitalizeWithLocale("istanbul", "tr") = "İstanbul"
+func CapitalizeWithLocale(str string, locale string) string {
+	return NewCaseMapper(locale).Capitalize(str)
+}
+
+// Center centers a string in a larger string of size `size` using the space character.
+func Center(str string, size int) string {
+	return CenterWithString(str, size, " ")
+}
+
+// CenterWithString centers a string in a larger string of size `size` using a pad string.
+// When the padding cannot be split evenly, the extra character goes to the right.
+func CenterWithString(str string, size int, pad string) string {
+	strLen := utf8.RuneCountInString(str)
+	if size <= 0 || strLen >= size {
+		return str
+	}
+	pads := size - strLen
+	str = LeftPadWithString(str, strLen+pads/2, pad)
+	return RightPadWithString(str, size, pad)
 }
 
 // Chomp removes one newline from end of a string if it's there, otherwise leave it alone.
@@ -142,8 +177,11 @@ func ContainsAnyCharacter(str string, search string) bool {
 }
 
 // ContainsIgnoreCase checks if the string contains the searched string ignoring case.
+//
+//	ContainsIgnoreCase("Die Straße", "STRASSE") = true
 func ContainsIgnoreCase(str string, search string) bool {
-	return strings.Contains(strings.ToLower(str), strings.ToLower(search))
+	start, _ := IndexIgnoreCase(str, search)
+	return start >= 0
 }
 
 // ContainsNone checks if the string contains no occurrence of searched string.
@@ -295,7 +333,7 @@ func IsBlank(s string) bool {
 	if s == "" {
 		return true
 	}
-	if regexp.MustCompile(`^\s+$`).MatchString(s) {
+	if MustCompilePattern(`^\s+$`).MatchString(s) {
 		return true
 	}
 	return false
@@ -417,52 +455,103 @@ func Left(str string, size int) string {
 	if str == "" || size < 0 {
 		return ""
 	}
-	if len(str) <= size {
-		return str
-	}
-	return str[0:size]
+	return str[:runeOffset(str, size)]
 }
 
-/*func internalLeftPad(str string, size int, pad string) string {
-	pads := size - len(str)
+// LeftPad left pads a string with spaces to a size of `size` characters.
+func LeftPad(str string, size int) string {
+	return LeftPadWithString(str, size, " ")
+}
+
+// LeftPadWithString left pads a string with a pad string to a size of `size` characters.
+// The pad string is repeated and truncated as needed, an empty pad string is treated as a single space.
+func LeftPadWithString(str string, size int, pad string) string {
+	pads := size - utf8.RuneCountInString(str)
 	if pads <= 0 {
 		return str
 	}
-	if isEmpty(pad) {
+	return internalPadding(pads, pad) + str
+}
+
+// internalPadding builds a padding of `size` characters by repeating the pad string.
+func internalPadding(size int, pad string) string {
+	if IsEmpty(pad) {
 		pad = " "
 	}
-	padLen := len(pad)
-	strLen := len(str)
-	if padLen == 1 && pads
-}*/
+	padRunes := []rune(pad)
+	buff := make([]rune, size)
+	for i := range buff {
+		buff[i] = padRunes[i%len(padRunes)]
+	}
+	return string(buff)
+}
 
 // LowerCase converts a string to lower case.
 func LowerCase(str string) string {
 	return strings.ToLower(str)
 }
 
+// LowerCaseWithLocale converts a string to lower case following the rules of a locale, such as "tr" or "lt".
+//
+//	LowerCaseWithLocale("DİYARBAKIR", "tr") = "diyarbakır"
+func LowerCaseWithLocale(str string, locale string) string {
+	return NewCaseMapper(locale).ToLower(str)
+}
+
 // UpperCase converts a string to upper case.
 func UpperCase(str string) string {
 	return strings.ToUpper(str)
 }
 
+// UpperCaseWithLocale converts a string to upper case following the rules of a locale, such as "tr" or "el".
+//
+//	UpperCaseWithLocale("diyarbakır", "tr") = "DİYARBAKIR"
+func UpperCaseWithLocale(str string, locale string) string {
+	return NewCaseMapper(locale).ToUpper(str)
+}
+
+// MatchesPattern checks if the whole string matches the given regular expression.
+// It panics if the regular expression cannot be compiled.
+func MatchesPattern(str string, pattern string) bool {
+	matches, err := MatchesPatternE(str, pattern)
+	if err != nil {
+		panic(err)
+	}
+	return matches
+}
+
+// MatchesPatternE checks if the whole string matches the given regular expression,
+// returning an error if it cannot be compiled.
+func MatchesPatternE(str string, pattern string) (bool, error) {
+	re, err := matchPatternCache.compile(pattern, func() (*regexp.Regexp, error) {
+		// the pattern is checked alone, as anchoring an invalid pattern may make it valid
+		if _, err := syntax.Parse(pattern, syntax.Perl); err != nil {
+			return nil, err
+		}
+		return regexp.Compile(`\A(?:` + pattern + `)\z`)
+	})
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(str), nil
+}
+
 // Mid gets size characters from the middle of a string.
 func Mid(str string, pos int, size int) string {
-	if str == "" || size < 0 || pos > len(str) {
+	if str == "" || size < 0 || pos > utf8.RuneCountInString(str) {
 		return ""
 	}
 	if pos < 0 {
 		pos = 0
 	}
-	if len(str) <= pos+size {
-		return str[pos:]
-	}
-	return str[pos : pos+size]
+	start := runeOffset(str, pos)
+	return str[start : start+runeOffset(str[start:], size)]
 }
 
 // Overlay overlays part of a string with another string.
+// Start and end are expressed in characters (runes), not bytes.
 func Overlay(str string, overlay string, start int, end int) string {
-	strLen := len(str)
+	strLen := utf8.RuneCountInString(str)
 	// guards
 	if start < 0 {
 		start = 0
@@ -479,7 +568,7 @@ func Overlay(str string, overlay string, start int, end int) string {
 	if start > end {
 		start, end = end, start
 	}
-	return str[:start] + overlay + str[end:]
+	return str[:runeOffset(str, start)] + overlay + str[runeOffset(str, end):]
 }
 
 // Remove removes all occurrences of a substring from within the source string.
@@ -513,26 +602,36 @@ func RemoveEndIgnoreCase(str string, remove string) string {
 	if IsEmpty(str) || IsEmpty(remove) {
 		return str
 	}
-	if EndsWithIgnoreCase(str, remove) {
-		return str[:len(str)-len(remove)]
+	if start := newFoldedString(str).hasSuffix(FoldCase(remove)); start >= 0 {
+		return str[:start]
 	}
 	return str
 }
 
 // RemovePattern removes each substring of the source string that matches
-// the given regular expression
+// the given regular expression.
+// It panics if the regular expression cannot be compiled.
 func RemovePattern(str string, pattern string) string {
-	return regexp.MustCompile(pattern).ReplaceAllString(str, "")
+	return MustCompilePattern(pattern).ReplaceAllString(str, "")
+}
+
+// RemovePatternE removes each substring of the source string that matches
+// the given regular expression, returning an error if it cannot be compiled.
+func RemovePatternE(str string, pattern string) (string, error) {
+	return ReplaceAllPatternE(str, pattern, "")
 }
 
 // RemoveStart removes a substring only if it is at the beginning of a source string,
 // otherwise returns the source string
+//
+//	RemoveStart("www.domain.com", "www.") = "domain.com"
+//	RemoveStart("domain.com", "www.")     = "domain.com"
 func RemoveStart(str string, remove string) string {
 	if IsEmpty(str) || IsEmpty(remove) {
 		return str
 	}
 	if StartsWith(str, remove) {
-		return str[len(remove)+1:]
+		return str[len(remove):]
 	}
 	return str
 }
@@ -543,8 +642,8 @@ func RemoveStartIgnoreCase(str string, remove string) string {
 	if IsEmpty(str) || IsEmpty(remove) {
 		return str
 	}
-	if StartsWithIgnoreCase(str, remove) {
-		return str[len(remove)+1:]
+	if end := newFoldedString(str).hasPrefix(FoldCase(remove)); end >= 0 {
+		return str[end:]
 	}
 	return str
 }
@@ -573,6 +672,51 @@ func RepeatWithSeparator(str string, sep string, repeat int) string {
 	return buff
 }
 
+// ReplaceAllPattern replaces each substring of the source string that matches the given regular expression
+// with a replacement, in which $1 or ${name} stand for the submatches.
+// It panics if the regular expression cannot be compiled.
+func ReplaceAllPattern(str string, pattern string, replacement string) string {
+	return MustCompilePattern(pattern).ReplaceAllString(str, replacement)
+}
+
+// ReplaceAllPatternE replaces each substring of the source string that matches the given regular expression
+// with a replacement, in which $1 or ${name} stand for the submatches.
+// It returns an error if the regular expression cannot be compiled.
+func ReplaceAllPatternE(str string, pattern string, replacement string) (string, error) {
+	re, err := CompilePattern(pattern)
+	if err != nil {
+		return "", err
+	}
+	return re.ReplaceAllString(str, replacement), nil
+}
+
+// ReplacePattern replaces the first substring of the source string that matches the given regular expression
+// with a replacement, in which $1 or ${name} stand for the submatches.
+// It panics if the regular expression cannot be compiled.
+func ReplacePattern(str string, pattern string, replacement string) string {
+	return internalReplacePattern(MustCompilePattern(pattern), str, replacement)
+}
+
+// ReplacePatternE replaces the first substring of the source string that matches the given regular expression
+// with a replacement, in which $1 or ${name} stand for the submatches.
+// It returns an error if the regular expression cannot be compiled.
+func ReplacePatternE(str string, pattern string, replacement string) (string, error) {
+	re, err := CompilePattern(pattern)
+	if err != nil {
+		return "", err
+	}
+	return internalReplacePattern(re, str, replacement), nil
+}
+
+func internalReplacePattern(re *regexp.Regexp, str string, replacement string) string {
+	match := re.FindStringSubmatchIndex(str)
+	if match == nil {
+		return str
+	}
+	buff := re.ExpandString([]byte(str[:match[0]]), replacement, str, match)
+	return string(buff) + str[match[1]:]
+}
+
 // Reverse reverses a string.
 func Reverse(s string) string {
 	r := []rune(s)
@@ -596,25 +740,269 @@ func Right(str string, size int) string {
 	if str == "" || size < 0 {
 		return ""
 	}
-	if len(str) <= size {
+	strLen := utf8.RuneCountInString(str)
+	if strLen <= size {
+		return str
+	}
+	return str[runeOffset(str, strLen-size):]
+}
+
+// runeOffset returns the byte offset of the n-th character (rune) of a string,
+// or the length of the string if it has n characters or less.
+func runeOffset(str string, n int) int {
+	count := 0
+	for i := range str {
+		if count == n {
+			return i
+		}
+		count++
+	}
+	return len(str)
+}
+
+// RightPad right pads a string with spaces to a size of `size` characters.
+func RightPad(str string, size int) string {
+	return RightPadWithString(str, size, " ")
+}
+
+// RightPadWithString right pads a string with a pad string to a size of `size` characters.
+// The pad string is repeated and truncated as needed, an empty pad string is treated as a single space.
+func RightPadWithString(str string, size int, pad string) string {
+	pads := size - utf8.RuneCountInString(str)
+	if pads <= 0 {
 		return str
 	}
-	return str[len(str)-size:]
+	return str + internalPadding(pads, pad)
+}
+
+// Split splits a string into an array of tokens, using any of the characters of separatorChars as separator.
+// Adjacent separators are treated as one separator, and an empty separatorChars splits on whitespace.
+//
+//	Split("a.b.c", ".")    = ["a", "b", "c"]
+//	Split("a..b.c", ".")   = ["a", "b", "c"]
+//	Split("abc def", "")   = ["abc", "def"]
+//	Split("ab:cd:ef", ":") = ["ab", "cd", "ef"]
+func Split(str string, separatorChars string) []string {
+	return internalSplit(str, separatorChars, -1, false)
+}
+
+// SplitMax splits a string into an array of at most max tokens, using any of the characters of separatorChars
+// as separator. Adjacent separators are treated as one separator, and an empty separatorChars splits on whitespace.
+// The last token holds the rest of the string. A zero or negative max means no limit.
+//
+//	SplitMax("ab cd ef", "", 2)   = ["ab", "cd ef"]
+//	SplitMax("ab:cd:ef", ":", 2)  = ["ab", "cd:ef"]
+func SplitMax(str string, separatorChars string, max int) []string {
+	return internalSplit(str, separatorChars, max, false)
+}
+
+// SplitPreserveAllTokens splits a string into an array of tokens, using any of the characters of separatorChars
+// as separator. Adjacent separators are treated as separators for empty tokens, and an empty separatorChars
+// splits on whitespace.
+//
+//	SplitPreserveAllTokens("a..b.c", ".")   = ["a", "", "b", "c"]
+//	SplitPreserveAllTokens(":cd:ef:", ":")  = ["", "cd", "ef", ""]
+func SplitPreserveAllTokens(str string, separatorChars string) []string {
+	return internalSplit(str, separatorChars, -1, true)
+}
+
+// SplitPreserveAllTokensMax splits a string into an array of at most max tokens, using any of the characters
+// of separatorChars as separator. Adjacent separators are treated as separators for empty tokens, and
+// an empty separatorChars splits on whitespace. The last token holds the rest of the string.
+// A zero or negative max means no limit.
+//
+//	SplitPreserveAllTokensMax("ab   de fg", "", 2) = ["ab", "  de fg"]
+func SplitPreserveAllTokensMax(str string, separatorChars string, max int) []string {
+	return internalSplit(str, separatorChars, max, true)
+}
+
+// internalSplit splits a string on any of the characters of separatorChars, or on whitespace if it is empty.
+func internalSplit(str string, separatorChars string, max int, preserveAllTokens bool) []string {
+	list := []string{}
+	if str == "" {
+		return list
+	}
+	isSeparator := unicode.IsSpace
+	if separatorChars != "" {
+		isSeparator = func(r rune) bool {
+			return strings.ContainsRune(separatorChars, r)
+		}
+	}
+	start := 0
+	match, lastMatch := false, false
+	for i, c := range str {
+		if !isSeparator(c) {
+			lastMatch = false
+			match = true
+			continue
+		}
+		if match || preserveAllTokens {
+			if len(list)+1 == max {
+				return append(list, str[start:])
+			}
+			lastMatch = true
+			list = append(list, str[start:i])
+			match = false
+		}
+		// the width of an invalid byte, decoded as utf8.RuneError, is 1
+		_, size := utf8.DecodeRuneInString(str[i:])
+		start = i + size
+	}
+	if match || (preserveAllTokens && lastMatch) {
+		list = append(list, str[start:])
+	}
+	return list
+}
+
+// SplitByWholeSeparator splits a string into an array of tokens, using the whole separator string as separator.
+// Adjacent separators are treated as one separator, and an empty separator splits on whitespace.
+//
+//	SplitByWholeSeparator("ab-!-cd-!-ef", "-!-") = ["ab", "cd", "ef"]
+//	SplitByWholeSeparator("ab   de fg", "")      = ["ab", "de", "fg"]
+func SplitByWholeSeparator(str string, separator string) []string {
+	return internalSplitByWholeSeparator(str, separator, -1, false)
+}
+
+// SplitByWholeSeparatorMax splits a string into an array of at most max tokens, using the whole separator
+// string as separator. Adjacent separators are treated as one separator, and an empty separator splits
+// on whitespace. The last token holds the rest of the string. A zero or negative max means no limit.
+//
+//	SplitByWholeSeparatorMax("ab:cd:ef", ":", 2) = ["ab", "cd:ef"]
+func SplitByWholeSeparatorMax(str string, separator string, max int) []string {
+	return internalSplitByWholeSeparator(str, separator, max, false)
+}
+
+// SplitByWholeSeparatorPreserveAllTokens splits a string into an array of tokens, using the whole separator
+// string as separator. Adjacent separators are treated as separators for empty tokens, and an empty separator
+// splits on whitespace.
+//
+//	SplitByWholeSeparatorPreserveAllTokens("ab::cd", "::")     = ["ab", "cd"]
+//	SplitByWholeSeparatorPreserveAllTokens("ab::::cd", "::")   = ["ab", "", "cd"]
+func SplitByWholeSeparatorPreserveAllTokens(str string, separator string) []string {
+	return internalSplitByWholeSeparator(str, separator, -1, true)
+}
+
+// SplitByWholeSeparatorPreserveAllTokensMax splits a string into an array of at most max tokens, using
+// the whole separator string as separator. Adjacent separators are treated as separators for empty tokens,
+// and an empty separator splits on whitespace. The last token holds the rest of the string.
+// A zero or negative max means no limit.
+func SplitByWholeSeparatorPreserveAllTokensMax(str string, separator string, max int) []string {
+	return internalSplitByWholeSeparator(str, separator, max, true)
+}
+
+// internalSplitByWholeSeparator splits a string on a separator string, or on whitespace if it is empty.
+func internalSplitByWholeSeparator(str string, separator string, max int, preserveAllTokens bool) []string {
+	if separator == "" {
+		return internalSplit(str, "", max, preserveAllTokens)
+	}
+	list := []string{}
+	if str == "" {
+		return list
+	}
+	// as in Apache StringUtils, a separator ending the string is followed by an empty token
+	for beg, end := 0, 0; end < len(str); {
+		idx := strings.Index(str[beg:], separator)
+		if idx < 0 {
+			return append(list, str[beg:])
+		}
+		end = beg + idx
+		if end > beg || preserveAllTokens {
+			if len(list)+1 == max {
+				return append(list, str[beg:])
+			}
+			list = append(list, str[beg:end])
+		}
+		beg = end + len(separator)
+	}
+	return list
+}
+
+// SplitByCharacterType splits a string by Unicode general category, as returned by Character.getType in Java.
+// Groups of contiguous characters of the same category are returned as complete tokens.
+//
+//	SplitByCharacterType("ab de fg")    = ["ab", " ", "de", " ", "fg"]
+//	SplitByCharacterType("foo200Bar")   = ["foo", "200", "B", "ar"]
+//	SplitByCharacterType("ASFRules")    = ["ASFR", "ules"]
+func SplitByCharacterType(str string) []string {
+	return internalSplitByCharacterType(str, false)
+}
+
+// SplitByCharacterTypeCamelCase splits a string by Unicode general category, as returned by Character.getType
+// in Java, except that an upper case letter followed by lower case letters belongs to the lower case token.
+//
+//	SplitByCharacterTypeCamelCase("foo200Bar")   = ["foo", "200", "Bar"]
+//	SplitByCharacterTypeCamelCase("ASFRules")    = ["ASF", "Rules"]
+func SplitByCharacterTypeCamelCase(str string) []string {
+	return internalSplitByCharacterType(str, true)
+}
+
+// generalCategories holds the Unicode general categories, the most frequent ones first.
+// The lower case and upper case letters come first, at the lowercaseLetter and uppercaseLetter indexes.
+var generalCategories = []*unicode.RangeTable{
+	unicode.Ll, unicode.Lu, unicode.Nd, unicode.Zs, unicode.Po, unicode.Lo, unicode.Mn, unicode.Cc,
+	unicode.Pd, unicode.Ps, unicode.Pe, unicode.Pc, unicode.Pi, unicode.Pf, unicode.Sm, unicode.Sc,
+	unicode.Sk, unicode.So, unicode.Lt, unicode.Lm, unicode.Mc, unicode.Me, unicode.Nl, unicode.No,
+	unicode.Zl, unicode.Zp, unicode.Cf, unicode.Co, unicode.Cs,
+}
+
+const (
+	lowercaseLetter = 0
+	uppercaseLetter = 1
+)
+
+// generalCategory returns the Unicode general category of a character, as an index in generalCategories,
+// or -1 for unassigned characters.
+func generalCategory(r rune) int {
+	for i, category := range generalCategories {
+		if unicode.Is(category, r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// internalSplitByCharacterType splits a string by Unicode general category.
+func internalSplitByCharacterType(str string, camelCase bool) []string {
+	list := []string{}
+	if str == "" {
+		return list
+	}
+	// byte offsets of the current token and of the previous character
+	tokenStart, prev := 0, 0
+	first, _ := utf8.DecodeRuneInString(str)
+	currentType := generalCategory(first)
+	for pos, c := range str {
+		charType := generalCategory(c)
+		if charType != currentType {
+			if camelCase && charType == lowercaseLetter && currentType == uppercaseLetter {
+				if prev != tokenStart {
+					list = append(list, str[tokenStart:prev])
+					tokenStart = prev
+				}
+			} else {
+				list = append(list, str[tokenStart:pos])
+				tokenStart = pos
+			}
+		}
+		currentType = charType
+		prev = pos
+	}
+	return append(list, str[tokenStart:])
 }
 
 // Strip strips whitespace from the start and end of a String.
 func Strip(str string) string {
-	return regexp.MustCompile(`^\s+|\s+$`).ReplaceAllString(str, "")
+	return MustCompilePattern(`^\s+|\s+$`).ReplaceAllString(str, "")
 }
 
 // StripEnd strips whitespace from the end of a String.
 func StripEnd(str string) string {
-	return regexp.MustCompile(`\s+$`).ReplaceAllString(str, "")
+	return MustCompilePattern(`\s+$`).ReplaceAllString(str, "")
 }
 
 // StripStart strips whitespace from the start of a String.
 func StripStart(str string) string {
-	return regexp.MustCompile(`^\s+`).ReplaceAllString(str, "")
+	return MustCompilePattern(`^\s+`).ReplaceAllString(str, "")
 }
 
 // SubstringAfter gets the substring after the first occurrence of a separator.
@@ -670,6 +1058,14 @@ func SwapCase(str string) string {
 	return buff
 }
 
+// SwapCaseWithLocale changes upper and title case characters to lower case, and lower case characters
+// to upper case, following the rules of a locale.
+//
+//	SwapCaseWithLocale("Istanbul", "tr") = "ıSTANBUL"
+func SwapCaseWithLocale(str string, locale string) string {
+	return NewCaseMapper(locale).SwapCase(str)
+}
+
 // Trim removes control characters from both ends of this string.
 func Trim(str string) string {
 	return strings.Trim(str, " ")
@@ -687,6 +1083,14 @@ func Uncapitalize(str string) string {
 	return str
 }
 
+// UncapitalizeWithLocale changes the first character of a string to lower case following the rules of a locale.
+// The other characters are not changed.
+//
+//	UncapitalizeWithLocale("Istanbul", "tr") = "ıstanbul"
+func UncapitalizeWithLocale(str string, locale string) string {
+	return NewCaseMapper(locale).Uncapitalize(str)
+}
+
 // Wrap wraps a string with another string.
 func Wrap(str string, wrapWith string) string {
 	if str == "" {
@@ -700,11 +1104,8 @@ func internalStartsWith(str string, prefix string, ignoreCase bool) bool {
 	if str == "" || prefix == "" {
 		return (str == "" && prefix == "")
 	}
-	if utf8.RuneCountInString(prefix) > utf8.RuneCountInString(str) {
-		return false
-	}
 	if ignoreCase {
-		return strings.HasPrefix(strings.ToLower(str), strings.ToLower(prefix))
+		return newFoldedString(str).hasPrefix(FoldCase(prefix)) >= 0
 	}
 	return strings.HasPrefix(str, prefix)
 }
@@ -744,11 +1145,8 @@ func internalEndsWith(str string, suffix string, ignoreCase bool) bool {
 	if str == "" || suffix == "" {
 		return (str == "" && suffix == "")
 	}
-	if utf8.RuneCountInString(suffix) > utf8.RuneCountInString(str) {
-		return false
-	}
 	if ignoreCase {
-		return strings.HasSuffix(strings.ToLower(str), strings.ToLower(suffix))
+		return newFoldedString(str).hasSuffix(FoldCase(suffix)) >= 0
 	}
 	return strings.HasSuffix(str, suffix)
 }
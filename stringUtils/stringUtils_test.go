@@ -1,6 +1,9 @@
 package stringUtils
 
-import "testing"
+import (
+	"testing"
+	"unicode/utf8"
+)
 
 func TestAbbreviate(t *testing.T) {
 	if Abbreviate("", 4) != "" {
@@ -18,6 +21,15 @@ func TestAbbreviate(t *testing.T) {
 	if Abbreviate("abcdefg", 4) != "a..." {
 		t.Errorf("fail test abbreviate 4")
 	}
+	if Abbreviate("Hélène Dupré", 9) != "Hélène..." {
+		t.Errorf("fail test abbreviate 5")
+	}
+	if Abbreviate("日本語のテキスト", 8) != "日本語のテキスト" {
+		t.Errorf("fail test abbreviate 6")
+	}
+	if Abbreviate("日本語のテキスト", 7) != "日本語の..." {
+		t.Errorf("fail test abbreviate 7")
+	}
 }
 
 func TestAbbreviateWithOffset(t *testing.T) {
@@ -57,6 +69,12 @@ func TestAbbreviateWithOffset(t *testing.T) {
 	if AbbreviateWithOffset("abcdefghij", 5, 6) != "abcdefghij" {
 		t.Errorf("fail test abbreviate 11")
 	}
+	if AbbreviateWithOffset("àbçdéfghíjklmnø", 5, 10) != "...fghí..." {
+		t.Errorf("fail test abbreviate 12")
+	}
+	if AbbreviateWithOffset("àbçdéfghíjklmnø", 12, 10) != "...íjklmnø" {
+		t.Errorf("fail test abbreviate 13")
+	}
 }
 
 func TestAppendIfMissing(t *testing.T) {
@@ -105,6 +123,49 @@ func TestCapitalize(t *testing.T) {
 	if Capitalize("cAt") != "CAt" {
 		t.Errorf("fail test capitalize 3")
 	}
+	if Capitalize("élodie") != "Élodie" {
+		t.Errorf("fail test capitalize 4")
+	}
+	// an invalid first byte is kept, with the rest of the string
+	if Capitalize("\xff") != "\xff" || Capitalize("\xffab") != "\xffab" || Capitalize("a\xff") != "A\xff" {
+		t.Errorf("fail test capitalize 5")
+	}
+}
+
+func TestCenter(t *testing.T) {
+	if Center("", 4) != "    " {
+		t.Errorf("fail test Center 1")
+	}
+	if Center("ab", -1) != "ab" {
+		t.Errorf("fail test Center 2")
+	}
+	if Center("ab", 4) != " ab " {
+		t.Errorf("fail test Center 3")
+	}
+	if Center("abcd", 2) != "abcd" {
+		t.Errorf("fail test Center 4")
+	}
+	if Center("a", 4) != " a  " {
+		t.Errorf("fail test Center 5")
+	}
+	if Center("é", 3) != " é " {
+		t.Errorf("fail test Center 6")
+	}
+}
+
+func TestCenterWithString(t *testing.T) {
+	if CenterWithString("abc", 7, "yz") != "yzabcyz" {
+		t.Errorf("fail test CenterWithString 1")
+	}
+	if CenterWithString("a", 4, "yz") != "yayz" {
+		t.Errorf("fail test CenterWithString 2")
+	}
+	if CenterWithString("abc", 7, "") != "  abc  " {
+		t.Errorf("fail test CenterWithString 3")
+	}
+	if CenterWithString("日本", 6, "・") != "・・日本・・" {
+		t.Errorf("fail test CenterWithString 4")
+	}
 }
 
 func TestChomp(t *testing.T) {
@@ -414,6 +475,48 @@ func TestLeft(t *testing.T) {
 	if Left("foobar", -7) != "" {
 		t.Errorf("fail test Left 3")
 	}
+	if Left("Françoise", 5) != "Franç" {
+		t.Errorf("fail test Left 4")
+	}
+	if Left("山田太郎", 2) != "山田" {
+		t.Errorf("fail test Left 5")
+	}
+}
+
+func TestLeftPad(t *testing.T) {
+	if LeftPad("", 3) != "   " {
+		t.Errorf("fail test LeftPad 1")
+	}
+	if LeftPad("bat", 5) != "  bat" {
+		t.Errorf("fail test LeftPad 2")
+	}
+	if LeftPad("bat", 1) != "bat" {
+		t.Errorf("fail test LeftPad 3")
+	}
+	if LeftPad("bat", -1) != "bat" {
+		t.Errorf("fail test LeftPad 4")
+	}
+	if LeftPad("café", 6) != "  café" {
+		t.Errorf("fail test LeftPad 5")
+	}
+}
+
+func TestLeftPadWithString(t *testing.T) {
+	if LeftPadWithString("bat", 3, "yz") != "bat" {
+		t.Errorf("fail test LeftPadWithString 1")
+	}
+	if LeftPadWithString("bat", 5, "yz") != "yzbat" {
+		t.Errorf("fail test LeftPadWithString 2")
+	}
+	if LeftPadWithString("bat", 8, "yz") != "yzyzybat" {
+		t.Errorf("fail test LeftPadWithString 3")
+	}
+	if LeftPadWithString("bat", 5, "") != "  bat" {
+		t.Errorf("fail test LeftPadWithString 4")
+	}
+	if LeftPadWithString("日本", 5, "ーの") != "ーのー日本" {
+		t.Errorf("fail test LeftPadWithString 5")
+	}
 }
 
 func TestLowerCase(t *testing.T) {
@@ -447,6 +550,15 @@ func TestMid(t *testing.T) {
 	if Mid("abc", -2, 2) != "ab" {
 		t.Errorf("fail test Mid 6")
 	}
+	if Mid("Hélène", 1, 4) != "élèn" {
+		t.Errorf("fail test Mid 7")
+	}
+	if Mid("山田太郎", 2, 5) != "太郎" {
+		t.Errorf("fail test Mid 8")
+	}
+	if Mid("山田太郎", 5, 1) != "" {
+		t.Errorf("fail test Mid 9")
+	}
 }
 
 func TestOverlay(t *testing.T) {
@@ -477,6 +589,35 @@ func TestOverlay(t *testing.T) {
 	if Overlay("abcdef", "zzzz", 8, 10) != "abcdefzzzz" {
 		t.Errorf("fail test Overlay 9")
 	}
+	if Overlay("àéîõü", "-", 1, 3) != "à-õü" {
+		t.Errorf("fail test Overlay 10")
+	}
+	if Overlay("山田太郎", "花子", 2, 8) != "山田花子" {
+		t.Errorf("fail test Overlay 11")
+	}
+}
+
+func TestRuneSafety(t *testing.T) {
+	inputs := []string{"Hélène Dupré-Lefèvre", "山田太郎と鈴木花子", "a😀b😀c😀d😀e😀f"}
+	for _, str := range inputs {
+		strLen := utf8.RuneCountInString(str)
+		for i := -1; i <= strLen+1; i++ {
+			if !utf8.ValidString(Left(str, i)) || !utf8.ValidString(Right(str, i)) {
+				t.Errorf("fail test RuneSafety Left/Right %q %d", str, i)
+			}
+			if !utf8.ValidString(Abbreviate(str, i)) {
+				t.Errorf("fail test RuneSafety Abbreviate %q %d", str, i)
+			}
+			for j := -1; j <= strLen+1; j++ {
+				if !utf8.ValidString(Mid(str, i, j)) || !utf8.ValidString(Overlay(str, "x", i, j)) {
+					t.Errorf("fail test RuneSafety Mid/Overlay %q %d %d", str, i, j)
+				}
+				if !utf8.ValidString(AbbreviateWithOffset(str, i, j)) {
+					t.Errorf("fail test RuneSafety AbbreviateWithOffset %q %d %d", str, i, j)
+				}
+			}
+		}
+	}
 }
 
 func TestRemove(t *testing.T) {
@@ -509,6 +650,57 @@ func TestRepeatWithSeparator(t *testing.T) {
 	}
 }
 
+func TestRight(t *testing.T) {
+	if Right("", 2) != "" {
+		t.Errorf("fail test Right 1")
+	}
+	if Right("foobar", 3) != "bar" {
+		t.Errorf("fail test Right 2")
+	}
+	if Right("foobar", 10) != "foobar" {
+		t.Errorf("fail test Right 3")
+	}
+	if Right("foobar", -1) != "" {
+		t.Errorf("fail test Right 4")
+	}
+	if Right("Dupré", 2) != "ré" {
+		t.Errorf("fail test Right 5")
+	}
+	if Right("山田太郎", 2) != "太郎" {
+		t.Errorf("fail test Right 6")
+	}
+}
+
+func TestRightPad(t *testing.T) {
+	if RightPad("", 3) != "   " {
+		t.Errorf("fail test RightPad 1")
+	}
+	if RightPad("bat", 5) != "bat  " {
+		t.Errorf("fail test RightPad 2")
+	}
+	if RightPad("bat", 1) != "bat" {
+		t.Errorf("fail test RightPad 3")
+	}
+	if RightPad("ñu", 4) != "ñu  " {
+		t.Errorf("fail test RightPad 4")
+	}
+}
+
+func TestRightPadWithString(t *testing.T) {
+	if RightPadWithString("bat", 5, "yz") != "batyz" {
+		t.Errorf("fail test RightPadWithString 1")
+	}
+	if RightPadWithString("bat", 8, "yz") != "batyzyzy" {
+		t.Errorf("fail test RightPadWithString 2")
+	}
+	if RightPadWithString("bat", 5, "") != "bat  " {
+		t.Errorf("fail test RightPadWithString 3")
+	}
+	if RightPadWithString("été", 5, "à") != "étéàà" {
+		t.Errorf("fail test RightPadWithString 4")
+	}
+}
+
 func TestStrip(t *testing.T) {
 	if Strip("   abc   ") != "abc" {
 		t.Errorf("fail test Strip 1")
@@ -643,3 +835,245 @@ func TestPrependIfMissingIgnoreCase(t *testing.T) {
 		t.Errorf("fail test PrependIfMissingIgnoreCase 11")
 	}
 }
+
+func slicesEqual(a []string, b ...string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSplit(t *testing.T) {
+	if !slicesEqual(Split("", "")) || !slicesEqual(Split("  ", "")) {
+		t.Errorf("fail test Split 1")
+	}
+	if !slicesEqual(Split("abc def", ""), "abc", "def") || !slicesEqual(Split(" abc\t\n def  ", ""), "abc", "def") {
+		t.Errorf("fail test Split 2")
+	}
+	if !slicesEqual(Split("a..b.c", "."), "a", "b", "c") || !slicesEqual(Split("ab:cd;ef", ":;"), "ab", "cd", "ef") {
+		t.Errorf("fail test Split 3")
+	}
+	if !slicesEqual(Split("été·à·çà", "·"), "été", "à", "çà") {
+		t.Errorf("fail test Split 4")
+	}
+	// an invalid byte is one byte wide
+	if !slicesEqual(Split("a\xffbc", "\uFFFD"), "a", "bc") {
+		t.Errorf("fail test Split 5")
+	}
+}
+
+func TestSplitMax(t *testing.T) {
+	if !slicesEqual(SplitMax("ab cd ef", "", 0), "ab", "cd", "ef") || !slicesEqual(SplitMax("ab cd ef", "", 2), "ab", "cd ef") {
+		t.Errorf("fail test SplitMax 1")
+	}
+	if !slicesEqual(SplitMax("ab   cd ef", "", 2), "ab", "cd ef") || !slicesEqual(SplitMax("ab:cd:ef", ":", 1), "ab:cd:ef") {
+		t.Errorf("fail test SplitMax 2")
+	}
+}
+
+func TestSplitPreserveAllTokens(t *testing.T) {
+	if !slicesEqual(SplitPreserveAllTokens("", ".")) {
+		t.Errorf("fail test SplitPreserveAllTokens 1")
+	}
+	if !slicesEqual(SplitPreserveAllTokens("a..b.c", "."), "a", "", "b", "c") {
+		t.Errorf("fail test SplitPreserveAllTokens 2")
+	}
+	if !slicesEqual(SplitPreserveAllTokens(":cd:ef:", ":"), "", "cd", "ef", "") {
+		t.Errorf("fail test SplitPreserveAllTokens 3")
+	}
+	if !slicesEqual(SplitPreserveAllTokens(" ab  de ", ""), "", "ab", "", "de", "") {
+		t.Errorf("fail test SplitPreserveAllTokens 4")
+	}
+	if !slicesEqual(SplitPreserveAllTokensMax("ab   de fg", "", 2), "ab", "  de fg") || !slicesEqual(SplitPreserveAllTokensMax("ab:cd:ef", ":", 3), "ab", "cd", "ef") {
+		t.Errorf("fail test SplitPreserveAllTokens 5")
+	}
+	if !slicesEqual(SplitPreserveAllTokensMax("ab::cd", ":", 2), "ab", ":cd") {
+		t.Errorf("fail test SplitPreserveAllTokens 6")
+	}
+	if !slicesEqual(SplitPreserveAllTokens("a\xff", "\uFFFD"), "a", "") {
+		t.Errorf("fail test SplitPreserveAllTokens 7")
+	}
+}
+
+func TestSplitByWholeSeparator(t *testing.T) {
+	if !slicesEqual(SplitByWholeSeparator("", "-!-")) {
+		t.Errorf("fail test SplitByWholeSeparator 1")
+	}
+	if !slicesEqual(SplitByWholeSeparator("ab-!-cd-!-ef", "-!-"), "ab", "cd", "ef") || !slicesEqual(SplitByWholeSeparator("ab   de fg", ""), "ab", "de", "fg") {
+		t.Errorf("fail test SplitByWholeSeparator 2")
+	}
+	if !slicesEqual(SplitByWholeSeparator("abstemiouslyaeiouyabstemiously", "aeiouy"), "abstemiously", "abstemiously") {
+		t.Errorf("fail test SplitByWholeSeparator 3")
+	}
+	if !slicesEqual(SplitByWholeSeparator("ab::::cd", "::"), "ab", "cd") || !slicesEqual(SplitByWholeSeparator("ab::", "::"), "ab", "") {
+		t.Errorf("fail test SplitByWholeSeparator 4")
+	}
+	if !slicesEqual(SplitByWholeSeparatorMax("ab:cd:ef", ":", 2), "ab", "cd:ef") || !slicesEqual(SplitByWholeSeparatorMax("ab-!-cd-!-ef", "-!-", 5), "ab", "cd", "ef") {
+		t.Errorf("fail test SplitByWholeSeparator 5")
+	}
+}
+
+func TestSplitByWholeSeparatorPreserveAllTokens(t *testing.T) {
+	if !slicesEqual(SplitByWholeSeparatorPreserveAllTokens("ab::::cd", "::"), "ab", "", "cd") {
+		t.Errorf("fail test SplitByWholeSeparatorPreserveAllTokens 1")
+	}
+	if !slicesEqual(SplitByWholeSeparatorPreserveAllTokens("::ab::", "::"), "", "ab", "") {
+		t.Errorf("fail test SplitByWholeSeparatorPreserveAllTokens 2")
+	}
+	if !slicesEqual(SplitByWholeSeparatorPreserveAllTokens("ab   de fg", ""), "ab", "", "", "de", "fg") {
+		t.Errorf("fail test SplitByWholeSeparatorPreserveAllTokens 3")
+	}
+	if !slicesEqual(SplitByWholeSeparatorPreserveAllTokensMax("ab::::cd::ef", "::", 3), "ab", "", "cd::ef") {
+		t.Errorf("fail test SplitByWholeSeparatorPreserveAllTokens 4")
+	}
+}
+
+func TestSplitByCharacterType(t *testing.T) {
+	if !slicesEqual(SplitByCharacterType("")) || !slicesEqual(SplitByCharacterType("ab de fg"), "ab", " ", "de", " ", "fg") {
+		t.Errorf("fail test SplitByCharacterType 1")
+	}
+	if !slicesEqual(SplitByCharacterType("ab   de fg"), "ab", "   ", "de", " ", "fg") || !slicesEqual(SplitByCharacterType("ab:cd:ef"), "ab", ":", "cd", ":", "ef") {
+		t.Errorf("fail test SplitByCharacterType 2")
+	}
+	if !slicesEqual(SplitByCharacterType("number5"), "number", "5") || !slicesEqual(SplitByCharacterType("fooBar"), "foo", "B", "ar") {
+		t.Errorf("fail test SplitByCharacterType 3")
+	}
+	if !slicesEqual(SplitByCharacterType("foo200Bar"), "foo", "200", "B", "ar") || !slicesEqual(SplitByCharacterType("ASFRules"), "ASFR", "ules") {
+		t.Errorf("fail test SplitByCharacterType 4")
+	}
+}
+
+func TestSplitByCharacterTypeCamelCase(t *testing.T) {
+	if !slicesEqual(SplitByCharacterTypeCamelCase("")) || !slicesEqual(SplitByCharacterTypeCamelCase("ab de fg"), "ab", " ", "de", " ", "fg") {
+		t.Errorf("fail test SplitByCharacterTypeCamelCase 1")
+	}
+	if !slicesEqual(SplitByCharacterTypeCamelCase("number5"), "number", "5") || !slicesEqual(SplitByCharacterTypeCamelCase("fooBar"), "foo", "Bar") {
+		t.Errorf("fail test SplitByCharacterTypeCamelCase 2")
+	}
+	if !slicesEqual(SplitByCharacterTypeCamelCase("foo200Bar"), "foo", "200", "Bar") || !slicesEqual(SplitByCharacterTypeCamelCase("ASFRules"), "ASF", "Rules") {
+		t.Errorf("fail test SplitByCharacterTypeCamelCase 3")
+	}
+	if !slicesEqual(SplitByCharacterTypeCamelCase("ÉcoleÀParis"), "École", "À", "Paris") {
+		t.Errorf("fail test SplitByCharacterTypeCamelCase 4")
+	}
+}
+
+func TestMatchesPattern(t *testing.T) {
+	if MatchesPattern("abc123", `[a-z]+\d+`) != true || MatchesPattern("abc123!", `[a-z]+\d+`) != false {
+		t.Errorf("fail test MatchesPattern 1")
+	}
+	if MatchesPattern("ab", `a|ab`) != true {
+		t.Errorf("fail test MatchesPattern 2")
+	}
+	if _, err := MatchesPatternE("abc", `a(`); err == nil || err.Error() != "error parsing regexp: missing closing ): `a(`" {
+		t.Errorf("fail test MatchesPattern 3")
+	}
+	// invalid patterns which would be valid once anchored
+	if matches, err := MatchesPatternE("a", `a)(`); matches || err == nil {
+		t.Errorf("fail test MatchesPattern 4")
+	}
+	if matches, err := MatchesPatternE("xb", `a)|(b`); matches || err == nil || err.Error() != "error parsing regexp: unexpected ): `a)|(b`" {
+		t.Errorf("fail test MatchesPattern 5")
+	}
+}
+
+func TestRemoveStart(t *testing.T) {
+	if RemoveStart("", "a") != "" || RemoveStart("abc", "") != "abc" {
+		t.Errorf("fail test RemoveStart 1")
+	}
+	if RemoveStart("www.domain.com", "www.") != "domain.com" || RemoveStart("domain.com", "www.") != "domain.com" {
+		t.Errorf("fail test RemoveStart 2")
+	}
+	if RemoveStart("abc", "abc") != "" || RemoveStart("été", "é") != "té" {
+		t.Errorf("fail test RemoveStart 3")
+	}
+}
+
+func TestRemovePattern(t *testing.T) {
+	if RemovePattern("", "x") != "" || RemovePattern("any", "") != "any" {
+		t.Errorf("fail test RemovePattern 1")
+	}
+	if RemovePattern("A<__>\n<__>B", "<.*>") != "A\nB" || RemovePattern("ABCabc123", "[a-z]") != "ABC123" {
+		t.Errorf("fail test RemovePattern 2")
+	}
+	if s, err := RemovePatternE("A<__>\n<__>B", "(?s)<.*>"); err != nil || s != "AB" {
+		t.Errorf("fail test RemovePattern 3")
+	}
+	if s, err := RemovePatternE("abc", "["); err == nil || s != "" {
+		t.Errorf("fail test RemovePattern 4")
+	}
+}
+
+func TestReplacePattern(t *testing.T) {
+	if ReplacePattern("ABCabc123abc", "[a-z]", "_") != "ABC_bc123abc" || ReplacePattern("abc", "x", "_") != "abc" {
+		t.Errorf("fail test ReplacePattern 1")
+	}
+	if ReplacePattern("Lorem ipsum  dolor", `(\w+)\s+(\w+)`, "$2 ${1}") != "ipsum Lorem  dolor" {
+		t.Errorf("fail test ReplacePattern 2")
+	}
+	if s, err := ReplacePatternE("abc", "b", "$0$0"); err != nil || s != "abbc" {
+		t.Errorf("fail test ReplacePattern 3")
+	}
+	if _, err := ReplacePatternE("abc", "*", ""); err == nil {
+		t.Errorf("fail test ReplacePattern 4")
+	}
+}
+
+func TestReplaceAllPattern(t *testing.T) {
+	if ReplaceAllPattern("ABCabc123abc", "[a-z]", "_") != "ABC___123___" || ReplaceAllPattern("", "x", "_") != "" {
+		t.Errorf("fail test ReplaceAllPattern 1")
+	}
+	if ReplaceAllPattern("user=bob pass=secret", `pass=\S+`, "pass=***") != "user=bob pass=***" {
+		t.Errorf("fail test ReplaceAllPattern 2")
+	}
+	if s, err := ReplaceAllPatternE("a1b22", `(\d+)`, "<$1>"); err != nil || s != "a<1>b<22>" {
+		t.Errorf("fail test ReplaceAllPattern 3")
+	}
+	if _, err := ReplaceAllPatternE("abc", "(", ""); err == nil {
+		t.Errorf("fail test ReplaceAllPattern 4")
+	}
+}
+
+func TestCaseWithLocale(t *testing.T) {
+	if CapitalizeWithLocale("istanbul", "tr") != "İstanbul" {
+		t.Errorf("fail test CaseWithLocale 1")
+	}
+	if LowerCaseWithLocale("DİYARBAKIR", "tr") != "diyarbakır" || UpperCaseWithLocale("diyarbakır", "tr-TR") != "DİYARBAKIR" {
+		t.Errorf("fail test CaseWithLocale 2")
+	}
+	if SwapCaseWithLocale("Istanbul", "tr") != "ıSTANBUL" || UncapitalizeWithLocale("Istanbul", "tr") != "ıstanbul" {
+		t.Errorf("fail test CaseWithLocale 3")
+	}
+	if UpperCaseWithLocale("Μάιος", "el") != "ΜΑΪΟΣ" || LowerCaseWithLocale("ΟΔΟΣ", "") != "οδος" {
+		t.Errorf("fail test CaseWithLocale 4")
+	}
+}
+
+func TestIgnoreCaseFolding(t *testing.T) {
+	if !ContainsIgnoreCase("Die Straße", "STRASSE") || ContainsIgnoreCase("Straße", "STRAS") {
+		t.Errorf("fail test IgnoreCaseFolding 1")
+	}
+	if !StartsWithIgnoreCase("ßa", "SS") || !EndsWithIgnoreCase("Fuß", "FUSS") || EndsWithIgnoreCase("Fuß", "S") {
+		t.Errorf("fail test IgnoreCaseFolding 2")
+	}
+	if RemoveEndIgnoreCase("Die Straße", "STRASSE") != "Die " || RemoveEndIgnoreCase("aK", "k") != "a" {
+		t.Errorf("fail test IgnoreCaseFolding 3")
+	}
+	if RemoveStartIgnoreCase("STRASSE 1", "straße") != " 1" || RemoveStartIgnoreCase("www.domain.com", "WWW.") != "domain.com" {
+		t.Errorf("fail test IgnoreCaseFolding 4")
+	}
+	if AppendIfMissingIgnoreCase("Fuß", "SS") != "Fuß" || PrependIfMissingIgnoreCase("ßa", "ss") != "ßa" {
+		t.Errorf("fail test IgnoreCaseFolding 5")
+	}
+	if ReplaceEachIgnoreCase("Die Straße, die STRASSE", []string{"strasse"}, []string{"road"}) != "Die road, die road" {
+		t.Errorf("fail test IgnoreCaseFolding 6")
+	}
+	if ReplaceEachIgnoreCase("maße", []string{"s"}, []string{"z"}) != "maße" {
+		t.Errorf("fail test IgnoreCaseFolding 7")
+	}
+}
@@ -0,0 +1,197 @@
+package stringUtils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrCyclicSubstitution is returned when a variable refers to itself, directly or through other variables.
+var ErrCyclicSubstitution = errors.New("stringUtils: cyclic variable substitution")
+
+// ErrUndefinedVariable is returned by a Substitutor set to fail on undefined variables.
+var ErrUndefinedVariable = errors.New("stringUtils: undefined variable")
+
+// Lookup resolves the value of a variable, reporting whether it is defined.
+type Lookup func(name string) (string, bool)
+
+// MapLookup returns a Lookup resolving variables from a map.
+func MapLookup(values map[string]string) Lookup {
+	return func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+// EnvLookup is a Lookup resolving variables from the environment variables.
+func EnvLookup(name string) (string, bool) {
+	return os.LookupEnv(name)
+}
+
+// Substitutor replaces the variables found in a string, such as ${name}, by their values.
+//
+// A variable may have a default value used when it is not defined, as in ${name:-default}.
+// Variables are resolved recursively: the values and default values may contain variables themselves,
+// as may the names of the variables, as in ${user.${env}}. A variable preceded by the escape character
+// is not replaced, and the escape character is removed: $${name} gives ${name}.
+// Undefined variables without default value are left as is.
+type Substitutor struct {
+	// Lookup resolves the values of the variables.
+	Lookup Lookup
+	// Prefix starts a variable, "${" by default.
+	Prefix string
+	// Suffix ends a variable, "}" by default.
+	Suffix string
+	// Escape escapes a variable when placed right before the prefix, '$' by default. 0 disables escaping.
+	Escape rune
+	// DefaultDelimiter separates the name of a variable from its default value, ":-" by default.
+	// An empty delimiter disables default values.
+	DefaultDelimiter string
+	// FailOnUndefined makes Replace return ErrUndefinedVariable for undefined variables
+	// without default value instead of leaving them as is.
+	FailOnUndefined bool
+}
+
+// NewSubstitutor creates a Substitutor resolving variables with a Lookup, using the ${name:-default} syntax.
+func NewSubstitutor(lookup Lookup) *Substitutor {
+	return &Substitutor{Lookup: lookup, Prefix: "${", Suffix: "}", Escape: '$', DefaultDelimiter: ":-"}
+}
+
+// Substitute replaces the ${name} variables found in a string by their values in a map.
+//
+//	Substitute("Hello ${name}!", map[string]string{"name": "World"}) = "Hello World!", nil
+//	Substitute("${port:-8080}", map[string]string{})                 = "8080", nil
+func Substitute(str string, values map[string]string) (string, error) {
+	return NewSubstitutor(MapLookup(values)).Replace(str)
+}
+
+// SubstituteEnv replaces the ${name} variables found in a string by the values of the environment variables.
+func SubstituteEnv(str string) (string, error) {
+	return NewSubstitutor(EnvLookup).Replace(str)
+}
+
+// Replace replaces the variables found in a string by their values.
+// It returns ErrCyclicSubstitution, wrapped with the cycle, when a variable refers to itself.
+//
+//	Replace("${a}") with a=${b}, b=${a} returns an error wrapping ErrCyclicSubstitution: "a -> b -> a"
+func (s *Substitutor) Replace(str string) (string, error) {
+	if s.Prefix == "" || s.Suffix == "" {
+		return str, nil
+	}
+	return s.substitute(str, nil)
+}
+
+// isEscaped checks if a string starts with the escape character followed by the prefix.
+func (s *Substitutor) isEscaped(str string) (bool, int) {
+	if s.Escape == 0 {
+		return false, 0
+	}
+	r, size := utf8.DecodeRuneInString(str)
+	return r == s.Escape && strings.HasPrefix(str[size:], s.Prefix), size
+}
+
+// variableEnd returns the position of the suffix closing the variable which content starts a string,
+// taking nested variables into account, or -1 if the variable is not closed.
+func (s *Substitutor) variableEnd(str string) int {
+	depth := 1
+	for i := 0; i < len(str); {
+		if escaped, size := s.isEscaped(str[i:]); escaped {
+			i += size + len(s.Prefix)
+			continue
+		}
+		if strings.HasPrefix(str[i:], s.Prefix) {
+			depth++
+			i += len(s.Prefix)
+			continue
+		}
+		if strings.HasPrefix(str[i:], s.Suffix) {
+			depth--
+			if depth == 0 {
+				return i
+			}
+			i += len(s.Suffix)
+			continue
+		}
+		i++
+	}
+	return -1
+}
+
+// splitDefault splits the content of a variable into its name and its default value,
+// ignoring the delimiters found inside nested variables.
+func (s *Substitutor) splitDefault(variable string) (string, string, bool) {
+	if s.DefaultDelimiter == "" {
+		return variable, "", false
+	}
+	for i := 0; i < len(variable); {
+		if strings.HasPrefix(variable[i:], s.Prefix) {
+			end := s.variableEnd(variable[i+len(s.Prefix):])
+			if end < 0 {
+				break
+			}
+			i += len(s.Prefix) + end + len(s.Suffix)
+			continue
+		}
+		if strings.HasPrefix(variable[i:], s.DefaultDelimiter) {
+			return variable[:i], variable[i+len(s.DefaultDelimiter):], true
+		}
+		i++
+	}
+	return variable, "", false
+}
+
+// substitute replaces the variables of a string, stack holding the names of the variables being resolved.
+func (s *Substitutor) substitute(str string, stack []string) (string, error) {
+	var buff strings.Builder
+	for i := 0; i < len(str); {
+		if escaped, size := s.isEscaped(str[i:]); escaped {
+			buff.WriteString(s.Prefix)
+			i += size + len(s.Prefix)
+			continue
+		}
+		if !strings.HasPrefix(str[i:], s.Prefix) {
+			buff.WriteByte(str[i])
+			i++
+			continue
+		}
+		start := i + len(s.Prefix)
+		end := s.variableEnd(str[start:])
+		if end < 0 {
+			buff.WriteString(str[i:])
+			break
+		}
+		value, err := s.resolve(str[i:start+end+len(s.Suffix)], str[start:start+end], stack)
+		if err != nil {
+			return "", err
+		}
+		buff.WriteString(value)
+		i = start + end + len(s.Suffix)
+	}
+	return buff.String(), nil
+}
+
+// resolve returns the value of a variable, given its original text and its content.
+func (s *Substitutor) resolve(original string, variable string, stack []string) (string, error) {
+	name, defaultValue, hasDefault := s.splitDefault(variable)
+	name, err := s.substitute(name, stack)
+	if err != nil {
+		return "", err
+	}
+	for _, resolving := range stack {
+		if resolving == name {
+			return "", fmt.Errorf("%w: %s", ErrCyclicSubstitution, strings.Join(append(stack, name), " -> "))
+		}
+	}
+	if value, ok := s.Lookup(name); ok {
+		return s.substitute(value, append(stack[:len(stack):len(stack)], name))
+	}
+	if hasDefault {
+		return s.substitute(defaultValue, stack)
+	}
+	if s.FailOnUndefined {
+		return "", fmt.Errorf("%w: %s", ErrUndefinedVariable, name)
+	}
+	return original, nil
+}
@@ -0,0 +1,80 @@
+package stringUtils
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+	values := map[string]string{
+		"name":      "World",
+		"greeting":  "Hello ${name}",
+		"env":       "prod",
+		"host.prod": "example.com",
+	}
+	if s, err := Substitute("${greeting}!", values); err != nil || s != "Hello World!" {
+		t.Errorf("fail test Substitute 1")
+	}
+	if s, err := Substitute("${port:-8080} ${name:-nobody}", values); err != nil || s != "8080 World" {
+		t.Errorf("fail test Substitute 2")
+	}
+	if s, err := Substitute("$${name} costs $5", values); err != nil || s != "${name} costs $5" {
+		t.Errorf("fail test Substitute 3")
+	}
+	if s, err := Substitute("${host.${env}}", values); err != nil || s != "example.com" {
+		t.Errorf("fail test Substitute 4")
+	}
+	if s, err := Substitute("${missing} ${unclosed", values); err != nil || s != "${missing} ${unclosed" {
+		t.Errorf("fail test Substitute 5")
+	}
+	if s, err := Substitute("${port:-${name:-x}:-y}", values); err != nil || s != "World:-y" {
+		t.Errorf("fail test Substitute 6")
+	}
+	if s, err := Substitute("", values); err != nil || s != "" {
+		t.Errorf("fail test Substitute 7")
+	}
+}
+
+func TestSubstituteCycle(t *testing.T) {
+	values := map[string]string{"a": "${b}", "b": "x${a}", "c": "${c}", "d": "${a:-ok}"}
+	if _, err := Substitute("${a}", values); !errors.Is(err, ErrCyclicSubstitution) || err.Error() != "stringUtils: cyclic variable substitution: a -> b -> a" {
+		t.Errorf("fail test SubstituteCycle 1")
+	}
+	if _, err := Substitute("${c}", values); !errors.Is(err, ErrCyclicSubstitution) {
+		t.Errorf("fail test SubstituteCycle 2")
+	}
+	// the same variable may be used several times without forming a cycle
+	if s, err := Substitute("${e}${e}", map[string]string{"e": "${f}${f}", "f": "1"}); err != nil || s != "1111" {
+		t.Errorf("fail test SubstituteCycle 3")
+	}
+}
+
+func TestSubstituteEnv(t *testing.T) {
+	os.Setenv("GO_COMMONS_LANG_TEST", "bar")
+	defer os.Unsetenv("GO_COMMONS_LANG_TEST")
+	if s, err := SubstituteEnv("foo=${GO_COMMONS_LANG_TEST}"); err != nil || s != "foo=bar" {
+		t.Errorf("fail test SubstituteEnv 1")
+	}
+}
+
+func TestSubstitutor(t *testing.T) {
+	s := NewSubstitutor(func(name string) (string, bool) {
+		return UpperCase(name), true
+	})
+	if r, err := s.Replace("${a} ${b}"); err != nil || r != "A B" {
+		t.Errorf("fail test Substitutor 1")
+	}
+	s = &Substitutor{Lookup: MapLookup(map[string]string{"a": "1"}), Prefix: "%(", Suffix: ")", Escape: '\\'}
+	if r, err := s.Replace(`%(a) \%(a) %(b:-2)`); err != nil || r != "1 %(a) %(b:-2)" {
+		t.Errorf("fail test Substitutor 2")
+	}
+	s = NewSubstitutor(MapLookup(map[string]string{"a": "${b}"}))
+	s.FailOnUndefined = true
+	if _, err := s.Replace("${a}"); !errors.Is(err, ErrUndefinedVariable) || err.Error() != "stringUtils: undefined variable: b" {
+		t.Errorf("fail test Substitutor 3")
+	}
+	if r, err := s.Replace("${b:-}"); err != nil || r != "" {
+		t.Errorf("fail test Substitutor 4")
+	}
+}
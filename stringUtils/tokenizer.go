@@ -0,0 +1,335 @@
+package stringUtils
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tokenizerLookahead is the minimum number of bytes of input given to the matchers of a Tokenizer.
+const tokenizerLookahead = 64
+
+// tokenizerChunkSize is the number of bytes a Tokenizer reads at once.
+const tokenizerChunkSize = 4096
+
+// Matcher matches the beginning of an input, used by a Tokenizer to find delimiters, quotes,
+// and characters to ignore or trim.
+type Matcher interface {
+	// Match returns the number of bytes matched at the beginning of input, zero meaning no match.
+	// The input holds at least the next 64 bytes of the text being tokenized, or its remaining bytes,
+	// so a match longer than 64 bytes may not be found.
+	Match(input string) int
+}
+
+// MatcherFunc adapts a function to the Matcher interface.
+type MatcherFunc func(input string) int
+
+// Match calls f(input).
+func (f MatcherFunc) Match(input string) int {
+	return f(input)
+}
+
+// CharMatcher matches a single character.
+func CharMatcher(c rune) Matcher {
+	return CharSetMatcher(string(c))
+}
+
+// CharSetMatcher matches any of the characters of a string.
+func CharSetMatcher(chars string) Matcher {
+	return MatcherFunc(func(input string) int {
+		r, size := utf8.DecodeRuneInString(input)
+		if input == "" || !strings.ContainsRune(chars, r) {
+			return 0
+		}
+		return size
+	})
+}
+
+// StringMatcher matches a string. It panics if the string is longer than 64 bytes, the input
+// given to the matchers by a Tokenizer being possibly shorter.
+func StringMatcher(str string) Matcher {
+	if len(str) > tokenizerLookahead {
+		panic("stringUtils: StringMatcher(" + strconv.Quote(str) + "): longer than 64 bytes")
+	}
+	return MatcherFunc(func(input string) int {
+		if str == "" || !strings.HasPrefix(input, str) {
+			return 0
+		}
+		return len(str)
+	})
+}
+
+// NoneMatcher matches nothing.
+var NoneMatcher Matcher = MatcherFunc(func(input string) int { return 0 })
+
+// SplitMatcher matches the whitespace characters used to split words: space, tab, new line, carriage return and form feed.
+var SplitMatcher = CharSetMatcher(" \t\n\r\f")
+
+// TrimMatcher matches the characters trimmed by strings.TrimSpace in ASCII, that is any control character or space.
+var TrimMatcher Matcher = MatcherFunc(func(input string) int {
+	if input == "" || input[0] > ' ' {
+		return 0
+	}
+	return 1
+})
+
+// CommaMatcher matches a comma.
+var CommaMatcher = CharMatcher(',')
+
+// TabMatcher matches a tab.
+var TabMatcher = CharMatcher('\t')
+
+// QuoteMatcher matches a single or a double quote.
+var QuoteMatcher = CharSetMatcher(`'"`)
+
+// DoubleQuoteMatcher matches a double quote.
+var DoubleQuoteMatcher = CharMatcher('"')
+
+// SingleQuoteMatcher matches a single quote.
+var SingleQuoteMatcher = CharMatcher('\'')
+
+// BackslashMatcher matches a backslash.
+var BackslashMatcher = CharMatcher('\\')
+
+// Tokenizer splits a text into tokens, modeled on Apache StrTokenizer.
+//
+// Tokens are separated by delimiters. A token starting with a quote extends up to the same closing quote,
+// delimiters included, and a doubled quote inside it stands for a single quote. Ignored characters are
+// removed from the tokens, outside of quotes, and trimmed characters are removed from both ends of
+// the tokens. When an escape matcher is set, the character following an escape is always kept as is.
+//
+// The text is read lazily as tokens are requested, like with a bufio.Scanner:
+//
+//	t := NewTokenizer("a b  c")
+//	for t.Next() {
+//		fmt.Println(t.Token())
+//	}
+//
+// The matchers and options may be changed before the first call to Next.
+type Tokenizer struct {
+	// Delimiter matches the delimiters between tokens, SplitMatcher by default.
+	Delimiter Matcher
+	// Quote matches the quotes around tokens, NoneMatcher by default.
+	Quote Matcher
+	// Ignored matches the characters removed from the tokens, NoneMatcher by default.
+	Ignored Matcher
+	// Trimmer matches the characters removed from both ends of the tokens, NoneMatcher by default.
+	Trimmer Matcher
+	// Escape matches the escapes keeping the character which follows them as is, NoneMatcher by default.
+	Escape Matcher
+	// IgnoreEmptyTokens skips the empty tokens, true by default.
+	IgnoreEmptyTokens bool
+
+	reader io.Reader
+	// window holds the bytes read from the reader but not consumed yet
+	window string
+	eof    bool
+	token  string
+	err    error
+	// trailing is set when a delimiter ends the text, which is followed by an empty token
+	trailing bool
+	done     bool
+}
+
+// NewTokenizer creates a Tokenizer splitting a string on whitespace and ignoring empty tokens.
+//
+//	NewTokenizer("a b  c").Tokens() = ["a", "b", "c"], nil
+func NewTokenizer(str string) *Tokenizer {
+	return NewTokenizerReader(strings.NewReader(str))
+}
+
+// NewTokenizerReader creates a Tokenizer splitting the text read from a reader on whitespace and
+// ignoring empty tokens. The text is read as tokens are requested.
+func NewTokenizerReader(r io.Reader) *Tokenizer {
+	return &Tokenizer{
+		Delimiter:         SplitMatcher,
+		Quote:             NoneMatcher,
+		Ignored:           NoneMatcher,
+		Trimmer:           NoneMatcher,
+		Escape:            NoneMatcher,
+		IgnoreEmptyTokens: true,
+		reader:            r,
+	}
+}
+
+// NewCommandLineTokenizer creates a Tokenizer splitting a command line on whitespace, handling single and
+// double quotes as well as backslash escapes.
+//
+//	NewCommandLineTokenizer(`git commit -m "fix bug" a\ b`).Tokens() = ["git", "commit", "-m", "fix bug", "a b"], nil
+func NewCommandLineTokenizer(str string) *Tokenizer {
+	t := NewTokenizer(str)
+	t.Quote = QuoteMatcher
+	t.Escape = BackslashMatcher
+	return t
+}
+
+// NewCSVTokenizer creates a Tokenizer splitting a line of comma separated values, handling double quotes,
+// trimming the values and keeping the empty ones.
+//
+//	NewCSVTokenizer(`a, "b,c",,d`).Tokens() = ["a", "b,c", "", "d"], nil
+func NewCSVTokenizer(str string) *Tokenizer {
+	t := NewTokenizer(str)
+	t.Delimiter = CommaMatcher
+	t.Quote = DoubleQuoteMatcher
+	t.Trimmer = TrimMatcher
+	t.IgnoreEmptyTokens = false
+	return t
+}
+
+// NewTSVTokenizer creates a Tokenizer splitting a line of tab separated values, handling double quotes,
+// trimming the values and keeping the empty ones.
+func NewTSVTokenizer(str string) *Tokenizer {
+	t := NewCSVTokenizer(str)
+	t.Delimiter = TabMatcher
+	return t
+}
+
+// Next advances the tokenizer to the next token, which is then available through Token.
+// It returns false when there are no more tokens or when reading the text failed.
+func (t *Tokenizer) Next() bool {
+	for !t.done {
+		token, ok := t.readToken()
+		if !ok {
+			t.done = true
+			break
+		}
+		if token != "" || !t.IgnoreEmptyTokens {
+			t.token = token
+			return true
+		}
+	}
+	t.token = ""
+	return false
+}
+
+// Token returns the token found by the last call to Next.
+func (t *Tokenizer) Token() string {
+	return t.token
+}
+
+// Err returns the first error met while reading the text, other than io.EOF.
+func (t *Tokenizer) Err() error {
+	return t.err
+}
+
+// Tokens returns all the remaining tokens.
+func (t *Tokenizer) Tokens() ([]string, error) {
+	tokens := []string{}
+	for t.Next() {
+		tokens = append(tokens, t.Token())
+	}
+	return tokens, t.Err()
+}
+
+// peek returns the next bytes of the text, at least tokenizerLookahead of them unless the text ends before,
+// empty at the end of the text or on error. The text is read by chunks, each converted to a string once.
+func (t *Tokenizer) peek() string {
+	for len(t.window) < tokenizerLookahead && !t.eof {
+		chunk := make([]byte, tokenizerChunkSize)
+		n, err := t.reader.Read(chunk)
+		t.window += string(chunk[:n])
+		if err == io.EOF {
+			t.eof = true
+		} else if err != nil {
+			t.err = err
+			t.eof = true
+			t.window = ""
+		}
+	}
+	return t.window
+}
+
+// discard skips n bytes of the text.
+func (t *Tokenizer) discard(n int) {
+	t.window = t.window[n:]
+}
+
+// readToken reads the next token, including empty ones, returning false at the end of the text.
+func (t *Tokenizer) readToken() (string, bool) {
+	input := t.peek()
+	// skip the leading ignored and trimmed characters, unless they are delimiters or quotes
+	for input != "" {
+		removeLen := maxInt(t.Ignored.Match(input), t.Trimmer.Match(input))
+		if removeLen == 0 || t.Delimiter.Match(input) > 0 || t.Quote.Match(input) > 0 {
+			break
+		}
+		t.discard(removeLen)
+		input = t.peek()
+	}
+	if input == "" {
+		if t.trailing {
+			t.trailing = false
+			return "", true
+		}
+		return "", false
+	}
+	t.trailing = false
+	if delimLen := t.Delimiter.Match(input); delimLen > 0 {
+		t.discard(delimLen)
+		t.trailing = true
+		return "", true
+	}
+	quote := ""
+	if quoteLen := t.Quote.Match(input); quoteLen > 0 {
+		quote = input[:quoteLen]
+		t.discard(quoteLen)
+	}
+	return t.readWithQuotes(quote), true
+}
+
+// readWithQuotes reads a token up to the next delimiter, the token being quoted with quote if not empty.
+func (t *Tokenizer) readWithQuotes(quote string) string {
+	var buff strings.Builder
+	quoting := quote != ""
+	// length of the token once the trailing trimmed characters are removed
+	trimStart := 0
+	for input := t.peek(); input != ""; input = t.peek() {
+		if escapeLen := t.Escape.Match(input); escapeLen > 0 {
+			_, size := utf8.DecodeRuneInString(input[escapeLen:])
+			buff.WriteString(input[escapeLen : escapeLen+size])
+			t.discard(escapeLen + size)
+			trimStart = buff.Len()
+			continue
+		}
+		if quoting {
+			if strings.HasPrefix(input, quote) {
+				t.discard(len(quote))
+				if strings.HasPrefix(t.peek(), quote) {
+					// a doubled quote stands for a single quote
+					buff.WriteString(quote)
+					t.discard(len(quote))
+					trimStart = buff.Len()
+				} else {
+					quoting = false
+				}
+				continue
+			}
+		} else {
+			if delimLen := t.Delimiter.Match(input); delimLen > 0 {
+				t.discard(delimLen)
+				t.trailing = true
+				break
+			}
+			if quote != "" && strings.HasPrefix(input, quote) {
+				quoting = true
+				t.discard(len(quote))
+				continue
+			}
+			if ignoredLen := t.Ignored.Match(input); ignoredLen > 0 {
+				t.discard(ignoredLen)
+				continue
+			}
+			if trimmedLen := t.Trimmer.Match(input); trimmedLen > 0 {
+				buff.WriteString(input[:trimmedLen])
+				t.discard(trimmedLen)
+				continue
+			}
+		}
+		_, size := utf8.DecodeRuneInString(input)
+		buff.WriteString(input[:size])
+		t.discard(size)
+		trimStart = buff.Len()
+	}
+	return buff.String()[:trimStart]
+}
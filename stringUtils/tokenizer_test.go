@@ -0,0 +1,140 @@
+package stringUtils
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func tokensEqual(t *Tokenizer, expected ...string) bool {
+	tokens, err := t.Tokens()
+	if err != nil || len(tokens) != len(expected) {
+		return false
+	}
+	for i := range tokens {
+		if tokens[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTokenizer(t *testing.T) {
+	if !tokensEqual(NewTokenizer(" a b\t\tc\n"), "a", "b", "c") {
+		t.Errorf("fail test Tokenizer 1")
+	}
+	if !tokensEqual(NewTokenizer("")) || !tokensEqual(NewTokenizer("   ")) {
+		t.Errorf("fail test Tokenizer 2")
+	}
+	tokenizer := NewTokenizer("a  b ")
+	tokenizer.IgnoreEmptyTokens = false
+	if !tokensEqual(tokenizer, "a", "", "b", "") {
+		t.Errorf("fail test Tokenizer 3")
+	}
+	tokenizer = NewTokenizer("a::b::::c")
+	tokenizer.Delimiter = StringMatcher("::")
+	if !tokensEqual(tokenizer, "a", "b", "c") {
+		t.Errorf("fail test Tokenizer 4")
+	}
+	tokenizer = NewTokenizer("a-b c-d")
+	tokenizer.Ignored = CharMatcher('-')
+	if !tokensEqual(tokenizer, "ab", "cd") {
+		t.Errorf("fail test Tokenizer 5")
+	}
+	tokenizer = NewTokenizer("a;é;😀")
+	tokenizer.Delimiter = CharSetMatcher(";")
+	if !tokensEqual(tokenizer, "a", "é", "😀") {
+		t.Errorf("fail test Tokenizer 6")
+	}
+}
+
+func TestTokenizerQuotes(t *testing.T) {
+	if !tokensEqual(NewCommandLineTokenizer(`git commit -m "fix bug" a\ b 'it''s'`), "git", "commit", "-m", "fix bug", "a b", "it's") {
+		t.Errorf("fail test TokenizerQuotes 1")
+	}
+	if !tokensEqual(NewCommandLineTokenizer(`"a"b"c d" "unclosed e`), "abc d", "unclosed e") {
+		t.Errorf("fail test TokenizerQuotes 2")
+	}
+	if !tokensEqual(NewCommandLineTokenizer(`"" \"x\" end\`), `"x"`, "end") {
+		t.Errorf("fail test TokenizerQuotes 3")
+	}
+}
+
+func TestCSVTokenizer(t *testing.T) {
+	if !tokensEqual(NewCSVTokenizer(`a, "b,c",,d`), "a", "b,c", "", "d") {
+		t.Errorf("fail test CSVTokenizer 1")
+	}
+	if !tokensEqual(NewCSVTokenizer(`" a ""quoted"" b " , c ,`), ` a "quoted" b `, "c", "") {
+		t.Errorf("fail test CSVTokenizer 2")
+	}
+	if !tokensEqual(NewCSVTokenizer("")) || !tokensEqual(NewCSVTokenizer(","), "", "") {
+		t.Errorf("fail test CSVTokenizer 3")
+	}
+	if !tokensEqual(NewTSVTokenizer("a\t b\t\t"), "a", "b", "", "") {
+		t.Errorf("fail test CSVTokenizer 4")
+	}
+}
+
+type failingReader struct {
+	r io.Reader
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if err == io.EOF {
+		return n, errors.New("read failed")
+	}
+	return n, err
+}
+
+func TestTokenizerReader(t *testing.T) {
+	long := strings.Repeat("x", 10000)
+	tokenizer := NewTokenizerReader(strings.NewReader(strings.Repeat(long+" ", 100)))
+	count := 0
+	for tokenizer.Next() {
+		if tokenizer.Token() != long {
+			t.Errorf("fail test TokenizerReader 1")
+		}
+		count++
+	}
+	if count != 100 || tokenizer.Err() != nil || tokenizer.Token() != "" {
+		t.Errorf("fail test TokenizerReader 2")
+	}
+	tokenizer = NewTokenizerReader(&failingReader{strings.NewReader("a b")})
+	if _, err := tokenizer.Tokens(); err == nil {
+		t.Errorf("fail test TokenizerReader 3")
+	}
+	// a delimiter read across two chunks
+	tokenizer = NewTokenizerReader(iotest.OneByteReader(strings.NewReader("a<->b<->c")))
+	tokenizer.Delimiter = StringMatcher("<->")
+	if !tokensEqual(tokenizer, "a", "b", "c") {
+		t.Errorf("fail test TokenizerReader 4")
+	}
+	tokenizer = NewTokenizerReader(strings.NewReader(strings.Repeat("y", tokenizerChunkSize-1) + "<->z"))
+	tokenizer.Delimiter = StringMatcher("<->")
+	if !tokensEqual(tokenizer, strings.Repeat("y", tokenizerChunkSize-1), "z") {
+		t.Errorf("fail test TokenizerReader 5")
+	}
+	// the text is converted to strings by chunks, not byte by byte
+	text := strings.Repeat("x", 100000)
+	allocs := testing.AllocsPerRun(5, func() {
+		NewTokenizer(text).Tokens()
+	})
+	if allocs > 1000 {
+		t.Errorf("fail test TokenizerReader 6")
+	}
+}
+
+func TestStringMatcher(t *testing.T) {
+	if StringMatcher("ab").Match("abc") != 2 || StringMatcher("ab").Match("a") != 0 || StringMatcher("").Match("a") != 0 {
+		t.Errorf("fail test StringMatcher 1")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("fail test StringMatcher 2")
+		}
+	}()
+	StringMatcher(strings.Repeat("-", tokenizerLookahead+1))
+}
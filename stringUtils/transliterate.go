@@ -0,0 +1,193 @@
+package stringUtils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// transliterations holds the ASCII transliterations of the lower case and caseless characters which have
+// no decomposition to ASCII. The upper case characters use the transliteration of their lower case form.
+// Greek follows ELOT 743 and Cyrillic the common English romanizations, without diacritics.
+var transliterations = map[rune]string{
+	// Latin
+	'ß': "ss", 'æ': "ae", 'ð': "d", 'ø': "o", 'þ': "th", 'đ': "d", 'ħ': "h", 'ı': "i", 'ĸ': "q", 'ł': "l",
+	'ŋ': "ng", 'œ': "oe", 'ŧ': "t", 'ſ': "s", 'ƀ': "b", 'ƒ': "f", 'ƙ': "k", 'ƚ': "l", 'ƞ': "n", 'ƥ': "p",
+	'ƫ': "t", 'ƭ': "t", 'ƴ': "y", 'ƶ': "z", 'ǝ': "e", 'ȥ': "z", 'ɓ': "b", 'ɖ': "d", 'ɗ': "d", 'ə': "e",
+	'ɛ': "e", 'ɠ': "g", 'ɣ': "g", 'ɨ': "i", 'ɩ': "i", 'ɲ': "n", 'ɵ': "o", 'ʃ': "sh", 'ʉ': "u", 'ʋ': "v",
+	'ʒ': "zh",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+	// punctuation and symbols
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", '“': "\"", '”': "\"", '„': "\"", '‟': "\"", '″': "\"",
+	'«': "<<", '»': ">>", '‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-", '•': "*",
+	'·': ".", '¿': "?", '¡': "!", '×': "x", '÷': "/", '©': "(c)", '®': "(r)", '€': "EUR", '£': "GBP",
+	'¥': "JPY", '、': ",", '。': ".", '「': "\"", '」': "\"", '・': " ",
+}
+
+// kanaRomaji holds the Hepburn romanization of the hiragana, from U+3041 to U+3096. The katakana use
+// the romanization of the hiragana 0x60 characters before them.
+var kanaRomaji = [...]string{
+	"a", "a", "i", "i", "u", "u", "e", "e", "o", "o", "ka", "ga", "ki", "gi", "ku", "gu", "ke", "ge", "ko", "go",
+	"sa", "za", "shi", "ji", "su", "zu", "se", "ze", "so", "zo", "ta", "da", "chi", "ji", "", "tsu", "zu", "te",
+	"de", "to", "do", "na", "ni", "nu", "ne", "no", "ha", "ba", "pa", "hi", "bi", "pi", "fu", "bu", "pu", "he",
+	"be", "pe", "ho", "bo", "po", "ma", "mi", "mu", "me", "mo", "ya", "ya", "yu", "yu", "yo", "yo", "ra", "ri",
+	"ru", "re", "ro", "wa", "wa", "wi", "we", "o", "n", "vu", "ka", "ke",
+}
+
+// The romanizations of the Hangul jamo in the Revised Romanization of Korean, without the sound changes
+// between syllables.
+var (
+	hangulInitials = [...]string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulMedials  = [...]string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we",
+		"wi", "yu", "eu", "ui", "i"}
+	hangulFinals = [...]string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p",
+		"t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+// Transliterate converts a string to ASCII, replacing its characters by their closest ASCII spelling.
+// Accents are removed, compatibility characters decomposed ("ﬁ" becomes "fi"), and the Latin letters
+// without decomposition, Greek, Cyrillic, Japanese kana and Korean Hangul are romanized. The characters
+// which cannot be transliterated, such as the Chinese characters, are removed.
+//
+//	Transliterate("Crème Brûlée")  = "Creme Brulee"
+//	Transliterate("Straße, Ærø")   = "Strasse, Aero"
+//	Transliterate("Щука и ёж")     = "Shchuka i yozh"
+//	Transliterate("Αθήνα")         = "Athina"
+//	Transliterate("東京 とうきょう") = " toukyou"
+//	Transliterate("서울")          = "seoul"
+func Transliterate(str string) string {
+	if isASCII(str) {
+		return str
+	}
+	runes := []rune(Normalize(str, NFC))
+	buff := make([]byte, 0, len(str))
+	// kanaStart is the offset of the romanization of the last kana in buff, or -1
+	kanaStart := -1
+	geminate := false
+	for i, r := range runes {
+		if kana, ok := toHiragana(r); ok {
+			buff, kanaStart, geminate = appendKana(buff, kana, kanaStart, geminate)
+			continue
+		}
+		kanaStart, geminate = -1, false
+		switch {
+		case r < utf8.RuneSelf:
+			buff = append(buff, byte(r))
+		case r >= hangulBase && r < hangulBase+hangulCount:
+			s := r - hangulBase
+			buff = append(buff, hangulInitials[s/(jamoVCount*jamoTCount)]...)
+			buff = append(buff, hangulMedials[s%(jamoVCount*jamoTCount)/jamoTCount]...)
+			buff = append(buff, hangulFinals[s%jamoTCount]...)
+		case r == 'υ' && i > 0 && (runes[i-1] == 'ο' || runes[i-1] == 'Ο'):
+			// the Greek diphthong "ου" is romanized "ou"
+			buff = append(buff, 'u')
+		case r == 'Υ' && i > 0 && runes[i-1] == 'Ο':
+			buff = append(buff, 'U')
+		default:
+			buff = append(buff, transliterateRune(runes, i)...)
+		}
+	}
+	return string(buff)
+}
+
+// transliterateRune returns the transliteration of the character at index i, the characters around it
+// telling the case of the upper case transliterations.
+func transliterateRune(runes []rune, i int) string {
+	r := runes[i]
+	if transliteration, ok := transliterations[r]; ok {
+		return transliteration
+	}
+	if transliteration, ok := transliterations[unicode.ToLower(r)]; ok {
+		return transliterateCase(runes, i, transliteration)
+	}
+	// remove the accents and look for the transliterations of the decomposed characters
+	var buff strings.Builder
+	for _, d := range decompose(string(r), true) {
+		if d < utf8.RuneSelf {
+			buff.WriteRune(d)
+		} else if transliteration, ok := transliterations[unicode.ToLower(d)]; ok {
+			if d == unicode.ToLower(d) {
+				buff.WriteString(transliteration)
+			} else {
+				buff.WriteString(transliterateCase(runes, i, transliteration))
+			}
+		}
+	}
+	return buff.String()
+}
+
+// transliterateCase returns the transliteration of the upper case character at index i: upper cased
+// if the characters around it are upper case too, capitalized otherwise.
+func transliterateCase(runes []rune, i int, transliteration string) string {
+	if len(transliteration) <= 1 || (i+1 < len(runes) && unicode.IsUpper(runes[i+1])) ||
+		(i > 0 && unicode.IsUpper(runes[i-1]) && (i+1 == len(runes) || !unicode.IsLower(runes[i+1]))) {
+		return strings.ToUpper(transliteration)
+	}
+	return strings.ToUpper(transliteration[:1]) + transliteration[1:]
+}
+
+// toHiragana returns the hiragana of a kana, katakana being converted, or false if it is not a kana.
+func toHiragana(r rune) (rune, bool) {
+	if r >= 0x30A1 && r <= 0x30FA {
+		r -= 0x60
+	}
+	if r >= 0x3041 && r <= 0x309A || r == 0x30FC {
+		return r, true
+	}
+	return r, false
+}
+
+// appendKana appends the romanization of a hiragana, combining it with the previous kana when it is
+// a small kana, and returns the offset of its romanization and whether the next consonant is doubled.
+func appendKana(buff []byte, kana rune, kanaStart int, geminate bool) ([]byte, int, bool) {
+	switch {
+	case kana == 'っ':
+		// the small tsu doubles the next consonant
+		return buff, -1, true
+	case kana == 0x30FC || kana > 0x3096:
+		// the long vowels are not marked
+		return buff, kanaStart, false
+	}
+	romaji := kanaRomaji[kana-0x3041]
+	previous := ""
+	if kanaStart >= 0 {
+		previous = string(buff[kanaStart:])
+	}
+	switch {
+	case previous != "" && (kana == 'ゃ' || kana == 'ゅ' || kana == 'ょ') && strings.HasSuffix(previous, "i"):
+		// "ki" and "ya" make "kya", "shi" and "ya" make "sha"
+		previous = previous[:len(previous)-1]
+		if previous == "sh" || previous == "ch" || previous == "j" {
+			romaji = romaji[1:]
+		}
+		return append(buff[:kanaStart], previous+romaji...), kanaStart, false
+	case previous != "" && (kana == 'ぁ' || kana == 'ぃ' || kana == 'ぅ' || kana == 'ぇ' || kana == 'ぉ'):
+		// "fu" and "a" make "fa", "u" and "i" make "wi"
+		switch previous {
+		case "u":
+			previous = "w"
+		case "i":
+			previous = "y"
+		default:
+			previous = strings.TrimRight(previous, "aiueo")
+		}
+		return append(buff[:kanaStart], previous+romaji...), kanaStart, false
+	}
+	if geminate && romaji != "" && strings.IndexByte("aiueon", romaji[0]) < 0 {
+		if strings.HasPrefix(romaji, "ch") {
+			buff = append(buff, 't')
+		} else {
+			buff = append(buff, romaji[0])
+		}
+	}
+	return append(buff, romaji...), len(buff), false
+}
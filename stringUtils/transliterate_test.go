@@ -0,0 +1,33 @@
+package stringUtils
+
+import "testing"
+
+func TestTransliterate(t *testing.T) {
+	if Transliterate("") != "" || Transliterate("plain ASCII!") != "plain ASCII!" {
+		t.Errorf("fail test Transliterate 1")
+	}
+	if Transliterate("Crème Brûlée") != "Creme Brulee" || Transliterate("été") != "ete" || Transliterate("ﬁ²") != "fi2" {
+		t.Errorf("fail test Transliterate 2")
+	}
+	if Transliterate("Straße, Ærø, Łódź, Þór") != "Strasse, Aero, Lodz, Thor" || Transliterate("ÆRØ") != "AERO" {
+		t.Errorf("fail test Transliterate 3")
+	}
+	if Transliterate("Щука и ёж") != "Shchuka i yozh" || Transliterate("ЩУКА") != "SHCHUKA" || Transliterate("Љубљана") != "Ljubljana" {
+		t.Errorf("fail test Transliterate 4")
+	}
+	if Transliterate("Αθήνα") != "Athina" || Transliterate("Ουρανός") != "Ouranos" || Transliterate("ΨΥΧΗ") != "PSYCHI" {
+		t.Errorf("fail test Transliterate 5")
+	}
+	if Transliterate("とうきょう") != "toukyou" || Transliterate("サッカー") != "sakka" || Transliterate("キャッチ") != "kyatchi" {
+		t.Errorf("fail test Transliterate 6")
+	}
+	if Transliterate("ファイル") != "fairu" || Transliterate("ジャズ") != "jazu" || Transliterate("ウィキ") != "wiki" {
+		t.Errorf("fail test Transliterate 7")
+	}
+	if Transliterate("서울") != "seoul" || Transliterate("한국어") != "hangukeo" {
+		t.Errorf("fail test Transliterate 8")
+	}
+	if Transliterate("“quoted” — dash…") != "\"quoted\" - dash..." || Transliterate("東京 tower") != " tower" {
+		t.Errorf("fail test Transliterate 9")
+	}
+}
@@ -0,0 +1,233 @@
+package stringUtils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// eastAsianWide holds the characters whose East_Asian_Width property is Wide or Fullwidth
+// (EastAsianWidth.txt), they take two cells in a terminal.
+var eastAsianWide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115F, 1},
+		{0x231A, 0x231B, 1},
+		{0x2329, 0x232A, 1},
+		{0x23E9, 0x23EC, 1},
+		{0x23F0, 0x23F3, 3},
+		{0x25FD, 0x25FE, 1},
+		{0x2614, 0x2615, 1},
+		{0x2648, 0x2653, 1},
+		{0x267F, 0x2693, 20},
+		{0x26A1, 0x26A1, 1},
+		{0x26AA, 0x26AB, 1},
+		{0x26BD, 0x26BE, 1},
+		{0x26C4, 0x26C5, 1},
+		{0x26CE, 0x26D4, 6},
+		{0x26EA, 0x26EA, 1},
+		{0x26F2, 0x26F3, 1},
+		{0x26F5, 0x26FA, 5},
+		{0x26FD, 0x26FD, 1},
+		{0x2705, 0x2705, 1},
+		{0x270A, 0x270B, 1},
+		{0x2728, 0x2728, 1},
+		{0x274C, 0x274E, 2},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2795, 0x2797, 1},
+		{0x27B0, 0x27BF, 15},
+		{0x2B1B, 0x2B1C, 1},
+		{0x2B50, 0x2B55, 5},
+		{0x2E80, 0x2E99, 1},
+		{0x2E9B, 0x2EF3, 1},
+		{0x2F00, 0x2FD5, 1},
+		{0x2FF0, 0x2FFF, 1},
+		{0x3000, 0x303E, 1},
+		{0x3041, 0x3096, 1},
+		{0x3099, 0x30FF, 1},
+		{0x3105, 0x312F, 1},
+		{0x3131, 0x318E, 1},
+		{0x3190, 0x31E3, 1},
+		{0x31EF, 0x321E, 1},
+		{0x3220, 0x3247, 1},
+		{0x3250, 0x4DBF, 1},
+		{0x4E00, 0xA48C, 1},
+		{0xA490, 0xA4C6, 1},
+		{0xA960, 0xA97C, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xF900, 0xFAFF, 1},
+		{0xFE10, 0xFE19, 1},
+		{0xFE30, 0xFE52, 1},
+		{0xFE54, 0xFE66, 1},
+		{0xFE68, 0xFE6B, 1},
+		{0xFF01, 0xFF60, 1},
+		{0xFFE0, 0xFFE6, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE0, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18CD5, 1},
+		{0x18D00, 0x18D08, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+		{0x1B170, 0x1B2FB, 1},
+		{0x1F004, 0x1F004, 1},
+		{0x1F0CF, 0x1F0CF, 1},
+		{0x1F18E, 0x1F18E, 1},
+		{0x1F191, 0x1F19A, 1},
+		{0x1F200, 0x1F202, 1},
+		{0x1F210, 0x1F23B, 1},
+		{0x1F240, 0x1F248, 1},
+		{0x1F250, 0x1F251, 1},
+		{0x1F260, 0x1F265, 1},
+		{0x1F300, 0x1F320, 1},
+		{0x1F32D, 0x1F335, 1},
+		{0x1F337, 0x1F37C, 1},
+		{0x1F37E, 0x1F393, 1},
+		{0x1F3A0, 0x1F3CA, 1},
+		{0x1F3CF, 0x1F3D3, 1},
+		{0x1F3E0, 0x1F3F0, 1},
+		{0x1F3F4, 0x1F3F4, 1},
+		{0x1F3F8, 0x1F43E, 1},
+		{0x1F440, 0x1F440, 1},
+		{0x1F442, 0x1F4FC, 1},
+		{0x1F4FF, 0x1F53D, 1},
+		{0x1F54B, 0x1F54E, 1},
+		{0x1F550, 0x1F567, 1},
+		{0x1F57A, 0x1F57A, 1},
+		{0x1F595, 0x1F596, 1},
+		{0x1F5A4, 0x1F5A4, 1},
+		{0x1F5FB, 0x1F64F, 1},
+		{0x1F680, 0x1F6C5, 1},
+		{0x1F6CC, 0x1F6CC, 1},
+		{0x1F6D0, 0x1F6D2, 1},
+		{0x1F6D5, 0x1F6D7, 1},
+		{0x1F6DC, 0x1F6DF, 1},
+		{0x1F6EB, 0x1F6EC, 1},
+		{0x1F6F4, 0x1F6FC, 1},
+		{0x1F7E0, 0x1F7EB, 1},
+		{0x1F7F0, 0x1F7F0, 1},
+		{0x1F90C, 0x1F93A, 1},
+		{0x1F93C, 0x1F945, 1},
+		{0x1F947, 0x1F9FF, 1},
+		{0x1FA70, 0x1FA7C, 1},
+		{0x1FA80, 0x1FA88, 1},
+		{0x1FA90, 0x1FABD, 1},
+		{0x1FABF, 0x1FAC5, 1},
+		{0x1FACE, 0x1FADB, 1},
+		{0x1FAE0, 0x1FAE8, 1},
+		{0x1FAF0, 0x1FAF8, 1},
+		{0x20000, 0x2FFFD, 1},
+		{0x30000, 0x3FFFD, 1},
+	},
+}
+
+// runeWidth returns the number of terminal cells taken by a character on its own.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return 0
+	case r < 0x300:
+		return 1
+	case r == 0x200B || (r >= 0x1160 && r <= 0x11FF) || (r >= 0xD7B0 && r <= 0xD7FF):
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(eastAsianWide, r):
+		return 2
+	}
+	return 1
+}
+
+// graphemeWidth returns the number of terminal cells taken by a grapheme cluster.
+// The cluster takes the width of its first visible character, emoji sequences and
+// characters followed by an emoji presentation selector take two cells.
+func graphemeWidth(cluster string) int {
+	width := 0
+	for _, r := range cluster {
+		switch {
+		case width == 0:
+			width = runeWidth(r)
+			if width > 0 && unicode.Is(unicode.Regional_Indicator, r) && len(cluster) > 4 {
+				return 2
+			}
+		case r == 0xFE0F:
+			return 2
+		case r == 0xFE0E:
+			return 1
+		}
+	}
+	return width
+}
+
+// DisplayWidth returns the number of terminal cells needed to display a string.
+// East Asian wide and fullwidth characters as well as emoji take two cells, combining marks,
+// zero width characters and control characters take none.
+func DisplayWidth(str string) int {
+	width := 0
+	for i := 0; i < len(str); {
+		size := firstGraphemeLen(str[i:])
+		width += graphemeWidth(str[i : i+size])
+		i += size
+	}
+	return width
+}
+
+// AbbreviateToWidth abbreviates a string using ellipses so that it fits in maxWidth terminal cells.
+// Grapheme clusters are never split. When maxWidth is too small to hold the ellipses,
+// the string is truncated without them.
+func AbbreviateToWidth(str string, maxWidth int) string {
+	if DisplayWidth(str) <= maxWidth {
+		return str
+	}
+	abbrevMarker := "..."
+	if maxWidth < 4 {
+		abbrevMarker = ""
+	}
+	available := maxWidth - len(abbrevMarker)
+	end, width := 0, 0
+	for end < len(str) {
+		size := firstGraphemeLen(str[end:])
+		w := graphemeWidth(str[end : end+size])
+		if width+w > available {
+			break
+		}
+		width += w
+		end += size
+	}
+	return str[:end] + abbrevMarker
+}
+
+// PadToWidth right pads a string with spaces so that it takes width terminal cells.
+func PadToWidth(str string, width int) string {
+	pads := width - DisplayWidth(str)
+	if pads <= 0 {
+		return str
+	}
+	return str + strings.Repeat(" ", pads)
+}
+
+// LeftPadToWidth left pads a string with spaces so that it takes width terminal cells.
+func LeftPadToWidth(str string, width int) string {
+	pads := width - DisplayWidth(str)
+	if pads <= 0 {
+		return str
+	}
+	return strings.Repeat(" ", pads) + str
+}
+
+// CenterToWidth centers a string with spaces so that it takes width terminal cells.
+// When the padding cannot be split evenly, the extra space goes to the right.
+func CenterToWidth(str string, width int) string {
+	pads := width - DisplayWidth(str)
+	if pads <= 0 {
+		return str
+	}
+	return strings.Repeat(" ", pads/2) + str + strings.Repeat(" ", pads-pads/2)
+}
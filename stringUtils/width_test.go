@@ -0,0 +1,96 @@
+package stringUtils
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	if DisplayWidth("") != 0 {
+		t.Errorf("fail test DisplayWidth 1")
+	}
+	if DisplayWidth("abc") != 3 {
+		t.Errorf("fail test DisplayWidth 2")
+	}
+	if DisplayWidth("日本語") != 6 {
+		t.Errorf("fail test DisplayWidth 3")
+	}
+	if DisplayWidth("e\u0301te\u0301") != 3 {
+		t.Errorf("fail test DisplayWidth 4")
+	}
+	if DisplayWidth("ｱｲｳ") != 3 {
+		t.Errorf("fail test DisplayWidth 5")
+	}
+	if DisplayWidth("ＡＢ") != 4 {
+		t.Errorf("fail test DisplayWidth 6")
+	}
+	if DisplayWidth("\U0001F468\u200D\U0001F469\u200D\U0001F467") != 2 {
+		t.Errorf("fail test DisplayWidth 7")
+	}
+	if DisplayWidth("🇫🇷👍🏽") != 4 {
+		t.Errorf("fail test DisplayWidth 8")
+	}
+	if DisplayWidth("\u2764\uFE0F \u2764") != 4 {
+		t.Errorf("fail test DisplayWidth 9")
+	}
+	if DisplayWidth("a\u200Bb\tc") != 3 {
+		t.Errorf("fail test DisplayWidth 10")
+	}
+	if DisplayWidth("\u1100\u1161\u11A8") != 2 {
+		t.Errorf("fail test DisplayWidth 11")
+	}
+}
+
+func TestAbbreviateToWidth(t *testing.T) {
+	if AbbreviateToWidth("", 4) != "" {
+		t.Errorf("fail test AbbreviateToWidth 1")
+	}
+	if AbbreviateToWidth("abcdefg", 6) != "abc..." {
+		t.Errorf("fail test AbbreviateToWidth 2")
+	}
+	if AbbreviateToWidth("abcdefg", 7) != "abcdefg" {
+		t.Errorf("fail test AbbreviateToWidth 3")
+	}
+	if AbbreviateToWidth("日本語のテキスト", 8) != "日本..." {
+		t.Errorf("fail test AbbreviateToWidth 4")
+	}
+	if AbbreviateToWidth("日本語のテキスト", 9) != "日本語..." {
+		t.Errorf("fail test AbbreviateToWidth 5")
+	}
+	if AbbreviateToWidth("日本語", 3) != "日" {
+		t.Errorf("fail test AbbreviateToWidth 6")
+	}
+	if AbbreviateToWidth("e\u0301le\u0301phant", 5) != "e\u0301l..." {
+		t.Errorf("fail test AbbreviateToWidth 7")
+	}
+}
+
+func TestPadToWidth(t *testing.T) {
+	if PadToWidth("", 2) != "  " {
+		t.Errorf("fail test PadToWidth 1")
+	}
+	if PadToWidth("abc", 2) != "abc" {
+		t.Errorf("fail test PadToWidth 2")
+	}
+	if PadToWidth("日本", 6) != "日本  " {
+		t.Errorf("fail test PadToWidth 3")
+	}
+	if PadToWidth("e\u0301", 3) != "e\u0301  " {
+		t.Errorf("fail test PadToWidth 4")
+	}
+}
+
+func TestLeftPadToWidth(t *testing.T) {
+	if LeftPadToWidth("日本", 5) != " 日本" {
+		t.Errorf("fail test LeftPadToWidth 1")
+	}
+	if LeftPadToWidth("日本", 3) != "日本" {
+		t.Errorf("fail test LeftPadToWidth 2")
+	}
+}
+
+func TestCenterToWidth(t *testing.T) {
+	if CenterToWidth("日本", 7) != " 日本  " {
+		t.Errorf("fail test CenterToWidth 1")
+	}
+	if CenterToWidth("ab", 2) != "ab" {
+		t.Errorf("fail test CenterToWidth 2")
+	}
+}
@@ -0,0 +1,331 @@
+package stringUtils
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// wordProperty is the Word_Break property of a character, as defined by Unicode Standard Annex #29.
+type wordProperty int
+
+const (
+	wpOther wordProperty = iota
+	wpCR
+	wpLF
+	wpNewline
+	wpExtend
+	wpZWJ
+	wpRegionalIndicator
+	wpFormat
+	wpKatakana
+	wpHebrewLetter
+	wpALetter
+	wpSingleQuote
+	wpDoubleQuote
+	wpMidNumLet
+	wpMidLetter
+	wpMidNum
+	wpNumeric
+	wpExtendNumLet
+	wpWSegSpace
+)
+
+// wordKatakana holds the characters which are not of the Katakana script but have the Katakana
+// Word_Break property.
+var wordKatakana = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3031, 0x3035, 1},
+		{0x309B, 0x309C, 1},
+		{0x30A0, 0x30A0, 1},
+		{0x30FC, 0x30FC, 1},
+		{0xFF70, 0xFF70, 1},
+	},
+}
+
+// wordALetter holds the characters which are not alphabetic but have the ALetter Word_Break property.
+var wordALetter = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x02C2, 0x02C5, 1},
+		{0x02D2, 0x02D7, 1},
+		{0x02DE, 0x02DF, 1},
+		{0x02ED, 0x02EF, 2},
+		{0x02F0, 0x02FF, 1},
+		{0x05F3, 0x05F3, 1},
+		{0xA720, 0xA721, 1},
+		{0xA789, 0xA78A, 1},
+		{0xAB5B, 0xAB5B, 1},
+		{0xAB6A, 0xAB6B, 1},
+	},
+}
+
+// wordMidNumLet holds the characters having the MidNumLet Word_Break property.
+var wordMidNumLet = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002E, 0x002E, 1},
+		{0x2018, 0x2019, 1},
+		{0x2024, 0x2024, 1},
+		{0xFE52, 0xFE52, 1},
+		{0xFF07, 0xFF07, 1},
+		{0xFF0E, 0xFF0E, 1},
+	},
+}
+
+// wordMidLetter holds the characters having the MidLetter Word_Break property.
+var wordMidLetter = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x003A, 0x003A, 1},
+		{0x00B7, 0x00B7, 1},
+		{0x0387, 0x0387, 1},
+		{0x055F, 0x055F, 1},
+		{0x05F4, 0x05F4, 1},
+		{0x2027, 0x2027, 1},
+		{0xFE13, 0xFE13, 1},
+		{0xFE55, 0xFE55, 1},
+		{0xFF1A, 0xFF1A, 1},
+	},
+}
+
+// wordMidNum holds the characters having the MidNum Word_Break property.
+var wordMidNum = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002C, 0x002C, 1},
+		{0x003B, 0x003B, 1},
+		{0x037E, 0x037E, 1},
+		{0x0589, 0x0589, 1},
+		{0x060C, 0x060D, 1},
+		{0x066C, 0x066C, 1},
+		{0x07F8, 0x07F8, 1},
+		{0x2044, 0x2044, 1},
+		{0xFE10, 0xFE10, 1},
+		{0xFE14, 0xFE14, 1},
+		{0xFE50, 0xFE50, 1},
+		{0xFE54, 0xFE54, 1},
+		{0xFF0C, 0xFF0C, 1},
+		{0xFF1B, 0xFF1B, 1},
+	},
+}
+
+// complexContext holds the scripts whose words are not separated by spaces and need a dictionary to be
+// segmented (Line_Break=Complex_Context), their letters are not ALetter.
+var complexContext = []*unicode.RangeTable{
+	unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer, unicode.Tai_Le, unicode.New_Tai_Lue,
+	unicode.Tai_Tham, unicode.Tai_Viet, unicode.Ahom,
+}
+
+// wordPropertyOf returns the Word_Break property of a character.
+func wordPropertyOf(r rune) wordProperty {
+	if r < utf8.RuneSelf {
+		switch {
+		case r == '\r':
+			return wpCR
+		case r == '\n':
+			return wpLF
+		case r == 0x0B || r == 0x0C:
+			return wpNewline
+		case r == ' ':
+			return wpWSegSpace
+		case r == '\'':
+			return wpSingleQuote
+		case r == '"':
+			return wpDoubleQuote
+		case r == '.':
+			return wpMidNumLet
+		case r == ':':
+			return wpMidLetter
+		case r == ',' || r == ';':
+			return wpMidNum
+		case r >= '0' && r <= '9':
+			return wpNumeric
+		case r == '_':
+			return wpExtendNumLet
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			return wpALetter
+		}
+		return wpOther
+	}
+	switch {
+	case r == 0x85 || r == 0x2028 || r == 0x2029:
+		return wpNewline
+	case r == 0x200D:
+		return wpZWJ
+	case r == 0x200C || (r >= 0x1F3FB && r <= 0x1F3FF) ||
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Other_Grapheme_Extend):
+		return wpExtend
+	case unicode.Is(unicode.Regional_Indicator, r):
+		return wpRegionalIndicator
+	case r != 0x200B && unicode.Is(unicode.Cf, r):
+		return wpFormat
+	case unicode.In(r, unicode.Katakana, wordKatakana):
+		return wpKatakana
+	case unicode.Is(unicode.Hebrew, r) && unicode.IsLetter(r):
+		return wpHebrewLetter
+	case unicode.Is(wordMidNumLet, r):
+		return wpMidNumLet
+	case unicode.Is(wordMidLetter, r):
+		return wpMidLetter
+	case unicode.Is(wordMidNum, r):
+		return wpMidNum
+	case r == 0x066B || (unicode.Is(unicode.Nd, r) && !(r >= 0xFF10 && r <= 0xFF19)):
+		return wpNumeric
+	case r == 0x202F || unicode.Is(unicode.Pc, r):
+		return wpExtendNumLet
+	case unicode.Is(unicode.Zs, r) && r != 0xA0 && r != 0x2007:
+		return wpWSegSpace
+	case unicode.In(r, unicode.Ideographic, unicode.Hiragana) || unicode.In(r, complexContext...):
+		return wpOther
+	case unicode.In(r, unicode.L, unicode.Nl, unicode.Other_Alphabetic, wordALetter):
+		return wpALetter
+	}
+	return wpOther
+}
+
+// isAHLetter checks if a Word_Break property is ALetter or Hebrew_Letter.
+func isAHLetter(p wordProperty) bool {
+	return p == wpALetter || p == wpHebrewLetter
+}
+
+// isMidNumLetQ checks if a Word_Break property is MidNumLet or Single_Quote.
+func isMidNumLetQ(p wordProperty) bool {
+	return p == wpMidNumLet || p == wpSingleQuote
+}
+
+// isWordIgnorable checks if a Word_Break property is ignored by the rule WB4, the characters being
+// attached to the previous one.
+func isWordIgnorable(p wordProperty) bool {
+	return p == wpExtend || p == wpFormat || p == wpZWJ
+}
+
+// wordBoundaries returns the byte offsets at which each word segment of a string starts,
+// followed by the length of the string, following the word boundary rules of Unicode Standard Annex #29.
+func wordBoundaries(str string) []int {
+	bounds := runeBoundaries(str)
+	n := len(bounds) - 1
+	runes := make([]rune, n)
+	props := make([]wordProperty, n)
+	for i := 0; i < n; i++ {
+		runes[i], _ = utf8.DecodeRuneInString(str[bounds[i]:])
+		props[i] = wordPropertyOf(runes[i])
+	}
+	// before returns the index of the last character before i which is not ignored by WB4, or -1
+	before := func(i int) int {
+		for i--; i >= 0 && isWordIgnorable(props[i]); i-- {
+		}
+		return i
+	}
+	// after returns the index of the first character after i which is not ignored by WB4, or n
+	after := func(i int) int {
+		for i++; i < n && isWordIgnorable(props[i]); i++ {
+		}
+		return i
+	}
+	propAt := func(i int) wordProperty {
+		if i < 0 || i >= n {
+			return wpOther
+		}
+		return props[i]
+	}
+	result := []int{0}
+	if n == 0 {
+		return result
+	}
+	// regional is the number of regional indicators in the run ending before the current character
+	regional := 0
+	for i := 1; i < n; i++ {
+		if props[i-1] == wpRegionalIndicator {
+			regional++
+		} else if !isWordIgnorable(props[i-1]) {
+			regional = 0
+		}
+		if isWordBoundary(runes, props, i, before, after, propAt, regional) {
+			result = append(result, bounds[i])
+		}
+	}
+	return append(result, len(str))
+}
+
+// isWordBoundary reports whether there is a word boundary before the character at index i,
+// regional being the number of regional indicators in the run ending before it.
+func isWordBoundary(runes []rune, props []wordProperty, i int, before func(int) int, after func(int) int,
+	propAt func(int) wordProperty, regional int) bool {
+	prev, next := props[i-1], props[i]
+	switch {
+	case prev == wpCR && next == wpLF: // WB3
+		return false
+	case prev == wpCR || prev == wpLF || prev == wpNewline: // WB3a
+		return true
+	case next == wpCR || next == wpLF || next == wpNewline: // WB3b
+		return true
+	case prev == wpZWJ && unicode.Is(extendedPictographic, runes[i]): // WB3c
+		return false
+	case prev == wpWSegSpace && next == wpWSegSpace: // WB3d
+		return false
+	case isWordIgnorable(next): // WB4
+		return false
+	}
+	// the rules below skip the characters ignored by WB4
+	if p := before(i); p >= 0 {
+		prev = props[p]
+	}
+	prev2 := propAt(before(before(i)))
+	next2 := propAt(after(i))
+	switch {
+	case isAHLetter(prev) && isAHLetter(next): // WB5
+		return false
+	case isAHLetter(prev) && (next == wpMidLetter || isMidNumLetQ(next)) && isAHLetter(next2): // WB6
+		return false
+	case isAHLetter(prev2) && (prev == wpMidLetter || isMidNumLetQ(prev)) && isAHLetter(next): // WB7
+		return false
+	case prev == wpHebrewLetter && next == wpSingleQuote: // WB7a
+		return false
+	case prev == wpHebrewLetter && next == wpDoubleQuote && next2 == wpHebrewLetter: // WB7b
+		return false
+	case prev2 == wpHebrewLetter && prev == wpDoubleQuote && next == wpHebrewLetter: // WB7c
+		return false
+	case prev == wpNumeric && next == wpNumeric: // WB8
+		return false
+	case isAHLetter(prev) && next == wpNumeric: // WB9
+		return false
+	case prev == wpNumeric && isAHLetter(next): // WB10
+		return false
+	case prev2 == wpNumeric && (prev == wpMidNum || isMidNumLetQ(prev)) && next == wpNumeric: // WB11
+		return false
+	case prev == wpNumeric && (next == wpMidNum || isMidNumLetQ(next)) && next2 == wpNumeric: // WB12
+		return false
+	case prev == wpKatakana && next == wpKatakana: // WB13
+		return false
+	case (isAHLetter(prev) || prev == wpNumeric || prev == wpKatakana || prev == wpExtendNumLet) &&
+		next == wpExtendNumLet: // WB13a
+		return false
+	case prev == wpExtendNumLet && (isAHLetter(next) || next == wpNumeric || next == wpKatakana): // WB13b
+		return false
+	case prev == wpRegionalIndicator && next == wpRegionalIndicator && regional%2 == 1: // WB15, WB16
+		return false
+	}
+	return true
+}
+
+// WordSegments splits a string into its word segments, as defined by the word boundaries of
+// Unicode Standard Annex #29. The segments are the words themselves, but also the runs of spaces and
+// each punctuation character between them. Apostrophes and periods inside words ("can't", "e.g")
+// and separators inside numbers ("3.14", "1,000") do not split them.
+//
+//	WordSegments("Don't panic, it's 3.14!") = ["Don't", " ", "panic", ",", " ", "it's", " ", "3.14", "!"]
+func WordSegments(str string) []string {
+	bounds := wordBoundaries(str)
+	segments := make([]string, len(bounds)-1)
+	for i := range segments {
+		segments[i] = str[bounds[i]:bounds[i+1]]
+	}
+	return segments
+}
+
+// IsWordSegment checks if a word segment is a word, that is if it contains a letter or a number,
+// rather than spaces or punctuation.
+func IsWordSegment(segment string) bool {
+	for _, r := range segment {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package stringUtils
+
+import "testing"
+
+func TestWordSegments(t *testing.T) {
+	if len(WordSegments("")) != 0 {
+		t.Errorf("fail test WordSegments 1")
+	}
+	if !slicesEqual(WordSegments("Don't panic, it's 3.14!"), "Don't", " ", "panic", ",", " ", "it's", " ", "3.14", "!") {
+		t.Errorf("fail test WordSegments 2")
+	}
+	if !slicesEqual(WordSegments("well-known e.g. 1,000.5"), "well", "-", "known", " ", "e.g", ".", " ", "1,000.5") {
+		t.Errorf("fail test WordSegments 3")
+	}
+	if !slicesEqual(WordSegments("snake_case a1b2  \tx"), "snake_case", " ", "a1b2", "  ", "\t", "x") {
+		t.Errorf("fail test WordSegments 4")
+	}
+	if !slicesEqual(WordSegments("a\r\n\nb"), "a", "\r\n", "\n", "b") {
+		t.Errorf("fail test WordSegments 5")
+	}
+	if !slicesEqual(WordSegments("東京タワーへ"), "東", "京", "タワー", "へ") {
+		t.Errorf("fail test WordSegments 6")
+	}
+	if !slicesEqual(WordSegments("café naïve"), "café", " ", "naïve") {
+		t.Errorf("fail test WordSegments 7")
+	}
+	if !slicesEqual(WordSegments("🇫🇷🇯🇵🇩x"), "🇫🇷", "🇯🇵", "🇩", "x") {
+		t.Errorf("fail test WordSegments 8")
+	}
+	if !slicesEqual(WordSegments("👍🏽\U0001F468‍\U0001F469 ok"), "👍🏽", "\U0001F468‍\U0001F469", " ", "ok") {
+		t.Errorf("fail test WordSegments 9")
+	}
+	if !slicesEqual(WordSegments("a:b a: 'quoted'"), "a:b", " ", "a", ":", " ", "'", "quoted", "'") {
+		t.Errorf("fail test WordSegments 10")
+	}
+	if !slicesEqual(WordSegments("א\"ב א'"), "א\"ב", " ", "א'") {
+		t.Errorf("fail test WordSegments 11")
+	}
+	if !slicesEqual(WordSegments("a­b"), "a­b") {
+		t.Errorf("fail test WordSegments 12")
+	}
+}
+
+func TestIsWordSegment(t *testing.T) {
+	if IsWordSegment("") || IsWordSegment(" ") || IsWordSegment("-") || IsWordSegment("🇫🇷") {
+		t.Errorf("fail test IsWordSegment 1")
+	}
+	if !IsWordSegment("don't") || !IsWordSegment("3.14") || !IsWordSegment("東") {
+		t.Errorf("fail test IsWordSegment 2")
+	}
+}
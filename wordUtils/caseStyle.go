@@ -0,0 +1,170 @@
+package wordUtils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CaseOptions configures how acronyms are rendered when converting to camelCase or PascalCase.
+type CaseOptions struct {
+	// Acronyms lists the words always rendered in upper case, such as "ID" or "HTTP".
+	// They are matched ignoring case.
+	Acronyms []string
+	// KeepAcronyms keeps the words written in upper case in the input, such as "HTTP" in "HTTPServer",
+	// in upper case. It has no effect when the whole input is written in upper case.
+	KeepAcronyms bool
+}
+
+// isIdentifierRune checks if a character is part of a word of an identifier.
+func isIdentifierRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || unicode.IsMark(c)
+}
+
+// SplitIdentifier splits an identifier into its words, whatever its case style.
+//
+// Words are separated by any character which is not a letter or a digit, by a lower case letter or a digit
+// followed by an upper case letter, and by the last letter of an upper case run followed by a lower case
+// letter, so that acronyms are kept together. Digits stay with the preceding word.
+//
+//	SplitIdentifier("HTTPServerID")   = ["HTTP", "Server", "ID"]
+//	SplitIdentifier("user_id")        = ["user", "id"]
+//	SplitIdentifier("base64Encoder")  = ["base64", "Encoder"]
+func SplitIdentifier(str string) []string {
+	words := []string{}
+	runes := []rune(str)
+	start := -1
+	for i, c := range runes {
+		if !isIdentifierRune(c) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && unicode.IsUpper(c) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// isUpperWord checks if a word contains letters, all of them being upper case.
+func isUpperWord(word string) bool {
+	hasLetter := false
+	for _, c := range word {
+		if unicode.IsLower(c) || unicode.IsTitle(c) {
+			return false
+		}
+		hasLetter = hasLetter || unicode.IsUpper(c)
+	}
+	return hasLetter
+}
+
+// capitalizeWord changes the first letter of a word to title case and the other ones to lower case.
+func capitalizeWord(word string) string {
+	for _, c := range word {
+		return string(unicode.ToTitle(c)) + strings.ToLower(word[utf8.RuneLen(c):])
+	}
+	return word
+}
+
+// internalToCamelCase joins the words of an identifier, capitalizing each one except the first one
+// unless upperFirst is set.
+func internalToCamelCase(str string, upperFirst bool, options CaseOptions) string {
+	words := SplitIdentifier(str)
+	acronyms := make(map[string]bool, len(options.Acronyms))
+	for _, acronym := range options.Acronyms {
+		acronyms[strings.ToUpper(acronym)] = true
+	}
+	keepAcronyms := options.KeepAcronyms && !isUpperWord(str)
+	buff := make([]string, len(words))
+	for i, word := range words {
+		upper := strings.ToUpper(word)
+		switch {
+		case i == 0 && !upperFirst:
+			buff[i] = strings.ToLower(word)
+		case acronyms[upper] || (keepAcronyms && len([]rune(word)) > 1 && isUpperWord(word)):
+			buff[i] = upper
+		default:
+			buff[i] = capitalizeWord(word)
+		}
+	}
+	return strings.Join(buff, "")
+}
+
+// internalToDelimitedCase joins the words of an identifier with a delimiter, converting them to upper or lower case.
+func internalToDelimitedCase(str string, delimiter string, upper bool) string {
+	words := SplitIdentifier(str)
+	for i, word := range words {
+		if upper {
+			words[i] = strings.ToUpper(word)
+		} else {
+			words[i] = strings.ToLower(word)
+		}
+	}
+	return strings.Join(words, delimiter)
+}
+
+// ToCamelCase converts an identifier to camelCase.
+//
+//	ToCamelCase("HTTPServerID") = "httpServerId"
+//	ToCamelCase("user-name")    = "userName"
+func ToCamelCase(str string) string {
+	return internalToCamelCase(str, false, CaseOptions{})
+}
+
+// ToCamelCaseWithOptions converts an identifier to camelCase, rendering acronyms as configured.
+// The first word is always in lower case.
+//
+//	ToCamelCaseWithOptions("user_id", CaseOptions{Acronyms: []string{"ID"}}) = "userID"
+func ToCamelCaseWithOptions(str string, options CaseOptions) string {
+	return internalToCamelCase(str, false, options)
+}
+
+// ToPascalCase converts an identifier to PascalCase.
+//
+//	ToPascalCase("HTTPServerID") = "HttpServerId"
+//	ToPascalCase("user_name")    = "UserName"
+func ToPascalCase(str string) string {
+	return internalToCamelCase(str, true, CaseOptions{})
+}
+
+// ToPascalCaseWithOptions converts an identifier to PascalCase, rendering acronyms as configured.
+//
+//	ToPascalCaseWithOptions("HTTPServerID", CaseOptions{KeepAcronyms: true}) = "HTTPServerID"
+func ToPascalCaseWithOptions(str string, options CaseOptions) string {
+	return internalToCamelCase(str, true, options)
+}
+
+// ToSnakeCase converts an identifier to snake_case.
+//
+//	ToSnakeCase("HTTPServerID") = "http_server_id"
+func ToSnakeCase(str string) string {
+	return internalToDelimitedCase(str, "_", false)
+}
+
+// ToScreamingSnakeCase converts an identifier to SCREAMING_SNAKE_CASE.
+//
+//	ToScreamingSnakeCase("httpServerId") = "HTTP_SERVER_ID"
+func ToScreamingSnakeCase(str string) string {
+	return internalToDelimitedCase(str, "_", true)
+}
+
+// ToKebabCase converts an identifier to kebab-case.
+//
+//	ToKebabCase("HTTPServerID") = "http-server-id"
+func ToKebabCase(str string) string {
+	return internalToDelimitedCase(str, "-", false)
+}
@@ -0,0 +1,108 @@
+package wordUtils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitIdentifier(t *testing.T) {
+	if len(SplitIdentifier("")) != 0 || len(SplitIdentifier("__")) != 0 {
+		t.Errorf("fail test SplitIdentifier 1")
+	}
+	if strings.Join(SplitIdentifier("HTTPServerID"), ",") != "HTTP,Server,ID" {
+		t.Errorf("fail test SplitIdentifier 2")
+	}
+	if strings.Join(SplitIdentifier("user_id"), ",") != "user,id" {
+		t.Errorf("fail test SplitIdentifier 3")
+	}
+	if strings.Join(SplitIdentifier("base64Encoder"), ",") != "base64,Encoder" {
+		t.Errorf("fail test SplitIdentifier 4")
+	}
+	if strings.Join(SplitIdentifier("  kebab-case.and snake_CASE "), ",") != "kebab,case,and,snake,CASE" {
+		t.Errorf("fail test SplitIdentifier 5")
+	}
+	if strings.Join(SplitIdentifier("getHTTPResponseCode2XX"), ",") != "get,HTTP,Response,Code2,XX" {
+		t.Errorf("fail test SplitIdentifier 6")
+	}
+	if strings.Join(SplitIdentifier("ÉtéÀParis"), ",") != "Été,À,Paris" {
+		t.Errorf("fail test SplitIdentifier 7")
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	if ToCamelCase("") != "" {
+		t.Errorf("fail test ToCamelCase 1")
+	}
+	if ToCamelCase("HTTPServerID") != "httpServerId" {
+		t.Errorf("fail test ToCamelCase 2")
+	}
+	if ToCamelCase("user-name") != "userName" || ToCamelCase("USER_NAME") != "userName" {
+		t.Errorf("fail test ToCamelCase 3")
+	}
+	if ToCamelCase("Already camelCase") != "alreadyCamelCase" {
+		t.Errorf("fail test ToCamelCase 4")
+	}
+}
+
+func TestToCamelCaseWithOptions(t *testing.T) {
+	if ToCamelCaseWithOptions("user_id", CaseOptions{Acronyms: []string{"ID"}}) != "userID" {
+		t.Errorf("fail test ToCamelCaseWithOptions 1")
+	}
+	if ToCamelCaseWithOptions("http_server_id", CaseOptions{Acronyms: []string{"id", "http"}}) != "httpServerID" {
+		t.Errorf("fail test ToCamelCaseWithOptions 2")
+	}
+	if ToCamelCaseWithOptions("parseHTTPHeader", CaseOptions{KeepAcronyms: true}) != "parseHTTPHeader" {
+		t.Errorf("fail test ToCamelCaseWithOptions 3")
+	}
+}
+
+func TestToPascalCase(t *testing.T) {
+	if ToPascalCase("HTTPServerID") != "HttpServerId" {
+		t.Errorf("fail test ToPascalCase 1")
+	}
+	if ToPascalCase("user_name") != "UserName" {
+		t.Errorf("fail test ToPascalCase 2")
+	}
+	if ToPascalCase("été-à-paris") != "ÉtéÀParis" {
+		t.Errorf("fail test ToPascalCase 3")
+	}
+}
+
+func TestToPascalCaseWithOptions(t *testing.T) {
+	if ToPascalCaseWithOptions("HTTPServerID", CaseOptions{KeepAcronyms: true}) != "HTTPServerID" {
+		t.Errorf("fail test ToPascalCaseWithOptions 1")
+	}
+	if ToPascalCaseWithOptions("HTTP_SERVER_ID", CaseOptions{KeepAcronyms: true}) != "HttpServerId" {
+		t.Errorf("fail test ToPascalCaseWithOptions 2")
+	}
+	if ToPascalCaseWithOptions("http_server_id", CaseOptions{Acronyms: []string{"HTTP", "ID"}}) != "HTTPServerID" {
+		t.Errorf("fail test ToPascalCaseWithOptions 3")
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	if ToSnakeCase("HTTPServerID") != "http_server_id" {
+		t.Errorf("fail test ToSnakeCase 1")
+	}
+	if ToSnakeCase("userName") != "user_name" || ToSnakeCase("user-name") != "user_name" {
+		t.Errorf("fail test ToSnakeCase 2")
+	}
+}
+
+func TestToScreamingSnakeCase(t *testing.T) {
+	if ToScreamingSnakeCase("httpServerId") != "HTTP_SERVER_ID" {
+		t.Errorf("fail test ToScreamingSnakeCase 1")
+	}
+	if ToScreamingSnakeCase("max-retries") != "MAX_RETRIES" {
+		t.Errorf("fail test ToScreamingSnakeCase 2")
+	}
+}
+
+func TestToKebabCase(t *testing.T) {
+	if ToKebabCase("HTTPServerID") != "http-server-id" {
+		t.Errorf("fail test ToKebabCase 1")
+	}
+	if ToKebabCase("MAX_RETRIES") != "max-retries" {
+		t.Errorf("fail test ToKebabCase 2")
+	}
+}
@@ -0,0 +1,89 @@
+package wordUtils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// WordPosition is an occurrence of a word found in a string.
+type WordPosition struct {
+	// Word is the searched word.
+	Word string
+	// Start is the byte offset of the occurrence in the string.
+	Start int
+	// End is the byte offset following the occurrence in the string.
+	End int
+}
+
+// isWordRune checks if a character may be part of a word, like \w in Unicode aware regular expressions.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || unicode.Is(unicode.Pc, r)
+}
+
+// indexWord returns the bounds of the first occurrence of a word found at or after from which is not
+// surrounded by word characters, or -1 and -1.
+func indexWord(str string, word string, from int, ignoreCase bool) (int, int) {
+	if word == "" {
+		return -1, -1
+	}
+	for i := from; i < len(str); {
+		start, end := -1, -1
+		if ignoreCase {
+			if idx, idxEnd := stringUtils.IndexIgnoreCase(str[i:], word); idx >= 0 {
+				start, end = i+idx, i+idxEnd
+			}
+		} else if idx := strings.Index(str[i:], word); idx >= 0 {
+			start, end = i+idx, i+idx+len(word)
+		}
+		if start < 0 {
+			break
+		}
+		before, _ := utf8.DecodeLastRuneInString(str[:start])
+		after, _ := utf8.DecodeRuneInString(str[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(str) || !isWordRune(after)) {
+			return start, end
+		}
+		_, size := utf8.DecodeRuneInString(str[start:])
+		i = start + size
+	}
+	return -1, -1
+}
+
+// FindWords finds all the occurrences of the words in a string, ordered by position.
+// The words are searched literally, and must not be surrounded by letters, digits, marks or underscores.
+// The occurrences of different words may overlap. Empty words are never found.
+//
+//	FindWords("new york is new", "new", "new york") = [{"new", 0, 3}, {"new york", 0, 8}, {"new", 12, 15}]
+func FindWords(str string, words ...string) []WordPosition {
+	return internalFindWords(str, words, false)
+}
+
+// FindWordsIgnoreCase finds all the occurrences of the words in a string ignoring case, ordered by position.
+// The words are searched literally, and must not be surrounded by letters, digits, marks or underscores.
+// The occurrences of different words may overlap. Empty words are never found.
+func FindWordsIgnoreCase(str string, words ...string) []WordPosition {
+	return internalFindWords(str, words, true)
+}
+
+// internalFindWords finds all the occurrences of the words in a string.
+func internalFindWords(str string, words []string, ignoreCase bool) []WordPosition {
+	positions := []WordPosition{}
+	for _, word := range words {
+		for from := 0; from < len(str); {
+			start, end := indexWord(str, word, from, ignoreCase)
+			if start < 0 {
+				break
+			}
+			positions = append(positions, WordPosition{Word: word, Start: start, End: end})
+			from = end
+		}
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].Start < positions[j].Start
+	})
+	return positions
+}
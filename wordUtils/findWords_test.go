@@ -0,0 +1,43 @@
+package wordUtils
+
+import "testing"
+
+func TestFindWords(t *testing.T) {
+	if len(FindWords("", "a")) != 0 || len(FindWords("abc")) != 0 || len(FindWords("abc", "")) != 0 {
+		t.Errorf("fail test FindWords 1")
+	}
+	positions := FindWords("new york is new", "new", "new york")
+	if len(positions) != 3 || positions[0] != (WordPosition{"new", 0, 3}) || positions[1] != (WordPosition{"new york", 0, 8}) || positions[2] != (WordPosition{"new", 12, 15}) {
+		t.Errorf("fail test FindWords 2")
+	}
+	positions = FindWords("renew news new", "new")
+	if len(positions) != 1 || positions[0] != (WordPosition{"new", 11, 14}) {
+		t.Errorf("fail test FindWords 3")
+	}
+	positions = FindWords("café c++ café", "café", "c++")
+	if len(positions) != 3 || positions[1] != (WordPosition{"c++", 6, 9}) || positions[2] != (WordPosition{"café", 10, 15}) {
+		t.Errorf("fail test FindWords 4")
+	}
+}
+
+func TestFindWordsIgnoreCase(t *testing.T) {
+	positions := FindWordsIgnoreCase("Go GO gopher go", "go")
+	if len(positions) != 3 || positions[1] != (WordPosition{"go", 3, 5}) || positions[2] != (WordPosition{"go", 13, 15}) {
+		t.Errorf("fail test FindWordsIgnoreCase 1")
+	}
+	// the Kelvin sign folds to k but is longer in UTF-8
+	positions = FindWordsIgnoreCase("5 K ok", "k")
+	if len(positions) != 1 || positions[0] != (WordPosition{"k", 2, 5}) {
+		t.Errorf("fail test FindWordsIgnoreCase 2")
+	}
+}
+
+func TestFindWordsIgnoreCaseFolding(t *testing.T) {
+	positions := FindWordsIgnoreCase("Die Straße", "STRASSE")
+	if len(positions) != 1 || positions[0].Start != 4 || positions[0].End != 11 {
+		t.Errorf("fail test FindWordsIgnoreCaseFolding 1")
+	}
+	if !ContainsAllWordsIgnoreCase("the Kelvin scale", "kelvin") {
+		t.Errorf("fail test FindWordsIgnoreCaseFolding 2")
+	}
+}
@@ -0,0 +1,175 @@
+package wordUtils
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// Alignment is the way Justify aligns the lines of a paragraph.
+type Alignment int
+
+const (
+	// AlignLeft aligns the lines on the left.
+	AlignLeft Alignment = iota
+	// AlignRight aligns the lines on the right.
+	AlignRight
+	// AlignCenter centers the lines.
+	AlignCenter
+	// AlignBoth aligns the lines on both sides by stretching the spaces between words,
+	// except for the last line of each paragraph which is aligned on the left.
+	AlignBoth
+)
+
+// tabWidth is the width of the tab stops in the indentation of the lines.
+const tabWidth = 8
+
+// bulletPattern matches the start of a bullet list item, such as "- ", "* " or "1. ".
+const bulletPattern = `^(\s*)([-*+•]|\d+[.)])\s+`
+
+// paragraph is a block of text to fill, with the indentation of its first and following lines.
+type paragraph struct {
+	words         []string
+	indent        string
+	hangingIndent string
+}
+
+// Justify fills the paragraphs of a text to a width and aligns their lines. The width is measured in
+// terminal cells, wide characters counting for two.
+//
+// Paragraphs are separated by blank lines, which are kept. The indentation of the first two lines of
+// a paragraph is kept, its tabs stopping every 8 columns, and each bullet list item ("- ", "* ", "1. "...)
+// is a paragraph whose following lines are indented past the bullet. Words longer than the width are not broken.
+//
+//	Justify("The quick brown fox jumps over the lazy dog.", 16, AlignBoth) =
+//		"The  quick brown\nfox  jumps  over\nthe lazy dog."
+func Justify(str string, width int, alignment Alignment) string {
+	if str == "" {
+		return str
+	}
+	lines := []string{}
+	for _, block := range splitParagraphs(str) {
+		if block == nil {
+			lines = append(lines, "")
+			continue
+		}
+		lines = append(lines, fillParagraph(*block, width, alignment)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Reflow fills again the paragraphs of an already wrapped text to a width, like the fmt command.
+// Paragraphs, blank lines, indentation and bullet list items are handled as in Justify,
+// the lines being aligned on the left.
+//
+//	Reflow("The quick\nbrown fox jumps\nover the lazy dog.", 20) = "The quick brown fox\njumps over the lazy\ndog."
+func Reflow(str string, width int) string {
+	return Justify(str, width, AlignLeft)
+}
+
+// splitParagraphs splits a text into paragraphs, a nil paragraph standing for a blank line.
+func splitParagraphs(str string) []*paragraph {
+	bullet := stringUtils.MustCompilePattern(bulletPattern)
+	paragraphs := []*paragraph{}
+	var current *paragraph
+	lineCount := 0
+	for _, line := range strings.Split(str, "\n") {
+		if strings.TrimSpace(line) == "" {
+			paragraphs = append(paragraphs, nil)
+			current = nil
+			continue
+		}
+		leading := line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+		if loc := bullet.FindStringIndex(line); loc != nil {
+			marker := line[:loc[1]]
+			current = &paragraph{
+				words:         strings.Fields(line[loc[1]:]),
+				indent:        marker,
+				hangingIndent: strings.Repeat(" ", indentWidth(marker)),
+			}
+			paragraphs = append(paragraphs, current)
+			// the following lines of a bullet item keep its hanging indentation
+			lineCount = 2
+			continue
+		}
+		if current == nil {
+			current = &paragraph{indent: leading, hangingIndent: leading}
+			paragraphs = append(paragraphs, current)
+			lineCount = 0
+		} else if lineCount == 1 {
+			current.hangingIndent = leading
+		}
+		current.words = append(current.words, strings.Fields(line)...)
+		lineCount++
+	}
+	return paragraphs
+}
+
+// fillParagraph fills a paragraph to a width, returning its aligned lines.
+func fillParagraph(p paragraph, width int, alignment Alignment) []string {
+	if len(p.words) == 0 {
+		// a bullet list item without words
+		return []string{strings.TrimRightFunc(p.indent, unicode.IsSpace)}
+	}
+	lines := []string{}
+	indent := p.indent
+	for start := 0; start < len(p.words); {
+		available := width - indentWidth(indent)
+		end := start + 1
+		lineWidth := stringUtils.DisplayWidth(p.words[start])
+		for end < len(p.words) && lineWidth+1+stringUtils.DisplayWidth(p.words[end]) <= available {
+			lineWidth += 1 + stringUtils.DisplayWidth(p.words[end])
+			end++
+		}
+		lines = append(lines, indent+alignLine(p.words[start:end], available-lineWidth, alignment, end == len(p.words)))
+		indent = p.hangingIndent
+		start = end
+	}
+	return lines
+}
+
+// indentWidth returns the display width of an indentation, its tabs reaching the next tab stop.
+func indentWidth(indent string) int {
+	width := 0
+	for _, r := range indent {
+		if r == '\t' {
+			width += tabWidth - width%tabWidth
+		} else {
+			width += stringUtils.DisplayWidth(string(r))
+		}
+	}
+	return width
+}
+
+// alignLine joins the words of a line, distributing the extra spaces as required by the alignment.
+func alignLine(words []string, extra int, alignment Alignment, last bool) string {
+	if extra <= 0 {
+		return strings.Join(words, " ")
+	}
+	switch alignment {
+	case AlignRight:
+		return strings.Repeat(" ", extra) + strings.Join(words, " ")
+	case AlignCenter:
+		return strings.Repeat(" ", extra/2) + strings.Join(words, " ")
+	case AlignBoth:
+		if last || len(words) == 1 {
+			break
+		}
+		gaps := len(words) - 1
+		var buff strings.Builder
+		for i, word := range words {
+			buff.WriteString(word)
+			if i < gaps {
+				// the leftmost gaps get the spaces which cannot be distributed evenly
+				spaces := 1 + extra/gaps
+				if i < extra%gaps {
+					spaces++
+				}
+				buff.WriteString(strings.Repeat(" ", spaces))
+			}
+		}
+		return buff.String()
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,64 @@
+package wordUtils
+
+import "testing"
+
+func TestJustify(t *testing.T) {
+	input := "The quick brown fox jumps over the lazy dog."
+	if Justify("", 10, AlignLeft) != "" {
+		t.Errorf("fail test Justify 1")
+	}
+	if Justify(input, 16, AlignLeft) != "The quick brown\nfox jumps over\nthe lazy dog." {
+		t.Errorf("fail test Justify 2")
+	}
+	if Justify(input, 16, AlignRight) != " The quick brown\n  fox jumps over\n   the lazy dog." {
+		t.Errorf("fail test Justify 3")
+	}
+	if Justify(input, 16, AlignCenter) != "The quick brown\n fox jumps over\n the lazy dog." {
+		t.Errorf("fail test Justify 4")
+	}
+	if Justify(input, 16, AlignBoth) != "The  quick brown\nfox  jumps  over\nthe lazy dog." {
+		t.Errorf("fail test Justify 5")
+	}
+	if Justify("a verylongword b", 5, AlignBoth) != "a\nverylongword\nb" {
+		t.Errorf("fail test Justify 6")
+	}
+	if Justify("日本 語の 文章", 7, AlignRight) != "   日本\n   語の\n   文章" {
+		t.Errorf("fail test Justify 7")
+	}
+}
+
+func TestJustifyParagraphs(t *testing.T) {
+	input := "First paragraph\nwith two lines.\n\n\nSecond one."
+	if Justify(input, 12, AlignBoth) != "First\nparagraph\nwith     two\nlines.\n\n\nSecond one." {
+		t.Errorf("fail test JustifyParagraphs 1")
+	}
+	input = "Changes:\n- fixed the wrapping of\n  long lines\n- added Justify and Reflow to wordUtils\n10. numbered item"
+	if Justify(input, 20, AlignLeft) != "Changes:\n- fixed the wrapping\n  of long lines\n- added Justify and\n  Reflow to\n  wordUtils\n10. numbered item" {
+		t.Errorf("fail test JustifyParagraphs 2")
+	}
+	input = "    indented paragraph with some words\n  and a hanging indentation"
+	if Justify(input, 20, AlignLeft) != "    indented\n  paragraph with\n  some words and a\n  hanging\n  indentation" {
+		t.Errorf("fail test JustifyParagraphs 3")
+	}
+	// the tabs of the indentation reach the next tab stop
+	input = "\tindented with a tab"
+	if Justify(input, 16, AlignLeft) != "\tindented\n\twith a\n\ttab" {
+		t.Errorf("fail test JustifyParagraphs 4")
+	}
+	if Justify("\t- a bullet item", 14, AlignLeft) != "\t- a\n          bullet\n          item" {
+		t.Errorf("fail test JustifyParagraphs 5")
+	}
+}
+
+func TestReflow(t *testing.T) {
+	if Reflow("The quick\nbrown fox jumps\nover the lazy dog.", 20) != "The quick brown fox\njumps over the lazy\ndog." {
+		t.Errorf("fail test Reflow 1")
+	}
+	if Reflow("a\nb\n  \nc   d", 80) != "a b\n\nc d" {
+		t.Errorf("fail test Reflow 2")
+	}
+	// an empty bullet list item is kept
+	if Reflow("Todo:\n- \n- b", 20) != "Todo:\n-\n- b" || Reflow("1. \n2. second", 20) != "1.\n2. second" {
+		t.Errorf("fail test Reflow 3")
+	}
+}
@@ -0,0 +1,99 @@
+package wordUtils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// Words returns the words of a String, found by Unicode word segmentation (UAX #29) rather than by
+// splitting on whitespace. Punctuation is dropped, but apostrophes and periods inside words and
+// separators inside numbers are kept. Ideographs are words of their own.
+//
+//	Words("Don't panic, it's well-known!") = ["Don't", "panic", "it's", "well", "known"]
+func Words(str string) []string {
+	words := []string{}
+	for _, segment := range stringUtils.WordSegments(str) {
+		if stringUtils.IsWordSegment(segment) {
+			words = append(words, segment)
+		}
+	}
+	return words
+}
+
+// CapitalizeSegmented capitalizes all the words of a String found by Unicode word segmentation.
+// Only the first letter of each word is changed.
+//
+//	CapitalizeSegmented("don't stop, well-known (quoted)") = "Don't Stop, Well-Known (Quoted)"
+func CapitalizeSegmented(str string) string {
+	return mapWords(str, func(word string) string {
+		return changeFirstRune(word, unicode.ToUpper)
+	})
+}
+
+// InitialsSegmented extracts the initial letters of the words of a String found by Unicode word segmentation.
+// Their case is not changed.
+//
+//	InitialsSegmented("Ben J. Lee-Smith (Jr)") = "BJLSJ"
+func InitialsSegmented(str string) string {
+	var buff strings.Builder
+	for _, word := range Words(str) {
+		r, _ := utf8.DecodeRuneInString(word)
+		buff.WriteRune(r)
+	}
+	return buff.String()
+}
+
+// SwapCaseSegmented swaps the case of a String as SwapCase does, the lower case characters
+// starting a word found by Unicode word segmentation being converted to title case.
+//
+//	SwapCaseSegmented("The dog-HAS a BONE") = "tHE DOG-has A bone"
+func SwapCaseSegmented(str string) string {
+	return mapWords(str, func(word string) string {
+		buff := []rune(word)
+		for i, ch := range buff {
+			if unicode.IsUpper(ch) || unicode.IsTitle(ch) {
+				buff[i] = unicode.ToLower(ch)
+			} else if unicode.IsLower(ch) && i == 0 {
+				buff[i] = unicode.ToTitle(ch)
+			} else if unicode.IsLower(ch) {
+				buff[i] = unicode.ToUpper(ch)
+			}
+		}
+		return string(buff)
+	})
+}
+
+// UncapitalizeSegmented uncapitalizes all the words of a String found by Unicode word segmentation.
+// Only the first letter of each word is changed.
+//
+//	UncapitalizeSegmented("Don't Stop, Well-Known") = "don't stop, well-known"
+func UncapitalizeSegmented(str string) string {
+	return mapWords(str, func(word string) string {
+		return changeFirstRune(word, unicode.ToLower)
+	})
+}
+
+// mapWords applies a mapping to the words of a string found by Unicode word segmentation,
+// keeping the segments between them.
+func mapWords(str string, mapping func(word string) string) string {
+	if str == "" {
+		return str
+	}
+	var buff strings.Builder
+	for _, segment := range stringUtils.WordSegments(str) {
+		if stringUtils.IsWordSegment(segment) {
+			segment = mapping(segment)
+		}
+		buff.WriteString(segment)
+	}
+	return buff.String()
+}
+
+// changeFirstRune applies a case mapping to the first character of a word.
+func changeFirstRune(word string, mapping func(rune) rune) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(mapping(r)) + word[size:]
+}
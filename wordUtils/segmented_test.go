@@ -0,0 +1,66 @@
+package wordUtils
+
+import "testing"
+
+func wordsEqual(a []string, b ...string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestWords(t *testing.T) {
+	if len(Words("")) != 0 || len(Words(" ,- ")) != 0 {
+		t.Errorf("fail test Words 1")
+	}
+	if !wordsEqual(Words("Don't panic, it's well-known!"), "Don't", "panic", "it's", "well", "known") {
+		t.Errorf("fail test Words 2")
+	}
+	if !wordsEqual(Words("pi is 3.14, e.g. 東京"), "pi", "is", "3.14", "e.g", "東", "京") {
+		t.Errorf("fail test Words 3")
+	}
+}
+
+func TestCapitalizeSegmented(t *testing.T) {
+	if CapitalizeSegmented("") != "" {
+		t.Errorf("fail test CapitalizeSegmented 1")
+	}
+	if CapitalizeSegmented("don't stop, well-known (quoted)") != "Don't Stop, Well-Known (Quoted)" {
+		t.Errorf("fail test CapitalizeSegmented 2")
+	}
+	if CapitalizeSegmented("élan\t\"vital\"") != "Élan\t\"Vital\"" {
+		t.Errorf("fail test CapitalizeSegmented 3")
+	}
+}
+
+func TestUncapitalizeSegmented(t *testing.T) {
+	if UncapitalizeSegmented("") != "" {
+		t.Errorf("fail test UncapitalizeSegmented 1")
+	}
+	if UncapitalizeSegmented("Don't Stop, Well-Known") != "don't stop, well-known" {
+		t.Errorf("fail test UncapitalizeSegmented 2")
+	}
+}
+
+func TestInitialsSegmented(t *testing.T) {
+	if InitialsSegmented("") != "" {
+		t.Errorf("fail test InitialsSegmented 1")
+	}
+	if InitialsSegmented("Ben J. Lee-Smith (Jr)") != "BJLSJ" {
+		t.Errorf("fail test InitialsSegmented 2")
+	}
+}
+
+func TestSwapCaseSegmented(t *testing.T) {
+	if SwapCaseSegmented("") != "" {
+		t.Errorf("fail test SwapCaseSegmented 1")
+	}
+	if SwapCaseSegmented("The dog-HAS a BONE") != "tHE DOG-has A bone" {
+		t.Errorf("fail test SwapCaseSegmented 2")
+	}
+}
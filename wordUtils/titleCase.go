@@ -0,0 +1,123 @@
+package wordUtils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// TitleStyle is the style guide TitleCase follows to choose the words to capitalize.
+type TitleStyle int
+
+const (
+	// StyleAP follows the Associated Press Stylebook: articles, and conjunctions and prepositions
+	// of three letters or fewer, are lowercased, except as the first or last word.
+	StyleAP TitleStyle = iota
+	// StyleChicago follows the Chicago Manual of Style: articles, the coordinating conjunctions
+	// and, but, for, or and nor, and all prepositions whatever their length, are lowercased,
+	// except as the first or last word.
+	StyleChicago
+	// StyleAPA follows the American Psychological Association style: articles, and conjunctions
+	// and prepositions of three letters or fewer, are lowercased, except as the first word of the title
+	// or of a subtitle. The last word is not capitalized if it is minor.
+	StyleAPA
+)
+
+// shortMinorWords holds the articles, conjunctions and prepositions of three letters or fewer.
+var shortMinorWords = wordSet("a", "an", "the", "and", "as", "at", "but", "by", "for", "if", "in", "nor",
+	"of", "off", "on", "or", "per", "so", "to", "up", "via", "yet")
+
+// chicagoMinorWords holds the articles, coordinating conjunctions and prepositions lowercased by StyleChicago.
+var chicagoMinorWords = wordSet("a", "an", "the", "and", "but", "for", "or", "nor", "as", "to",
+	"about", "above", "across", "after", "against", "along", "among", "around", "at", "before", "behind",
+	"below", "beneath", "beside", "between", "beyond", "by", "down", "during", "except", "from", "in",
+	"inside", "into", "like", "near", "of", "off", "on", "onto", "out", "outside", "over", "past", "per",
+	"since", "through", "throughout", "till", "toward", "towards", "under", "underneath", "until", "up",
+	"upon", "via", "with", "within", "without")
+
+// wordSet returns a set of words.
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// TitleCase formats a String as a title, following a style guide. Minor words are lowercased and the
+// other words are capitalized, the parts of hyphenated compounds being words of their own. The first word
+// of the title, of a subtitle following a colon, a dash, a question or exclamation mark, and of a compound
+// are always capitalized.
+//
+// Words having an upper case letter past their first one, such as acronyms and brands (NASA, iPhone,
+// McDonald), are kept as they are, unless the String has no lower case letter at all. The exceptions are
+// words written as given wherever they are, they are matched ignoring case.
+//
+//	TitleCase("the lord of the rings", StyleAP)                    = "The Lord of the Rings"
+//	TitleCase("a walk through the NASA archives", StyleChicago)    = "A Walk through the NASA Archives"
+//	TitleCase("a walk through the NASA archives", StyleAP)         = "A Walk Through the NASA Archives"
+//	TitleCase("an up-to-date guide: what to look for", StyleAPA)   = "An Up-to-Date Guide: What to Look for"
+//	TitleCase("the films of ludwig van beethoven", StyleAP, "van") = "The Films of Ludwig van Beethoven"
+func TitleCase(str string, style TitleStyle, exceptions ...string) string {
+	if str == "" {
+		return str
+	}
+	if strings.IndexFunc(str, unicode.IsLower) < 0 {
+		str = strings.ToLower(str)
+	}
+	exceptionWords := make(map[string]string, len(exceptions))
+	for _, exception := range exceptions {
+		exceptionWords[strings.ToLower(exception)] = exception
+	}
+	minorWords := shortMinorWords
+	if style == StyleChicago {
+		minorWords = chicagoMinorWords
+	}
+	segments := stringUtils.WordSegments(str)
+	last := -1
+	for i, segment := range segments {
+		if stringUtils.IsWordSegment(segment) {
+			last = i
+		}
+	}
+	startsPhrase := true
+	for i, segment := range segments {
+		if !stringUtils.IsWordSegment(segment) {
+			if strings.ContainsAny(segment, ":?!—–") {
+				startsPhrase = true
+			}
+			continue
+		}
+		lower := strings.ToLower(segment)
+		if exception, ok := exceptionWords[lower]; ok {
+			segments[i] = exception
+		} else if isMixedCase(segment) {
+			// keep acronyms and brands as they are
+		} else if startsPhrase || (i == last && style != StyleAPA) || !minorWords[lower] ||
+			startsCompound(segments, i) {
+			segments[i] = changeFirstRune(segment, unicode.ToTitle)
+		} else {
+			segments[i] = lower
+		}
+		startsPhrase = false
+	}
+	return strings.Join(segments, "")
+}
+
+// startsCompound checks if the word segment at index i is the first part of a hyphenated compound.
+func startsCompound(segments []string, i int) bool {
+	isHyphen := func(j int) bool {
+		return j >= 0 && j < len(segments) && (segments[j] == "-" || segments[j] == "\u2010" || segments[j] == "\u2011")
+	}
+	return isHyphen(i+1) && !isHyphen(i-1)
+}
+
+// isMixedCase checks if a word has an upper or title case letter past its first character.
+func isMixedCase(word string) bool {
+	_, size := utf8.DecodeRuneInString(word)
+	return strings.IndexFunc(word[size:], func(r rune) bool {
+		return unicode.IsUpper(r) || unicode.IsTitle(r)
+	}) >= 0
+}
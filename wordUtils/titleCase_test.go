@@ -0,0 +1,42 @@
+package wordUtils
+
+import "testing"
+
+func TestTitleCase(t *testing.T) {
+	if TitleCase("", StyleAP) != "" {
+		t.Errorf("fail test TitleCase 1")
+	}
+	if TitleCase("the lord of the rings", StyleAP) != "The Lord of the Rings" {
+		t.Errorf("fail test TitleCase 2")
+	}
+	if TitleCase("THE LORD OF THE RINGS", StyleChicago) != "The Lord of the Rings" {
+		t.Errorf("fail test TitleCase 3")
+	}
+	if TitleCase("a walk through the NASA archives", StyleChicago) != "A Walk through the NASA Archives" {
+		t.Errorf("fail test TitleCase 4")
+	}
+	if TitleCase("a walk through the NASA archives", StyleAP) != "A Walk Through the NASA Archives" {
+		t.Errorf("fail test TitleCase 5")
+	}
+	if TitleCase("an up-to-date guide: what to look for", StyleAPA) != "An Up-to-Date Guide: What to Look for" {
+		t.Errorf("fail test TitleCase 6")
+	}
+	if TitleCase("an up-to-date guide: what to look for", StyleChicago) != "An Up-to-Date Guide: What to Look For" {
+		t.Errorf("fail test TitleCase 7")
+	}
+	if TitleCase("the films of ludwig van beethoven", StyleAP, "van") != "The Films of Ludwig van Beethoven" {
+		t.Errorf("fail test TitleCase 8")
+	}
+	if TitleCase("why the iPhone beat the BlackBerry", StyleAP) != "Why the iPhone Beat the BlackBerry" {
+		t.Errorf("fail test TitleCase 9")
+	}
+	if TitleCase("iphone and ebay in 2024", StyleAP, "iPhone", "eBay") != "iPhone and eBay in 2024" {
+		t.Errorf("fail test TitleCase 10")
+	}
+	if TitleCase("don't stop (believin')", StyleAP) != "Don't Stop (Believin')" {
+		t.Errorf("fail test TitleCase 11")
+	}
+	if TitleCase("what is it for? a self-report of the self", StyleAPA) != "What Is It for? A Self-Report of the Self" {
+		t.Errorf("fail test TitleCase 12")
+	}
+}
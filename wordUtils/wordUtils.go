@@ -2,8 +2,11 @@
 package wordUtils
 
 import (
-	"regexp"
+	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
 )
 
 func isDelimiter(c rune, delimiters ...string) bool {
@@ -19,6 +22,27 @@ func isDelimiter(c rune, delimiters ...string) bool {
 	return false
 }
 
+// mapDelimitedWords applies a mapping to the delimiter separated words of a string, keeping the delimiters.
+func mapDelimitedWords(str string, mapping func(word string) string, delimiters ...string) string {
+	var buff strings.Builder
+	start := -1
+	for i, c := range str {
+		if isDelimiter(c, delimiters...) {
+			if start >= 0 {
+				buff.WriteString(mapping(str[start:i]))
+				start = -1
+			}
+			buff.WriteRune(c)
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		buff.WriteString(mapping(str[start:]))
+	}
+	return buff.String()
+}
+
 // Capitalize capitalizes all the whitespace separated words in a String. Only the first letter of each word is changed.
 func Capitalize(str string) string {
 	return CapitalizeDelimited(str, nil...)
@@ -47,18 +71,62 @@ func CapitalizeDelimited(str string, delimiters ...string) string {
 	return string(buff[:])
 }
 
+// CapitalizeWithLocale capitalizes all the delimiter separated words in a String following the case rules of a locale,
+// such as "tr" or "nl". Only the first letter of each word is changed. The words are whitespace separated
+// when no delimiter is given.
+//
+//	CapitalizeWithLocale("izmir ve istanbul", "tr") = "İzmir Ve İstanbul"
+//	CapitalizeWithLocale("het ijsselmeer", "nl")    = "Het IJsselmeer"
+func CapitalizeWithLocale(str string, locale string, delimiters ...string) string {
+	if str == "" || (delimiters != nil && len(delimiters) == 0) {
+		return str
+	}
+	return mapDelimitedWords(str, stringUtils.NewCaseMapper(locale).Capitalize, delimiters...)
+}
+
 // ContainsAllWords checks if the String contains all words.
+// The words are searched literally, and must not be surrounded by letters, digits, marks or underscores.
+// Empty words are never found.
+//
+//	ContainsAllWords("abc def", "def", "abc") = true
+//	ContainsAllWords("abcdf", "ab", "cd")     = false
+//	ContainsAllWords("I love c++!", "c++")    = true
 func ContainsAllWords(str string, words ...string) bool {
+	return internalContainsWords(str, words, false, true)
+}
+
+// ContainsAllWordsIgnoreCase checks if the String contains all words, ignoring case.
+func ContainsAllWordsIgnoreCase(str string, words ...string) bool {
+	return internalContainsWords(str, words, true, true)
+}
+
+// ContainsAnyWord checks if the String contains any of the words.
+// The words are searched literally, and must not be surrounded by letters, digits, marks or underscores.
+// Empty words are never found.
+//
+//	ContainsAnyWord("abc def", "xyz", "abc") = true
+//	ContainsAnyWord("abcdef", "abc")         = false
+func ContainsAnyWord(str string, words ...string) bool {
+	return internalContainsWords(str, words, false, false)
+}
+
+// ContainsAnyWordIgnoreCase checks if the String contains any of the words, ignoring case.
+func ContainsAnyWordIgnoreCase(str string, words ...string) bool {
+	return internalContainsWords(str, words, true, false)
+}
+
+// internalContainsWords checks if a string contains all or any of the words.
+func internalContainsWords(str string, words []string, ignoreCase bool, all bool) bool {
 	if str == "" || len(words) == 0 {
 		return false
 	}
-	found := 0
 	for _, word := range words {
-		if regexp.MustCompile(`.*\b` + word + `\b.*`).MatchString(str) {
-			found++
+		start, _ := indexWord(str, word, 0, ignoreCase)
+		if found := start >= 0; found != all {
+			return found
 		}
 	}
-	return found == len(words)
+	return all
 }
 
 // Initials extracts the initial letters from each word in the String.
@@ -125,6 +193,33 @@ func SwapCase(str string) string {
 	return string(buff)
 }
 
+// SwapCaseWithLocale swaps the case of a String as SwapCase does, following the case rules of a locale.
+//
+//	SwapCaseWithLocale("istanbul Ile", "tr") = "İSTANBUL ıLE"
+func SwapCaseWithLocale(str string, locale string) string {
+	if str == "" {
+		return str
+	}
+	mapper := stringUtils.NewCaseMapper(locale)
+	return mapDelimitedWords(str, func(word string) string {
+		swapped := mapper.SwapCase(word)
+		first, _ := utf8.DecodeRuneInString(word)
+		if !unicode.IsLower(first) {
+			return swapped
+		}
+		// the first letter of the word and its combining marks go to title case rather than upper case
+		headLen := utf8.RuneLen(first)
+		for headLen < len(word) {
+			r, size := utf8.DecodeRuneInString(word[headLen:])
+			if !unicode.Is(unicode.Mn, r) {
+				break
+			}
+			headLen += size
+		}
+		return mapper.Capitalize(word[:headLen]) + swapped[len(mapper.SwapCase(word[:headLen])):]
+	})
+}
+
 // Uncapitalize uncapitalizes all the whitespace separated words in a string. Only the first letter of each word is changed.
 func Uncapitalize(str string) string {
 	return UncapitalizeDelimited(str, nil...)
@@ -150,3 +245,15 @@ func UncapitalizeDelimited(str string, delimiters ...string) string {
 	}
 	return string(buff[:])
 }
+
+// UncapitalizeWithLocale uncapitalizes all the delimiter separated words in a String following the case rules
+// of a locale. Only the first letter of each word is changed. The words are whitespace separated when no delimiter
+// is given.
+//
+//	UncapitalizeWithLocale("İzmir Ve Istanbul", "tr") = "izmir ve ıstanbul"
+func UncapitalizeWithLocale(str string, locale string, delimiters ...string) string {
+	if str == "" || (delimiters != nil && len(delimiters) == 0) {
+		return str
+	}
+	return mapDelimitedWords(str, stringUtils.NewCaseMapper(locale).Uncapitalize, delimiters...)
+}
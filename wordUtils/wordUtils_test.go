@@ -36,6 +36,33 @@ func TestContainsAllWords(t *testing.T) {
 	if ContainsAllWords("abc def", "def", "abc") != true {
 		t.Errorf("fail test ContainsAllWords 3")
 	}
+	if ContainsAllWords("I love c++ and a.b", "c++", "a.b") != true || ContainsAllWords("abc", "a.c") != false {
+		t.Errorf("fail test ContainsAllWords 4")
+	}
+	if ContainsAllWords("c++x", "c++") != false || ContainsAllWords("(c++)", "c++") != true {
+		t.Errorf("fail test ContainsAllWords 5")
+	}
+	if ContainsAllWords("déjà vu", "déjà") != true || ContainsAllWords("déjàvu", "déjà") != false || ContainsAllWords("été", "t") != false {
+		t.Errorf("fail test ContainsAllWords 6")
+	}
+	if ContainsAllWords("abc", "") != false || ContainsAllWords("abc") != false || ContainsAllWords("foo_bar", "foo") != false {
+		t.Errorf("fail test ContainsAllWords 7")
+	}
+	if ContainsAllWords("Abc Def", "abc") != false || ContainsAllWordsIgnoreCase("Abc DÉF", "abc", "déf") != true {
+		t.Errorf("fail test ContainsAllWords 8")
+	}
+}
+
+func TestContainsAnyWord(t *testing.T) {
+	if ContainsAnyWord("", "a") != false || ContainsAnyWord("abc") != false {
+		t.Errorf("fail test ContainsAnyWord 1")
+	}
+	if ContainsAnyWord("abc def", "xyz", "abc") != true || ContainsAnyWord("abcdef", "abc", "def") != false {
+		t.Errorf("fail test ContainsAnyWord 2")
+	}
+	if ContainsAnyWord("ABC def", "abc") != false || ContainsAnyWordIgnoreCase("ABC def", "abc") != true {
+		t.Errorf("fail test ContainsAnyWord 3")
+	}
 }
 
 func TestInitials(t *testing.T) {
@@ -88,3 +115,42 @@ func TestUncapitalizeDelimited(t *testing.T) {
 		t.Errorf("fail test UncapitalizeDelimited 3")
 	}
 }
+
+func TestCapitalizeWithLocale(t *testing.T) {
+	if CapitalizeWithLocale("", "tr") != "" || CapitalizeWithLocale("izmir", "tr", []string{}...) != "izmir" {
+		t.Errorf("fail test CapitalizeWithLocale 1")
+	}
+	if CapitalizeWithLocale("izmir ve istanbul", "tr") != "İzmir Ve İstanbul" {
+		t.Errorf("fail test CapitalizeWithLocale 2")
+	}
+	if CapitalizeWithLocale("izmir ve istanbul", "") != "Izmir Ve Istanbul" {
+		t.Errorf("fail test CapitalizeWithLocale 3")
+	}
+	if CapitalizeWithLocale("het ijsselmeer", "nl") != "Het IJsselmeer" {
+		t.Errorf("fail test CapitalizeWithLocale 4")
+	}
+	if CapitalizeWithLocale("izmir.istanbul", "tr", ".") != "İzmir.İstanbul" {
+		t.Errorf("fail test CapitalizeWithLocale 5")
+	}
+}
+
+func TestUncapitalizeWithLocale(t *testing.T) {
+	if UncapitalizeWithLocale("", "tr") != "" {
+		t.Errorf("fail test UncapitalizeWithLocale 1")
+	}
+	if UncapitalizeWithLocale("İzmir Ve Istanbul", "tr") != "izmir ve ıstanbul" {
+		t.Errorf("fail test UncapitalizeWithLocale 2")
+	}
+}
+
+func TestSwapCaseWithLocale(t *testing.T) {
+	if SwapCaseWithLocale("", "tr") != "" {
+		t.Errorf("fail test SwapCaseWithLocale 1")
+	}
+	if SwapCaseWithLocale("istanbul Ile", "tr") != "İSTANBUL ıLE" {
+		t.Errorf("fail test SwapCaseWithLocale 2")
+	}
+	if SwapCaseWithLocale("ǆungla ΟΔΟΣ", "") != "ǅUNGLA οδος" {
+		t.Errorf("fail test SwapCaseWithLocale 3")
+	}
+}
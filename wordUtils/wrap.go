@@ -0,0 +1,167 @@
+package wordUtils
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/agrison/go-commons-lang/stringUtils"
+)
+
+// WrapOptions configures how WrapWithOptions wraps a text.
+type WrapOptions struct {
+	// Width is the maximum display width of the lines, prefix and indentation included.
+	// Lines are at least one character long whatever the width.
+	Width int
+	// NewLine is the string inserted between the lines, "\n" by default.
+	NewLine string
+	// WrapLongWords breaks the words longer than the width, which are kept whole otherwise.
+	WrapLongWords bool
+	// WrapOn is the regular expression matching the separators the lines are wrapped on, " " by default.
+	// The separators found at the start of a line are removed.
+	WrapOn string
+	// Prefix is put at the start of every line, such as "> " or "// ".
+	Prefix string
+	// Indent is put at the start of the first line, after the prefix.
+	Indent string
+	// HangingIndent is put at the start of the lines following the first one, after the prefix.
+	HangingIndent string
+}
+
+// Wrap wraps a text to a width, identifying words by wrapOn, a regular expression (" " when blank).
+// The width is measured in terminal cells, wide characters counting for two, and grapheme clusters
+// are never split. Line breaks already present in the text are kept.
+// It panics if wrapOn is not a valid regular expression.
+//
+//	Wrap("Here is one line of text that is going to be wrapped after 20 columns.", 20, "\n", false, " ") =
+//		"Here is one line of\ntext that is going\nto be wrapped after\n20 columns."
+//	Wrap("Click here, https://commons.apache.org", 20, "\n", true, " ") =
+//		"Click here,\nhttps://commons.apac\nhe.org"
+func Wrap(str string, width int, newLine string, wrapLongWords bool, wrapOn string) string {
+	return WrapWithOptions(str, WrapOptions{Width: width, NewLine: newLine, WrapLongWords: wrapLongWords, WrapOn: wrapOn})
+}
+
+// WrapWithOptions wraps a text as configured by the options. Line breaks already present in the text
+// are kept, the prefix and hanging indentation being repeated on the lines following them.
+// It panics if the WrapOn option is not a valid regular expression.
+//
+//	WrapWithOptions("-v  print the details of every step", WrapOptions{Width: 20, HangingIndent: "    "}) =
+//		"-v  print the\n    details of every\n    step"
+func WrapWithOptions(str string, options WrapOptions) string {
+	if str == "" {
+		return str
+	}
+	if options.NewLine == "" {
+		options.NewLine = "\n"
+	}
+	if stringUtils.IsBlank(options.WrapOn) {
+		options.WrapOn = " "
+	}
+	re := stringUtils.MustCompilePattern(options.WrapOn)
+	lines := []string{}
+	for _, line := range strings.Split(str, "\n") {
+		lines = wrapLine(lines, line, options, re)
+	}
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = options.Prefix + options.Indent + line
+		} else {
+			lines[i] = options.Prefix + options.HangingIndent + line
+		}
+	}
+	return strings.Join(lines, options.NewLine)
+}
+
+// wrapLine wraps a line of text without line breaks, appending the wrapped lines to lines.
+func wrapLine(lines []string, str string, options WrapOptions, re *regexp.Regexp) []string {
+	clusters := stringUtils.Graphemes(str)
+	// bounds holds the byte offsets of the grapheme clusters
+	bounds := make([]int, len(clusters)+1)
+	widths := make([]int, len(clusters))
+	for i, cluster := range clusters {
+		bounds[i+1] = bounds[i] + len(cluster)
+		widths[i] = stringUtils.DisplayWidth(cluster)
+	}
+	first := len(lines)
+	for offset := 0; offset < len(clusters); {
+		indent := options.Indent
+		if len(lines) > 0 {
+			indent = options.HangingIndent
+		}
+		available := options.Width - stringUtils.DisplayWidth(options.Prefix+indent)
+		lineEnd, width := offset, 0
+		for lineEnd < len(clusters) && width+widths[lineEnd] <= available {
+			width += widths[lineEnd]
+			lineEnd++
+		}
+		// the separators may start right after the end of the line
+		windowEnd := len(str)
+		if lineEnd < len(clusters) {
+			windowEnd = bounds[lineEnd+1]
+		}
+		sepStart, sepEnd := -1, -1
+		for _, loc := range re.FindAllStringIndex(str[bounds[offset]:windowEnd], -1) {
+			if loc[1] > loc[0] {
+				if sepStart < 0 && loc[0] == 0 {
+					// skip the separators at the start of the line
+					sepEnd = bounds[offset] + loc[1]
+					break
+				}
+				sepStart, sepEnd = bounds[offset]+loc[0], bounds[offset]+loc[1]
+			}
+		}
+		if sepStart >= 0 {
+			sepEnd = separatorEnd(re, str, sepStart, sepEnd)
+		} else if sepEnd >= 0 {
+			sepEnd = separatorEnd(re, str, bounds[offset], sepEnd)
+		}
+		switch {
+		case sepStart < 0 && sepEnd >= 0:
+			offset = sort.SearchInts(bounds, sepEnd)
+			continue
+		case lineEnd == len(clusters):
+			return append(lines, str[bounds[offset]:])
+		case sepStart < 0 && options.WrapLongWords:
+			if lineEnd == offset {
+				lineEnd++
+			}
+			sepStart, sepEnd = bounds[lineEnd], bounds[lineEnd]
+		case sepStart < 0:
+			// keep the long word whole, up to the next separator
+			sepStart, sepEnd = nextSeparator(re, str, bounds[lineEnd])
+			if sepStart < 0 {
+				return append(lines, str[bounds[offset]:])
+			}
+		}
+		lines = append(lines, str[bounds[offset]:sepStart])
+		offset = sort.SearchInts(bounds, sepEnd)
+	}
+	if len(lines) == first {
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// nextSeparator returns the bounds of the first non empty separator found at or after from, or -1 and -1.
+func nextSeparator(re *regexp.Regexp, str string, from int) (int, int) {
+	for from <= len(str) {
+		loc := re.FindStringIndex(str[from:])
+		if loc == nil {
+			break
+		}
+		if loc[1] > loc[0] {
+			return from + loc[0], from + loc[1]
+		}
+		from += loc[1] + 1
+	}
+	return -1, -1
+}
+
+// separatorEnd returns the end of the separator starting at start, found in a window of the string
+// to end at least at end, as it may extend beyond the window.
+func separatorEnd(re *regexp.Regexp, str string, start int, end int) int {
+	if loc := re.FindStringIndex(str[start:]); loc != nil && loc[0] == 0 && start+loc[1] > end {
+		return start + loc[1]
+	}
+	return end
+}
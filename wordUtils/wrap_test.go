@@ -0,0 +1,79 @@
+package wordUtils
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	if Wrap("", 20, "\n", false, " ") != "" {
+		t.Errorf("fail test Wrap 1")
+	}
+	if Wrap("Here is one line of text that is going to be wrapped after 20 columns.", 20, "\n", false, " ") != "Here is one line of\ntext that is going\nto be wrapped after\n20 columns." {
+		t.Errorf("fail test Wrap 2")
+	}
+	if Wrap("Here is one line of text that is going to be wrapped after 20 columns.", 20, "<br />", false, "") != "Here is one line of<br />text that is going<br />to be wrapped after<br />20 columns." {
+		t.Errorf("fail test Wrap 3")
+	}
+	if Wrap("Here is\tone line of text that is going to be wrapped after 20 columns.", 20, "\n", false, " ") != "Here is\tone line of\ntext that is going\nto be wrapped after\n20 columns." {
+		t.Errorf("fail test Wrap 4")
+	}
+	if Wrap(" Here:  is  one  line  of  text  that  is  going  to  be  wrapped  after  20  columns.", 20, "\n", false, " ") != "Here:  is  one  line\nof  text  that  is \ngoing  to  be \nwrapped  after  20 \ncolumns." {
+		t.Errorf("fail test Wrap 5")
+	}
+}
+
+func TestWrapLongWords(t *testing.T) {
+	input := "Click here to jump to the commons website - https://commons.apache.org"
+	if Wrap(input, 20, "\n", false, " ") != "Click here to jump\nto the commons\nwebsite -\nhttps://commons.apache.org" {
+		t.Errorf("fail test WrapLongWords 1")
+	}
+	if Wrap(input, 20, "\n", true, " ") != "Click here to jump\nto the commons\nwebsite -\nhttps://commons.apac\nhe.org" {
+		t.Errorf("fail test WrapLongWords 2")
+	}
+	if Wrap("Click here, https://commons.apache.org, to jump to the commons website", 20, "\n", false, " ") != "Click here,\nhttps://commons.apache.org,\nto jump to the\ncommons website" {
+		t.Errorf("fail test WrapLongWords 3")
+	}
+	if Wrap("abcdef", 0, "\n", true, " ") != "a\nb\nc\nd\ne\nf" {
+		t.Errorf("fail test WrapLongWords 4")
+	}
+}
+
+func TestWrapOn(t *testing.T) {
+	if Wrap("flammable/inflammable", 30, "\n", true, "/") != "flammable/inflammable" {
+		t.Errorf("fail test WrapOn 1")
+	}
+	if Wrap("flammableinflammable", 30, "\n", true, "flammable") != "inflammable" {
+		t.Errorf("fail test WrapOn 2")
+	}
+	if Wrap("flammable/inflammable", 10, "\n", true, "/") != "flammable\ninflammabl\ne" {
+		t.Errorf("fail test WrapOn 3")
+	}
+	if Wrap("a, b,  c, d", 5, "\n", false, `,\s*`) != "a, b\nc, d" {
+		t.Errorf("fail test WrapOn 4")
+	}
+}
+
+func TestWrapDisplayWidth(t *testing.T) {
+	if Wrap("日本語の 文章を 折り返す", 8, "\n", false, " ") != "日本語の\n文章を\n折り返す" {
+		t.Errorf("fail test WrapDisplayWidth 1")
+	}
+	if Wrap("日本語の文章", 5, "\n", true, " ") != "日本\n語の\n文章" {
+		t.Errorf("fail test WrapDisplayWidth 2")
+	}
+	if Wrap("café café", 4, "\n", true, " ") != "café\ncafé" {
+		t.Errorf("fail test WrapDisplayWidth 3")
+	}
+}
+
+func TestWrapWithOptions(t *testing.T) {
+	if WrapWithOptions("-v  print the details of every step", WrapOptions{Width: 20, HangingIndent: "    "}) != "-v  print the\n    details of every\n    step" {
+		t.Errorf("fail test WrapWithOptions 1")
+	}
+	if WrapWithOptions("Hello Bob, how are you doing today?", WrapOptions{Width: 14, Prefix: "> "}) != "> Hello Bob,\n> how are you\n> doing today?" {
+		t.Errorf("fail test WrapWithOptions 2")
+	}
+	if WrapWithOptions("one two three\n\nfour five six", WrapOptions{Width: 10, Prefix: "# ", Indent: "* ", HangingIndent: "  "}) != "# * one\n#   two\n#   three\n#   \n#   four\n#   five\n#   six" {
+		t.Errorf("fail test WrapWithOptions 3")
+	}
+	if WrapWithOptions("a b", WrapOptions{Width: 1, NewLine: "\r\n"}) != "a\r\nb" {
+		t.Errorf("fail test WrapWithOptions 4")
+	}
+}